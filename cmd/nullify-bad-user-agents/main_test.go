@@ -23,6 +23,7 @@ import (
 	migrator "storj.io/storj/cmd/nullify-bad-user-agents"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -839,7 +840,7 @@ func TestMigrateBucketMetainfos(t *testing.T) {
 	}
 
 	check := func(t *testing.T, ctx context.Context, db satellite.DB) {
-		list, err := db.Buckets().ListBuckets(ctx, projID, storj.BucketListOptions{Direction: storj.Forward}, macaroon.AllowedBuckets{All: true})
+		list, err := db.Buckets().ListBuckets(ctx, projID, buckets.ListOptions{BucketListOptions: storj.BucketListOptions{Direction: storj.Forward}}, macaroon.AllowedBuckets{All: true})
 		require.NoError(t, err)
 
 		var updated int
@@ -904,7 +905,7 @@ func TestMigrateBucketMetainfosLimited(t *testing.T) {
 	maxUpdates := 1
 
 	check := func(t *testing.T, ctx context.Context, db satellite.DB) {
-		list, err := db.Buckets().ListBuckets(ctx, projID, storj.BucketListOptions{Direction: storj.Forward}, macaroon.AllowedBuckets{All: true})
+		list, err := db.Buckets().ListBuckets(ctx, projID, buckets.ListOptions{BucketListOptions: storj.BucketListOptions{Direction: storj.Forward}}, macaroon.AllowedBuckets{All: true})
 		require.NoError(t, err)
 
 		var updated int
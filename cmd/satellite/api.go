@@ -36,6 +36,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		ApplicationName:      "satellite-api",
 		APIKeysLRUOptions:    runCfg.APIKeysLRUOptions(),
 		RevocationLRUOptions: runCfg.RevocationLRUOptions(),
+		ReadReplicaURL:       runCfg.DatabaseOptions.ReadReplica,
 	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite api: %+v", err)
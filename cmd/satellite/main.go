@@ -59,6 +59,8 @@ type Satellite struct {
 			Expiration time.Duration `help:"macaroon revocation cache expiration" default:"5m"`
 			Capacity   int           `help:"macaroon revocation cache capacity" default:"10000"`
 		}
+
+		ReadReplica string `help:"satellite database read replica connection string, used only for bucket reads from the metainfo api when metainfo.bucket-reads-from-replica is enabled" default:""`
 	}
 
 	satellite.Config
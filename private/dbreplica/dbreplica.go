@@ -0,0 +1,23 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package dbreplica marks contexts whose database reads may be served by a read replica.
+package dbreplica
+
+import "context"
+
+type readOnlyKey struct{}
+
+// WithReadOnly returns a context, which allows the database to serve reads
+// from a read replica, when one is configured. Replicas lag behind the primary,
+// so it must only be used for requests that tolerate stale data and that don't
+// read what the same request has just written.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+// IsReadOnly returns whether the context was marked with WithReadOnly.
+func IsReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
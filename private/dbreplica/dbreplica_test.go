@@ -0,0 +1,26 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dbreplica_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/dbreplica"
+)
+
+func TestWithReadOnly(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, dbreplica.IsReadOnly(ctx))
+
+	readOnly := dbreplica.WithReadOnly(ctx)
+	require.True(t, dbreplica.IsReadOnly(readOnly))
+	require.False(t, dbreplica.IsReadOnly(ctx))
+
+	type otherKey struct{}
+	derived := context.WithValue(readOnly, otherKey{}, "value")
+	require.True(t, dbreplica.IsReadOnly(derived))
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package jitter implements randomized startup delays, which stagger chores
+// that would otherwise all run at the same time after a restart.
+package jitter
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"storj.io/common/sync2"
+)
+
+// Config contains the startup jitter shared by the chores.
+type Config struct {
+	Fraction float64 `help:"fraction of a chore interval by which its first run is randomly delayed, chores may override it" default:"0"`
+}
+
+// For returns the fraction a chore should use. A negative override falls back
+// to the shared fraction.
+func (config Config) For(override float64) float64 {
+	if override < 0 {
+		return config.Fraction
+	}
+	return override
+}
+
+// Duration returns a random duration within [0, fraction*interval). The fraction
+// is capped at 1, a non-positive fraction or interval disables the jitter.
+func Duration(interval time.Duration, fraction float64) time.Duration {
+	if interval <= 0 || fraction <= 0 {
+		return 0
+	}
+	if fraction > 1 {
+		fraction = 1
+	}
+	return time.Duration(rand.Float64() * fraction * float64(interval))
+}
+
+// Delay is the randomized delay before the first run of a chore.
+type Delay struct {
+	duration time.Duration
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewDelay picks the delay for a chore running every interval.
+func NewDelay(interval time.Duration, fraction float64) *Delay {
+	return &Delay{
+		duration: Duration(interval, fraction),
+		closed:   make(chan struct{}),
+	}
+}
+
+// Duration returns the picked delay.
+func (delay *Delay) Duration() time.Duration {
+	return delay.duration
+}
+
+// Wait waits for the delay. It returns false when the context is canceled
+// or the delay is closed before it has passed.
+func (delay *Delay) Wait(ctx context.Context) bool {
+	if delay.duration <= 0 {
+		return true
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-delay.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return sync2.Sleep(ctx, delay.duration)
+}
+
+// Close interrupts a pending Wait.
+func (delay *Delay) Close() {
+	delay.closeOnce.Do(func() { close(delay.closed) })
+}
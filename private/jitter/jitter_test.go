@@ -0,0 +1,76 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package jitter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/jitter"
+)
+
+func TestFor(t *testing.T) {
+	config := jitter.Config{Fraction: 0.25}
+	require.Equal(t, 0.25, config.For(-1))
+	require.Equal(t, 0.5, config.For(0.5))
+	require.Equal(t, 0.0, config.For(0))
+}
+
+func TestDuration(t *testing.T) {
+	interval := time.Hour
+
+	require.Zero(t, jitter.Duration(interval, 0))
+	require.Zero(t, jitter.Duration(interval, -0.5))
+	require.Zero(t, jitter.Duration(0, 0.5))
+
+	for _, fraction := range []float64{0.1, 0.5, 1, 2} {
+		max := time.Duration(fraction * float64(interval))
+		if max > interval {
+			max = interval
+		}
+		for i := 0; i < 1000; i++ {
+			delay := jitter.Duration(interval, fraction)
+			require.GreaterOrEqual(t, delay, time.Duration(0), fraction)
+			require.Less(t, delay, max, fraction)
+		}
+	}
+}
+
+func TestDelay(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("disabled", func(t *testing.T) {
+		delay := jitter.NewDelay(time.Hour, 0)
+		require.Zero(t, delay.Duration())
+		require.True(t, delay.Wait(ctx))
+	})
+
+	t.Run("waits", func(t *testing.T) {
+		interval := 100 * time.Millisecond
+		delay := jitter.NewDelay(interval, 0.5)
+		require.Less(t, delay.Duration(), interval/2)
+
+		start := time.Now()
+		require.True(t, delay.Wait(ctx))
+		require.GreaterOrEqual(t, time.Since(start), delay.Duration())
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		delay := jitter.NewDelay(time.Hour, 1)
+		delay.Close()
+		require.False(t, delay.Wait(ctx))
+		delay.Close()
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		delay := jitter.NewDelay(time.Hour, 1)
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		require.False(t, delay.Wait(canceled))
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package post
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrDKIM is the error class for DKIM signing errors.
+var ErrDKIM = errs.Class("dkim")
+
+// dkimSignedHeaders are the headers included in the signature, when present in the message.
+var dkimSignedHeaders = []string{
+	"from", "reply-to", "to", "subject", "date", "message-id",
+	"mime-version", "content-type", "content-transfer-encoding",
+}
+
+// DKIMSigner signs messages according to RFC 6376 using rsa-sha256
+// and relaxed/relaxed canonicalization.
+type DKIMSigner struct {
+	Domain   string
+	Selector string
+	Key      *rsa.PrivateKey
+}
+
+// ParseDKIMKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key.
+func ParseDKIMKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrDKIM.New("no PEM data found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrDKIM.New("unable to parse private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrDKIM.New("unsupported private key type %T", key)
+	}
+	return rsaKey, nil
+}
+
+// Sign returns the DKIM-Signature header field for the message,
+// the message must be in the form returned by Message.Bytes.
+func (signer *DKIMSigner) Sign(message []byte, now time.Time) (string, error) {
+	header, body := splitMessage(message)
+
+	bodyHash := sha256.Sum256(canonicalizeBodyRelaxed(body))
+
+	fields := parseHeaderFields(header)
+	var names []string
+	var hashed bytes.Buffer
+	for _, name := range dkimSignedHeaders {
+		// multiple instances of a header are signed from the bottom up
+		for i := len(fields) - 1; i >= 0; i-- {
+			if strings.EqualFold(fields[i].name, name) {
+				names = append(names, name)
+				hashed.WriteString(canonicalizeHeaderRelaxed(fields[i].name, fields[i].value))
+				hashed.WriteString("\r\n")
+			}
+		}
+	}
+
+	value := "v=1; a=rsa-sha256; c=relaxed/relaxed" +
+		"; d=" + signer.Domain +
+		"; s=" + signer.Selector +
+		"; t=" + strconv.FormatInt(now.Unix(), 10) +
+		"; h=" + strings.Join(names, ":") +
+		"; bh=" + base64.StdEncoding.EncodeToString(bodyHash[:]) +
+		"; b="
+	hashed.WriteString(canonicalizeHeaderRelaxed("DKIM-Signature", value))
+
+	digest := sha256.Sum256(hashed.Bytes())
+	signature, err := rsa.SignPKCS1v15(rand.Reader, signer.Key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", ErrDKIM.Wrap(err)
+	}
+
+	return "DKIM-Signature: " + value + base64.StdEncoding.EncodeToString(signature), nil
+}
+
+type headerField struct {
+	name  string
+	value string
+}
+
+// splitMessage splits the message into the header and the body.
+func splitMessage(message []byte) (header, body []byte) {
+	if i := bytes.Index(message, []byte("\r\n\r\n")); i >= 0 {
+		return message[:i+2], message[i+4:]
+	}
+	return message, nil
+}
+
+// parseHeaderFields returns the header fields in order, keeping folded values as is.
+func parseHeaderFields(header []byte) []headerField {
+	var fields []headerField
+	for _, line := range strings.SplitAfter(string(header), "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1].value += line
+			continue
+		}
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			continue
+		}
+		fields = append(fields, headerField{name: line[:colon], value: line[colon+1:]})
+	}
+	return fields
+}
+
+// canonicalizeHeaderRelaxed implements the relaxed header canonicalization of RFC 6376 section 3.4.2.
+func canonicalizeHeaderRelaxed(name, value string) string {
+	value = strings.NewReplacer("\r\n", "").Replace(value)
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + strings.TrimSpace(compressWhitespace(value))
+}
+
+// canonicalizeBodyRelaxed implements the relaxed body canonicalization of RFC 6376 section 3.4.4.
+func canonicalizeBodyRelaxed(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(compressWhitespace(line), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+// compressWhitespace replaces sequences of spaces and tabs with a single space.
+func compressWhitespace(s string) string {
+	var b strings.Builder
+	whitespace := false
+	for _, r := range s {
+		if r == ' ' || r == '\t' {
+			whitespace = true
+			continue
+		}
+		if whitespace {
+			b.WriteByte(' ')
+			whitespace = false
+		}
+		b.WriteRune(r)
+	}
+	if whitespace {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
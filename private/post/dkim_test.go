@@ -0,0 +1,86 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package post
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDKIM_Canonicalization(t *testing.T) {
+	require.Equal(t, "subject:Hello World", canonicalizeHeaderRelaxed("Subject ", " Hello \t World\r\n"))
+	require.Equal(t, "to:a@test, b@test", canonicalizeHeaderRelaxed("To", " a@test,\r\n\tb@test\r\n"))
+
+	require.Equal(t, " C\r\nD E\r\n", string(canonicalizeBodyRelaxed([]byte(" C \r\nD \t E\r\n\r\n\r\n"))))
+	require.Empty(t, canonicalizeBodyRelaxed([]byte("\r\n\r\n")))
+}
+
+func TestDKIM_Sign(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	parsed, err := ParseDKIMKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	require.NoError(t, err)
+	require.True(t, key.Equal(parsed))
+
+	_, err = ParseDKIMKey([]byte("not a key"))
+	require.True(t, ErrDKIM.Has(err))
+
+	msg := &Message{
+		From:      mail.Address{Name: "No reply", Address: "noreply@mail.test"},
+		To:        []mail.Address{{Address: "foo@mail.test"}},
+		Subject:   "Hello",
+		PlainText: "Hello  there\n",
+		Boundary:  "boundary",
+	}
+	data, err := msg.Bytes()
+	require.NoError(t, err)
+
+	signer := &DKIMSigner{Domain: "mail.test", Selector: "default", Key: key}
+	header, err := signer.Sign(data, time.Unix(1000, 0))
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(header, "DKIM-Signature: v=1; a=rsa-sha256; c=relaxed/relaxed; d=mail.test; s=default; t=1000; h=from:to:subject:mime-version:content-type:content-transfer-encoding;"))
+
+	_, body := splitMessage(data)
+	bodyHash := sha256.Sum256(canonicalizeBodyRelaxed(body))
+	require.Contains(t, header, "; bh="+base64.StdEncoding.EncodeToString(bodyHash[:])+";")
+
+	// verify the signature with the header added to the message
+	msg.ExtraHeaders = []string{header}
+	signedData, err := msg.Bytes()
+	require.NoError(t, err)
+
+	fields := parseHeaderFields(signedData[:strings.Index(string(signedData), "\r\n\r\n")+2])
+	require.Equal(t, "DKIM-Signature", fields[0].name)
+
+	value := strings.TrimSpace(fields[0].value)
+	i := strings.LastIndex(value, "b=")
+	signature, err := base64.StdEncoding.DecodeString(value[i+2:])
+	require.NoError(t, err)
+
+	var hashed strings.Builder
+	for _, name := range []string{"from", "to", "subject", "mime-version", "content-type", "content-transfer-encoding"} {
+		for _, field := range fields {
+			if strings.EqualFold(field.name, name) {
+				hashed.WriteString(canonicalizeHeaderRelaxed(field.name, field.value) + "\r\n")
+			}
+		}
+	}
+	hashed.WriteString(canonicalizeHeaderRelaxed(fields[0].name, value[:i+2]))
+
+	digest := sha256.Sum256([]byte(hashed.String()))
+	require.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+}
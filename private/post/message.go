@@ -24,9 +24,16 @@ type Message struct {
 	ID        string
 	Date      time.Time
 	ReceiptTo []string
+	ReplyTo   []Address
 
 	PlainText string
 	Parts     []Part
+
+	// ExtraHeaders are complete header fields, such as DKIM-Signature,
+	// which are written before the other headers.
+	ExtraHeaders []string
+	// Boundary is the multipart boundary, a random one is used when it's empty.
+	Boundary string
 }
 
 // Part represent one part of multipart message.
@@ -46,11 +53,17 @@ func (msg *Message) Bytes() (data []byte, err error) {
 	var body bytes.Buffer
 
 	// write headers
+	for _, header := range msg.ExtraHeaders {
+		fmt.Fprintf(&body, "%s\r\n", header)
+	}
 	fmt.Fprintf(&body, "Subject: %v\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
 	fmt.Fprintf(&body, "From: %s\r\n", &msg.From)
 	for _, to := range msg.To {
 		fmt.Fprintf(&body, "To: %s\r\n", &to) // nolint:scopelint
 	}
+	for _, replyTo := range msg.ReplyTo {
+		fmt.Fprintf(&body, "Reply-To: %s\r\n", &replyTo) // nolint:scopelint
+	}
 	for _, recipient := range msg.ReceiptTo {
 		fmt.Fprintf(&body, "Disposition-Notification-To: <%v>\r\n", mime.QEncoding.Encode("utf-8", recipient))
 	}
@@ -91,6 +104,12 @@ func (msg *Message) writeMultipart(body *bytes.Buffer) (err error) {
 	wr := multipart.NewWriter(body)
 	defer func() { err = errs.Combine(err, wr.Close()) }()
 
+	if msg.Boundary != "" {
+		if err := wr.SetBoundary(msg.Boundary); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
 	fmt.Fprintf(body, "Content-Type: multipart/alternative;")
 	fmt.Fprintf(body, "\tboundary=\"%v\"\r\n", wr.Boundary())
 	fmt.Fprintf(body, "\r\n")
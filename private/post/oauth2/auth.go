@@ -16,12 +16,24 @@ import (
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
 )
 
 var (
 	mon = monkit.Package()
+
+	// ErrUnreachable is the error class for failures to get a response from the token endpoint,
+	// including server errors, which may succeed when retried.
+	ErrUnreachable = errs.Class("oauth2 token endpoint unreachable")
+	// ErrRejected is the error class for refresh tokens, which the token endpoint doesn't accept.
+	ErrRejected = errs.Class("oauth2 refresh token rejected")
 )
 
+// expiryDelta is how long before its expiry the token is refreshed, so it doesn't
+// expire while it's being used.
+const expiryDelta = time.Minute
+
 // Auth is XOAUTH2 implementation of smtp.Auth interface.
 type Auth struct {
 	UserEmail string
@@ -85,24 +97,27 @@ func NewTokenStore(creds Credentials, token Token) *TokenStore {
 	}
 }
 
-// Token retrieves token in a thread safe way and refreshes it if needed.
+// Token retrieves token in a thread safe way and refreshes it, when it's about to expire.
 func (s *TokenStore) Token(ctx context.Context) (_ *Token, err error) {
 	defer mon.Task()(&ctx)(&err)
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	token := new(Token)
-	if s.token.Expiry.Before(time.Now()) {
-		var err error
-		token, err = RefreshToken(ctx, s.creds, s.token.RefreshToken)
-		if err != nil {
+	now := time.Now()
+	if s.token.Expiry.Before(now.Add(expiryDelta)) {
+		refreshed, err := RefreshToken(ctx, s.creds, s.token.RefreshToken)
+		switch {
+		case err == nil:
+			s.token = *refreshed
+		case s.token.Expiry.Before(now):
 			return nil, err
 		}
-		s.token = *token
+		// when the refresh fails before the expiry, the current token is still
+		// used and the refresh is retried on the next use
 	}
 
-	*token = s.token
-	return token, nil
+	token := s.token
+	return &token, nil
 }
 
 // RefreshToken is a helper method that refreshes token with given credentials and OUATH2 refresh token.
@@ -126,26 +141,37 @@ func RefreshToken(ctx context.Context, creds Credentials, refreshToken string) (
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, ErrUnreachable.Wrap(err)
 	}
 	defer func() {
 		err = errs.Combine(err, resp.Body.Close())
 	}()
 
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, ErrUnreachable.New("unexpected status: %s", resp.Status)
+	}
+
 	// handle google expires_in field value
 	var t struct {
 		AccessToken  string `json:"access_token"`
 		RefreshToken string `json:"refresh_token"`
 		Type         string `json:"token_type"`
 		Expires      int64  `json:"expires_in"`
+		Error        string `json:"error"`
 	}
 	err = json.NewDecoder(resp.Body).Decode(&t)
 	if err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, ErrRejected.New("unexpected status: %s", resp.Status)
+		}
 		return nil, err
 	}
 
+	if t.Error != "" {
+		return nil, ErrRejected.New("%s", t.Error)
+	}
 	if t.AccessToken == "" {
-		return nil, errs.New("no access token were granted")
+		return nil, ErrRejected.New("no access token were granted")
 	}
 
 	if t.RefreshToken == "" {
@@ -159,3 +185,21 @@ func RefreshToken(ctx context.Context, creds Credentials, refreshToken string) (
 		Expiry:       time.Now().Add(time.Duration(t.Expires * int64(time.Second))),
 	}, nil
 }
+
+// RefreshTokenWithRetry refreshes the token like RefreshToken, but retries up to retries
+// times, doubling the backoff between the attempts, while the token endpoint is unreachable.
+// A rejected refresh token isn't retried.
+func RefreshTokenWithRetry(ctx context.Context, creds Credentials, refreshToken string, retries int, backoff time.Duration) (_ *Token, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for attempt := 0; ; attempt++ {
+		token, err := RefreshToken(ctx, creds, refreshToken)
+		if err == nil || attempt >= retries || !ErrUnreachable.Has(err) {
+			return token, err
+		}
+		if !sync2.Sleep(ctx, backoff) {
+			return nil, errs.Combine(err, ctx.Err())
+		}
+		backoff *= 2
+	}
+}
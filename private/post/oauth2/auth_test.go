@@ -0,0 +1,132 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package oauth2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post/oauth2"
+)
+
+// tokenEndpoint is a fake token endpoint, which fails with the status
+// for the first failures requests and grants a token afterwards.
+type tokenEndpoint struct {
+	failures int32
+	status   int
+	expires  int64
+
+	requests int32
+}
+
+func (endpoint *tokenEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := atomic.AddInt32(&endpoint.requests, 1)
+	if request <= endpoint.failures {
+		w.WriteHeader(endpoint.status)
+		if endpoint.status < http.StatusInternalServerError {
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		}
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   endpoint.expires,
+	})
+}
+
+func TestRefreshTokenWithRetry(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("transient failures", func(t *testing.T) {
+		endpoint := &tokenEndpoint{failures: 2, status: http.StatusServiceUnavailable, expires: 3600}
+		server := httptest.NewServer(endpoint)
+		defer server.Close()
+
+		token, err := oauth2.RefreshTokenWithRetry(ctx, oauth2.Credentials{TokenURI: server.URL}, "refresh", 3, time.Millisecond)
+		require.NoError(t, err)
+		require.Equal(t, "access", token.AccessToken)
+		require.Equal(t, "refresh", token.RefreshToken)
+		require.EqualValues(t, 3, atomic.LoadInt32(&endpoint.requests))
+	})
+
+	t.Run("too many failures", func(t *testing.T) {
+		endpoint := &tokenEndpoint{failures: 10, status: http.StatusBadGateway, expires: 3600}
+		server := httptest.NewServer(endpoint)
+		defer server.Close()
+
+		_, err := oauth2.RefreshTokenWithRetry(ctx, oauth2.Credentials{TokenURI: server.URL}, "refresh", 2, time.Millisecond)
+		require.True(t, oauth2.ErrUnreachable.Has(err), err)
+		require.EqualValues(t, 3, atomic.LoadInt32(&endpoint.requests))
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(&tokenEndpoint{})
+		server.Close()
+
+		_, err := oauth2.RefreshTokenWithRetry(ctx, oauth2.Credentials{TokenURI: server.URL}, "refresh", 1, time.Millisecond)
+		require.True(t, oauth2.ErrUnreachable.Has(err), err)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		endpoint := &tokenEndpoint{failures: 1, status: http.StatusBadRequest, expires: 3600}
+		server := httptest.NewServer(endpoint)
+		defer server.Close()
+
+		_, err := oauth2.RefreshTokenWithRetry(ctx, oauth2.Credentials{TokenURI: server.URL}, "refresh", 3, time.Millisecond)
+		require.True(t, oauth2.ErrRejected.Has(err), err)
+		require.Contains(t, err.Error(), "invalid_grant")
+		require.EqualValues(t, 1, atomic.LoadInt32(&endpoint.requests))
+	})
+}
+
+func TestTokenStoreRefreshesBeforeExpiry(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	endpoint := &tokenEndpoint{failures: 1, status: http.StatusServiceUnavailable, expires: 3600}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	store := oauth2.NewTokenStore(oauth2.Credentials{TokenURI: server.URL}, oauth2.Token{
+		AccessToken:  "expiring",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(30 * time.Second),
+	})
+
+	// the refresh fails, but the token hasn't expired yet
+	token, err := store.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "expiring", token.AccessToken)
+
+	token, err = store.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+	require.True(t, token.Expiry.After(time.Now().Add(time.Hour-time.Minute)))
+
+	// the refreshed token is used until it's about to expire
+	token, err = store.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+	require.EqualValues(t, 2, atomic.LoadInt32(&endpoint.requests))
+
+	// an expired token can't be used, when the refresh fails
+	failing := httptest.NewServer(&tokenEndpoint{failures: 1, status: http.StatusServiceUnavailable})
+	defer failing.Close()
+
+	expired := oauth2.NewTokenStore(oauth2.Credentials{TokenURI: failing.URL}, oauth2.Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(-time.Second),
+	})
+	_, err = expired.Token(ctx)
+	require.True(t, oauth2.ErrUnreachable.Has(err), err)
+}
@@ -10,6 +10,8 @@ import (
 	"net"
 	"net/mail"
 	"net/smtp"
+	"sync"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -20,12 +22,75 @@ type Address = mail.Address
 
 var mon = monkit.Package()
 
+var (
+	// ErrConnection is the error class for failures to connect to the mail server.
+	ErrConnection = errs.Class("mail connection")
+	// ErrTLS is the error class for failures to secure the connection to the mail server.
+	ErrTLS = errs.Class("mail tls")
+	// ErrAuth is the error class for failures to authenticate with the mail server.
+	ErrAuth = errs.Class("mail auth")
+)
+
+// DefaultIdleTimeout is the duration after which idle pooled connections are closed.
+const DefaultIdleTimeout = 30 * time.Second
+
 // SMTPSender is smtp sender.
 type SMTPSender struct {
 	ServerAddress string
 
 	From Address
 	Auth smtp.Auth
+
+	// TLSConfig is used when upgrading the connection with STARTTLS.
+	// When ServerName isn't set, the host of ServerAddress is used.
+	TLSConfig *tls.Config
+	// ForceSTARTTLS makes the sender refuse to send mail when the server
+	// doesn't offer STARTTLS, instead of falling back to plaintext.
+	ForceSTARTTLS bool
+
+	// PoolSize is the maximum number of idle connections kept open for reuse.
+	// When it's zero, a new connection is opened for every message.
+	PoolSize int
+	// IdleTimeout is the duration after which idle pooled connections are
+	// closed, DefaultIdleTimeout is used when it's zero.
+	IdleTimeout time.Duration
+
+	// DialTimeout limits connecting to the server and establishing the session,
+	// including STARTTLS and authentication. Zero means no limit.
+	DialTimeout time.Duration
+	// SendTimeout limits sending a message over an established session. Zero
+	// means no limit.
+	SendTimeout time.Duration
+
+	mu   sync.Mutex
+	idle []idleClient
+}
+
+// idleClient is a pooled connection, which isn't used at the moment.
+type idleClient struct {
+	client *session
+	since  time.Time
+}
+
+// session is an smtp session together with its connection, which allows
+// bounding the smtp commands with deadlines.
+type session struct {
+	*smtp.Client
+	conn net.Conn
+}
+
+// setTimeout bounds the following smtp commands of the session by the timeout
+// and the deadline of ctx, whichever is earlier. When there is neither, the
+// commands aren't bounded.
+func (client *session) setTimeout(ctx context.Context, timeout time.Duration) error {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	if ctxDeadline, ok := ctx.Deadline(); ok && (deadline.IsZero() || ctxDeadline.Before(deadline)) {
+		deadline = ctxDeadline
+	}
+	return client.conn.SetDeadline(deadline)
 }
 
 // FromAddress implements satellite/mail.SMTPSender.
@@ -37,36 +102,287 @@ func (sender *SMTPSender) FromAddress() Address {
 func (sender *SMTPSender) SendEmail(ctx context.Context, msg *Message) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	client, err := smtp.Dial(sender.ServerAddress)
+	if sender.PoolSize <= 0 {
+		client, err := sender.dial(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = sender.send(ctx, client, msg); err != nil {
+			return errs.Combine(err, client.Close())
+		}
+
+		// send quit msg to stop gracefully
+		return client.Quit()
+	}
+
+	client, err := sender.acquire(ctx)
 	if err != nil {
 		return err
 	}
 
-	if err = sender.communicate(ctx, client, msg); err != nil {
+	if err = sender.send(ctx, client, msg); err != nil {
+		// the connection may be in an unknown state, so it's not reused.
 		return errs.Combine(err, client.Close())
 	}
 
+	sender.release(client)
 	return nil
 }
 
-// communicate sends mail via SMTP using provided client and message.
-func (sender *SMTPSender) communicate(ctx context.Context, client *smtp.Client, msg *Message) error {
+// SendBatch sends the messages over a single connection and returns an error for
+// each of them, in the same order. A message rejected by the server doesn't stop
+// the batch. When the connection can't be reset after a failure, a new one is opened
+// for the following messages.
+func (sender *SMTPSender) SendBatch(ctx context.Context, msgs []*Message) (sendErrs []error) {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs = make([]error, len(msgs))
+
+	var client *session
+	for i, msg := range msgs {
+		if client == nil {
+			var err error
+			client, err = sender.connect(ctx)
+			if err != nil {
+				// the remaining messages can't be sent without a connection.
+				for j := i; j < len(msgs); j++ {
+					sendErrs[j] = err
+				}
+				return sendErrs
+			}
+		}
+
+		err := sender.send(ctx, client, msg)
+		if err == nil {
+			continue
+		}
+		sendErrs[i] = err
+
+		// RSET clears the state of the failed message, when the connection is still usable.
+		if client.Reset() != nil {
+			_ = client.Close()
+			client = nil
+		}
+	}
+
+	if client != nil {
+		if sender.PoolSize <= 0 {
+			_ = client.Quit()
+		} else {
+			sender.release(client)
+		}
+	}
+	return sendErrs
+}
+
+// connect returns a pooled connection, when pooling is enabled, or dials a new one.
+func (sender *SMTPSender) connect(ctx context.Context) (*session, error) {
+	if sender.PoolSize <= 0 {
+		return sender.dial(ctx)
+	}
+	return sender.acquire(ctx)
+}
+
+// HealthCheck connects to the smtp server and establishes a session the same
+// way as SendEmail, including STARTTLS and authentication, and quits without
+// sending mail. Pooled connections aren't used, so the whole handshake is checked.
+//
+// Failures are returned as ErrConnection, ErrTLS or ErrAuth.
+func (sender *SMTPSender) HealthCheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := sender.dial(ctx)
+	if err != nil {
+		return err
+	}
+	if err := client.setTimeout(ctx, sender.DialTimeout); err != nil {
+		return errs.Combine(ErrConnection.Wrap(err), client.Close())
+	}
+	return ErrConnection.Wrap(client.Quit())
+}
+
+// Close closes all idle pooled connections.
+func (sender *SMTPSender) Close() error {
+	sender.mu.Lock()
+	idle := sender.idle
+	sender.idle = nil
+	sender.mu.Unlock()
+
+	var group errs.Group
+	for _, conn := range idle {
+		if err := conn.client.setTimeout(context.Background(), sender.SendTimeout); err != nil {
+			group.Add(err, conn.client.Close())
+			continue
+		}
+		group.Add(conn.client.Quit())
+	}
+	return group.Err()
+}
+
+// acquire returns a healthy pooled connection or dials a new one.
+func (sender *SMTPSender) acquire(ctx context.Context) (*session, error) {
+	for {
+		client, ok := sender.takeIdle()
+		if !ok {
+			return sender.dial(ctx)
+		}
+
+		// RSET clears the state left from the previous message and
+		// doubles as a health check for the connection.
+		if err := client.setTimeout(ctx, sender.SendTimeout); err != nil {
+			_ = client.Close()
+			continue
+		}
+		if err := client.Reset(); err != nil {
+			mon.Event("smtp_pool_connection_broken")
+			_ = client.Close()
+			continue
+		}
+
+		mon.Event("smtp_pool_connection_reused")
+		return client, nil
+	}
+}
+
+// takeIdle removes the most recently used idle connection from the pool,
+// closing any connections which have been idle for too long.
+func (sender *SMTPSender) takeIdle() (*session, bool) {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	sender.evictIdle(time.Now())
+
+	if len(sender.idle) == 0 {
+		return nil, false
+	}
+	last := sender.idle[len(sender.idle)-1]
+	sender.idle = sender.idle[:len(sender.idle)-1]
+	return last.client, true
+}
+
+// release returns the connection to the pool or closes it when the pool is full.
+func (sender *SMTPSender) release(client *session) {
+	// idle connections aren't bounded, they are bounded again when they're used.
+	if err := client.conn.SetDeadline(time.Time{}); err != nil {
+		_ = client.Close()
+		return
+	}
+
+	sender.mu.Lock()
+	now := time.Now()
+	sender.evictIdle(now)
+	if len(sender.idle) < sender.PoolSize {
+		sender.idle = append(sender.idle, idleClient{client: client, since: now})
+		client = nil
+	}
+	sender.mu.Unlock()
+
+	if client != nil {
+		_ = client.setTimeout(context.Background(), sender.SendTimeout)
+		_ = client.Quit()
+	}
+}
+
+// evictIdle closes connections which have been idle for longer than the idle timeout.
+// The caller must hold sender.mu.
+func (sender *SMTPSender) evictIdle(now time.Time) {
+	timeout := sender.IdleTimeout
+	if timeout <= 0 {
+		timeout = DefaultIdleTimeout
+	}
+
+	// connections are appended in the order they were released,
+	// so the oldest ones are at the start.
+	evict := 0
+	for evict < len(sender.idle) && now.Sub(sender.idle[evict].since) > timeout {
+		// closing without QUIT, since the server may have already dropped the connection.
+		_ = sender.idle[evict].client.Close()
+		evict++
+	}
+	if evict > 0 {
+		sender.idle = append(sender.idle[:0], sender.idle[evict:]...)
+	}
+}
+
+// dial opens a new connection to the smtp server, upgrades it with STARTTLS
+// and authenticates. The whole handshake is bounded by DialTimeout.
+func (sender *SMTPSender) dial(ctx context.Context) (_ *session, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// suppress error because address should be validated
 	// before creating SMTPSender
 	host, _, _ := net.SplitHostPort(sender.ServerAddress)
 
-	// send smtp hello or ehlo msg and establish connection over tls
-	err := client.StartTLS(&tls.Config{ServerName: host})
+	dialer := net.Dialer{Timeout: sender.DialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", sender.ServerAddress)
 	if err != nil {
-		return err
+		return nil, ErrConnection.Wrap(err)
 	}
 
-	err = client.Auth(sender.Auth)
+	client := &session{conn: conn}
+	if err := client.setTimeout(ctx, sender.DialTimeout); err != nil {
+		return nil, errs.Combine(ErrConnection.Wrap(err), conn.Close())
+	}
+
+	// the greeting of the server is read by NewClient.
+	client.Client, err = smtp.NewClient(conn, host)
 	if err != nil {
-		return err
+		return nil, errs.Combine(ErrConnection.Wrap(err), conn.Close())
 	}
 
-	err = client.Mail(sender.From.Address)
+	if err := sender.hello(client.Client); err != nil {
+		return nil, errs.Combine(err, client.Close())
+	}
+	return client, nil
+}
+
+// hello establishes the smtp session using the provided client.
+func (sender *SMTPSender) hello(client *smtp.Client) error {
+	// suppress error because address should be validated
+	// before creating SMTPSender
+	host, _, _ := net.SplitHostPort(sender.ServerAddress)
+
+	// send smtp hello or ehlo msg and establish connection over tls
+	if err := client.Hello("localhost"); err != nil {
+		return ErrConnection.Wrap(err)
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		tlsConfig := &tls.Config{}
+		if sender.TLSConfig != nil {
+			tlsConfig = sender.TLSConfig.Clone()
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = host
+		}
+
+		err := client.StartTLS(tlsConfig)
+		if err != nil {
+			return ErrTLS.Wrap(err)
+		}
+	} else if sender.ForceSTARTTLS {
+		return ErrTLS.New("smtp server %q does not support STARTTLS", sender.ServerAddress)
+	}
+
+	if sender.Auth != nil {
+		err := client.Auth(sender.Auth)
+		if err != nil {
+			return ErrAuth.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// send sends the message over an established smtp session. The smtp commands
+// are bounded by SendTimeout, which also applies to the commands following
+// the message on the same session, until the timeout is set again.
+func (sender *SMTPSender) send(ctx context.Context, client *session, msg *Message) error {
+	if err := client.setTimeout(ctx, sender.SendTimeout); err != nil {
+		return ErrConnection.Wrap(err)
+	}
+
+	err := client.Mail(sender.From.Address)
 	if err != nil {
 		return err
 	}
@@ -89,13 +405,7 @@ func (sender *SMTPSender) communicate(ctx context.Context, client *smtp.Client,
 		return err
 	}
 
-	err = writeData(data, mess)
-	if err != nil {
-		return err
-	}
-
-	// send quit msg to stop gracefully
-	return client.Quit()
+	return writeData(data, mess)
 }
 
 // writeData ensures that writer will be closed after data is written.
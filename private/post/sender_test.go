@@ -0,0 +1,620 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package post
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+)
+
+func TestSMTPSender_STARTTLS(t *testing.T) {
+	cert, roots := newTestCertificate(t)
+
+	msg := &Message{
+		From:      mail.Address{Address: "noreply@mail.test"},
+		To:        []mail.Address{{Address: "foo@mail.test"}},
+		Subject:   "test",
+		PlainText: "hello",
+	}
+
+	for _, tt := range []struct {
+		name          string
+		offerSTARTTLS bool
+		tlsConfig     *tls.Config
+		forceSTARTTLS bool
+		errText       string
+		delivered     bool
+		deliveredTLS  bool
+	}{
+		{
+			name:          "starttls with custom ca",
+			offerSTARTTLS: true,
+			tlsConfig:     &tls.Config{RootCAs: roots},
+			forceSTARTTLS: true,
+			delivered:     true,
+			deliveredTLS:  true,
+		},
+		{
+			name:          "starttls with insecure skip verify",
+			offerSTARTTLS: true,
+			tlsConfig:     &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // test server uses a self-signed certificate
+			forceSTARTTLS: true,
+			delivered:     true,
+			deliveredTLS:  true,
+		},
+		{
+			name:          "starttls with unknown ca",
+			offerSTARTTLS: true,
+			forceSTARTTLS: true,
+			errText:       "certificate",
+		},
+		{
+			name:          "no starttls, forced",
+			forceSTARTTLS: true,
+			errText:       "does not support STARTTLS",
+		},
+		{
+			name:      "no starttls, not forced",
+			delivered: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestSMTPServer(t, cert, tt.offerSTARTTLS)
+
+			sender := &SMTPSender{
+				ServerAddress: server.Addr(),
+				From:          msg.From,
+				TLSConfig:     tt.tlsConfig,
+				ForceSTARTTLS: tt.forceSTARTTLS,
+			}
+
+			err := sender.SendEmail(context.Background(), msg)
+			if tt.errText != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errText)
+			} else {
+				require.NoError(t, err)
+			}
+
+			delivered, deliveredTLS := server.Delivered()
+			require.Equal(t, tt.delivered, delivered)
+			require.Equal(t, tt.deliveredTLS, deliveredTLS)
+		})
+	}
+}
+
+func TestSMTPSender_Pool(t *testing.T) {
+	cert, _ := newTestCertificate(t)
+
+	msg := &Message{
+		From:      mail.Address{Address: "noreply@mail.test"},
+		To:        []mail.Address{{Address: "foo@mail.test"}},
+		Subject:   "test",
+		PlainText: "hello",
+	}
+
+	t.Run("without pool", func(t *testing.T) {
+		server := newTestSMTPServer(t, cert, false)
+		sender := &SMTPSender{ServerAddress: server.Addr(), From: msg.From}
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, sender.SendEmail(context.Background(), msg))
+		}
+
+		deliveries, connections := server.Stats()
+		require.Equal(t, 3, deliveries)
+		require.Equal(t, 3, connections)
+	})
+
+	t.Run("reuses connections", func(t *testing.T) {
+		server := newTestSMTPServer(t, cert, false)
+		sender := &SMTPSender{ServerAddress: server.Addr(), From: msg.From, PoolSize: 1}
+		defer func() { require.NoError(t, sender.Close()) }()
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, sender.SendEmail(context.Background(), msg))
+		}
+
+		deliveries, connections := server.Stats()
+		require.Equal(t, 3, deliveries)
+		require.Equal(t, 1, connections)
+	})
+
+	t.Run("replaces broken connections", func(t *testing.T) {
+		server := newTestSMTPServer(t, cert, false)
+		server.closeAfterData = true
+		sender := &SMTPSender{ServerAddress: server.Addr(), From: msg.From, PoolSize: 1}
+		defer func() { _ = sender.Close() }()
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, sender.SendEmail(context.Background(), msg))
+		}
+
+		deliveries, connections := server.Stats()
+		require.Equal(t, 3, deliveries)
+		require.Equal(t, 3, connections)
+	})
+
+	t.Run("evicts idle connections", func(t *testing.T) {
+		server := newTestSMTPServer(t, cert, false)
+		sender := &SMTPSender{ServerAddress: server.Addr(), From: msg.From, PoolSize: 1, IdleTimeout: time.Nanosecond}
+		defer func() { require.NoError(t, sender.Close()) }()
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, sender.SendEmail(context.Background(), msg))
+			time.Sleep(time.Millisecond)
+		}
+
+		deliveries, connections := server.Stats()
+		require.Equal(t, 2, deliveries)
+		require.Equal(t, 2, connections)
+	})
+}
+
+func TestSMTPSender_SendBatch(t *testing.T) {
+	cert, _ := newTestCertificate(t)
+
+	newMessage := func(to string) *Message {
+		return &Message{
+			From:      mail.Address{Address: "noreply@mail.test"},
+			To:        []mail.Address{{Address: to}},
+			Subject:   "test",
+			PlainText: "hello",
+		}
+	}
+	msgs := []*Message{
+		newMessage("foo@mail.test"),
+		newMessage("rejected@mail.test"),
+		newMessage("bar@mail.test"),
+	}
+
+	for _, poolSize := range []int{0, 1} {
+		server := newTestSMTPServer(t, cert, false)
+		server.rejectRecipient = "rejected@mail.test"
+		sender := &SMTPSender{ServerAddress: server.Addr(), From: msgs[0].From, PoolSize: poolSize}
+
+		sendErrs := sender.SendBatch(context.Background(), msgs)
+		require.Len(t, sendErrs, 3)
+		require.NoError(t, sendErrs[0])
+		var protoErr *textproto.Error
+		require.True(t, errors.As(sendErrs[1], &protoErr), sendErrs[1])
+		require.Equal(t, 550, protoErr.Code)
+		require.NoError(t, sendErrs[2])
+
+		require.NoError(t, sender.Close())
+
+		deliveries, connections := server.Stats()
+		require.Equal(t, 2, deliveries, "pool size %d", poolSize)
+		require.Equal(t, 1, connections, "pool size %d", poolSize)
+	}
+
+	t.Run("connection refused", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		address := listener.Addr().String()
+		require.NoError(t, listener.Close())
+
+		sender := &SMTPSender{ServerAddress: address, From: msgs[0].From}
+		for _, err := range sender.SendBatch(context.Background(), msgs) {
+			require.True(t, ErrConnection.Has(err), err)
+		}
+	})
+}
+
+func TestSMTPSender_HealthCheck(t *testing.T) {
+	cert, roots := newTestCertificate(t)
+
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddress := closedListener.Addr().String()
+	require.NoError(t, closedListener.Close())
+
+	for _, tt := range []struct {
+		name          string
+		offerSTARTTLS bool
+		tlsConfig     *tls.Config
+		password      string
+		address       string
+		errClass      *errs.Class
+	}{
+		{
+			name:          "starttls and auth",
+			offerSTARTTLS: true,
+			tlsConfig:     &tls.Config{RootCAs: roots},
+			password:      "secret",
+		},
+		{
+			name:     "connection refused",
+			address:  closedAddress,
+			errClass: &ErrConnection,
+		},
+		{
+			name:          "unknown ca",
+			offerSTARTTLS: true,
+			errClass:      &ErrTLS,
+		},
+		{
+			name:          "wrong password",
+			offerSTARTTLS: true,
+			tlsConfig:     &tls.Config{RootCAs: roots},
+			password:      "other",
+			errClass:      &ErrAuth,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestSMTPServer(t, cert, tt.offerSTARTTLS)
+			server.password = tt.password
+
+			address := server.Addr()
+			if tt.address != "" {
+				address = tt.address
+			}
+
+			sender := &SMTPSender{
+				ServerAddress: address,
+				From:          mail.Address{Address: "noreply@mail.test"},
+				Auth:          smtp.PlainAuth("", "user", "secret", "127.0.0.1"),
+				TLSConfig:     tt.tlsConfig,
+				ForceSTARTTLS: true,
+			}
+
+			err := sender.HealthCheck(context.Background())
+			if tt.errClass != nil {
+				require.Error(t, err)
+				require.True(t, tt.errClass.Has(err), err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			delivered, _ := server.Delivered()
+			require.False(t, delivered)
+		})
+	}
+}
+
+func TestSMTPSender_Timeouts(t *testing.T) {
+	msg := &Message{
+		From:      mail.Address{Address: "noreply@mail.test"},
+		To:        []mail.Address{{Address: "foo@mail.test"}},
+		Subject:   "test",
+		PlainText: "hello",
+	}
+
+	const timeout = 200 * time.Millisecond
+
+	requireTimeout := func(t *testing.T, err error, elapsed time.Duration) {
+		require.Error(t, err)
+		var netErr net.Error
+		require.True(t, errors.As(err, &netErr), err)
+		require.True(t, netErr.Timeout(), err)
+		require.Less(t, elapsed, 10*timeout)
+	}
+
+	t.Run("unresponsive server", func(t *testing.T) {
+		// the listener accepts connections, but never responds.
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = listener.Close() })
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Read(make([]byte, 1))
+			_ = conn.Close()
+		}()
+		defer wg.Wait()
+
+		sender := &SMTPSender{
+			ServerAddress: listener.Addr().String(),
+			From:          mail.Address{Address: "noreply@mail.test"},
+			DialTimeout:   timeout,
+		}
+
+		start := time.Now()
+		err = sender.SendEmail(context.Background(), msg)
+		requireTimeout(t, err, time.Since(start))
+		require.True(t, ErrConnection.Has(err), err)
+	})
+
+	for _, poolSize := range []int{0, 1} {
+		poolSize := poolSize
+		t.Run(fmt.Sprintf("stuck send, pool size %d", poolSize), func(t *testing.T) {
+			server := newTestSMTPServer(t, tls.Certificate{}, false)
+			server.hangOnMail = true
+
+			sender := &SMTPSender{
+				ServerAddress: server.Addr(),
+				From:          mail.Address{Address: "noreply@mail.test"},
+				PoolSize:      poolSize,
+				DialTimeout:   timeout,
+				SendTimeout:   timeout,
+			}
+			defer func() { require.NoError(t, sender.Close()) }()
+
+			start := time.Now()
+			err := sender.SendEmail(context.Background(), msg)
+			requireTimeout(t, err, time.Since(start))
+
+			// the timed out connection isn't pooled.
+			sender.mu.Lock()
+			require.Empty(t, sender.idle)
+			sender.mu.Unlock()
+		})
+	}
+
+	t.Run("context deadline", func(t *testing.T) {
+		server := newTestSMTPServer(t, tls.Certificate{}, false)
+		server.hangOnMail = true
+
+		sender := &SMTPSender{
+			ServerAddress: server.Addr(),
+			From:          mail.Address{Address: "noreply@mail.test"},
+			SendTimeout:   time.Hour,
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		start := time.Now()
+		err := sender.SendEmail(ctx, msg)
+		requireTimeout(t, err, time.Since(start))
+	})
+}
+
+// testSMTPServer is a minimal smtp server, which accepts any mail.
+type testSMTPServer struct {
+	listener      net.Listener
+	cert          tls.Certificate
+	offerSTARTTLS bool
+
+	// closeAfterData makes the server drop the connection after every delivered mail.
+	closeAfterData bool
+	// password makes the server offer AUTH PLAIN and accept only this password.
+	password string
+	// rejectRecipient makes the server reject mail to this address.
+	rejectRecipient string
+	// hangOnMail makes the server stop responding once a mail is started.
+	hangOnMail bool
+
+	mu           sync.Mutex
+	delivered    bool
+	deliveredTLS bool
+	deliveries   int
+	connections  int
+}
+
+func newTestSMTPServer(t *testing.T, cert tls.Certificate, offerSTARTTLS bool) *testSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &testSMTPServer{
+		listener:      listener,
+		cert:          cert,
+		offerSTARTTLS: offerSTARTTLS,
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			server.mu.Lock()
+			server.connections++
+			server.mu.Unlock()
+
+			server.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		wg.Wait()
+	})
+
+	return server
+}
+
+// Addr returns the address the server is listening on.
+func (server *testSMTPServer) Addr() string {
+	return server.listener.Addr().String()
+}
+
+// Delivered returns whether a mail was delivered and whether that happened over tls.
+func (server *testSMTPServer) Delivered() (delivered, overTLS bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.delivered, server.deliveredTLS
+}
+
+// Stats returns the number of delivered mails and accepted connections.
+func (server *testSMTPServer) Stats() (deliveries, connections int) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.deliveries, server.connections
+}
+
+func (server *testSMTPServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	overTLS := false
+	text := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) bool {
+		return text.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 localhost ESMTP") {
+		return
+	}
+
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			lines := []string{"localhost"}
+			if server.offerSTARTTLS && !overTLS {
+				lines = append(lines, "STARTTLS")
+			}
+			if server.password != "" {
+				lines = append(lines, "AUTH PLAIN")
+			}
+			for i, line := range lines {
+				separator := "-"
+				if i == len(lines)-1 {
+					separator = " "
+				}
+				if !reply("250%s%s", separator, line) {
+					return
+				}
+			}
+		case "AUTH":
+			fields := strings.Fields(line)
+			credentials := ""
+			if len(fields) == 3 {
+				decoded, err := base64.StdEncoding.DecodeString(fields[2])
+				if err == nil {
+					credentials = string(decoded)
+				}
+			}
+			if server.password == "" || !strings.HasSuffix(credentials, "\x00"+server.password) {
+				if !reply("535 authentication failed") {
+					return
+				}
+				continue
+			}
+			if !reply("235 authenticated") {
+				return
+			}
+		case "STARTTLS":
+			if !server.offerSTARTTLS || overTLS {
+				if !reply("502 not supported") {
+					return
+				}
+				continue
+			}
+			if !reply("220 ready to start tls") {
+				return
+			}
+
+			tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{server.cert}})
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, overTLS = tlsConn, true
+			text = textproto.NewConn(conn)
+		case "RCPT":
+			if server.rejectRecipient != "" && strings.Contains(line, "<"+server.rejectRecipient+">") {
+				if !reply("550 no such user") {
+					return
+				}
+				continue
+			}
+			if !reply("250 ok") {
+				return
+			}
+		case "MAIL", "RSET", "NOOP":
+			if command == "MAIL" && server.hangOnMail {
+				// wait for the client to give up.
+				_, _ = text.ReadLine()
+				return
+			}
+			if !reply("250 ok") {
+				return
+			}
+		case "DATA":
+			if !reply("354 go ahead") {
+				return
+			}
+			if _, err := text.ReadDotBytes(); err != nil {
+				return
+			}
+
+			server.mu.Lock()
+			server.delivered, server.deliveredTLS = true, overTLS
+			server.deliveries++
+			server.mu.Unlock()
+
+			if !reply("250 ok") || server.closeAfterData {
+				return
+			}
+		case "QUIT":
+			_ = reply("221 bye")
+			return
+		default:
+			if !reply("502 unknown command") {
+				return
+			}
+		}
+	}
+}
+
+// newTestCertificate creates a self-signed certificate for 127.0.0.1 and a pool containing it.
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, roots
+}
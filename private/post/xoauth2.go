@@ -0,0 +1,100 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package post
+
+import (
+	"context"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// TokenSource provides the bearer token used for XOAUTH2 authentication.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+// Token returns the token.
+func (token StaticToken) Token(ctx context.Context) (string, error) {
+	if token == "" {
+		return "", errs.New("empty xoauth2 token")
+	}
+	return string(token), nil
+}
+
+// FileTokenSource reads the token from a file and reads it again once RefreshInterval
+// has passed, so the token can be rotated by an external process.
+type FileTokenSource struct {
+	Path            string
+	RefreshInterval time.Duration
+
+	mu     sync.Mutex
+	token  string
+	loaded time.Time
+}
+
+// Token returns the token, reading the file when needed.
+func (source *FileTokenSource) Token(ctx context.Context) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.token != "" && time.Since(source.loaded) < source.RefreshInterval {
+		return source.token, nil
+	}
+
+	data, err := os.ReadFile(source.Path)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errs.New("empty xoauth2 token in %q", source.Path)
+	}
+
+	source.token, source.loaded = token, time.Now()
+	return source.token, nil
+}
+
+// XOAUTH2Auth implements the XOAUTH2 SASL mechanism with a bearer token,
+// without assuming how the token is issued.
+type XOAUTH2Auth struct {
+	Username string
+	Tokens   TokenSource
+}
+
+// Start begins an authentication with a server.
+func (auth *XOAUTH2Auth) Start(server *smtp.ServerInfo) (proto string, toServer []byte, err error) {
+	ctx := context.TODO()
+	defer mon.Task()(&ctx)(&err)
+	if !server.TLS {
+		return "", nil, errs.New("unencrypted connection")
+	}
+
+	token, err := auth.Tokens.Token(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return "XOAUTH2", xoauth2Payload(auth.Username, token), nil
+}
+
+// Next sends an empty response to the error challenge, so the server can
+// complete the exchange with the final status.
+func (auth *XOAUTH2Auth) Next(fromServer []byte, more bool) (toServer []byte, err error) {
+	if more {
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+// xoauth2Payload returns the XOAUTH2 initial client response.
+func xoauth2Payload(username, token string) []byte {
+	return []byte("user=" + username + "\x01auth=Bearer " + token + "\x01\x01")
+}
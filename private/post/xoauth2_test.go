@@ -0,0 +1,68 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package post
+
+import (
+	"context"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestXOAUTH2Auth(t *testing.T) {
+	auth := &XOAUTH2Auth{
+		Username: "someone@example.test",
+		Tokens:   StaticToken("ya29.token"),
+	}
+
+	proto, toServer, err := auth.Start(&smtp.ServerInfo{Name: "smtp.example.test", TLS: true})
+	require.NoError(t, err)
+	require.Equal(t, "XOAUTH2", proto)
+	require.Equal(t, []byte("user=someone@example.test\x01auth=Bearer ya29.token\x01\x01"), toServer)
+
+	// the error challenge is answered with an empty response
+	toServer, err = auth.Next([]byte(`{"status":"401"}`), true)
+	require.NoError(t, err)
+	require.NotNil(t, toServer)
+	require.Empty(t, toServer)
+
+	toServer, err = auth.Next(nil, false)
+	require.NoError(t, err)
+	require.Nil(t, toServer)
+
+	_, _, err = auth.Start(&smtp.ServerInfo{Name: "smtp.example.test"})
+	require.Error(t, err)
+
+	_, _, err = (&XOAUTH2Auth{Username: "someone@example.test", Tokens: StaticToken("")}).Start(&smtp.ServerInfo{TLS: true})
+	require.Error(t, err)
+}
+
+func TestFileTokenSource(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0600))
+
+	source := &FileTokenSource{Path: path, RefreshInterval: time.Hour}
+	token, err := source.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	// the token is cached until the refresh interval passes
+	require.NoError(t, os.WriteFile(path, []byte("second\n"), 0600))
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	source.loaded = time.Now().Add(-2 * time.Hour)
+	token, err = source.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "second", token)
+
+	_, err = (&FileTokenSource{Path: filepath.Join(t.TempDir(), "missing")}).Token(ctx)
+	require.Error(t, err)
+}
@@ -51,6 +51,7 @@ import (
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketsweeper"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodestats"
@@ -148,6 +149,10 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketSweeper struct {
+		Chore *bucketsweeper.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		NodeTally        *nodetally.Service
@@ -611,6 +616,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.BucketSweeper.Chore = peer.BucketSweeper.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.NodeTally = peer.Accounting.NodeTally
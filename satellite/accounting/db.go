@@ -144,6 +144,8 @@ type BucketUsagePage struct {
 type BucketUsageRollup struct {
 	ProjectID  uuid.UUID `json:"projectID"`
 	BucketName string    `json:"bucketName"`
+	// CostCenter is the cost center, which the usage of the bucket is allocated to.
+	CostCenter string `json:"costCenter"`
 
 	TotalStoredData float64 `json:"totalStoredData"`
 
@@ -812,6 +812,54 @@ func TestUsageRollups(t *testing.T) {
 	})
 }
 
+func TestBucketUsageRollupsCostCenter(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		now := time.Now()
+
+		for _, name := range []string{"finance", "unallocated", "soft-deleted"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, name))
+		}
+		require.NoError(t, sat.DB.Buckets().UpdateBucketCostCenter(ctx, []byte("finance"), projectID, "cc-finance"))
+		require.NoError(t, sat.DB.Buckets().UpdateBucketCostCenter(ctx, []byte("soft-deleted"), projectID, "cc-finance"))
+		require.NoError(t, sat.DB.Buckets().SoftDeleteBucket(ctx, []byte("soft-deleted"), projectID, now))
+
+		// the usage of a deleted bucket remains in the report, but isn't allocated
+		tallies := map[metabase.BucketLocation]*accounting.BucketTally{}
+		for _, name := range []string{"finance", "unallocated", "soft-deleted", "deleted"} {
+			location := metabase.BucketLocation{ProjectID: projectID, BucketName: name}
+			tallies[location] = &accounting.BucketTally{
+				BucketLocation: location,
+				ObjectCount:    1,
+				TotalSegments:  1,
+				TotalBytes:     memory.GB.Int64(),
+			}
+		}
+		require.NoError(t, sat.DB.ProjectAccounting().SaveTallies(ctx, now.Add(-30*time.Minute), tallies))
+
+		rollups, err := sat.DB.ProjectAccounting().GetBucketUsageRollups(ctx, projectID, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+
+		costCenters := map[string]string{}
+		for _, rollup := range rollups {
+			costCenters[rollup.BucketName] = rollup.CostCenter
+		}
+		require.Equal(t, map[string]string{
+			"finance":      "cc-finance",
+			"unallocated":  "",
+			"soft-deleted": "",
+			"deleted":      "",
+		}, costCenters)
+
+		rollup, err := sat.DB.ProjectAccounting().GetSingleBucketUsageRollup(ctx, projectID, "finance", now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, "cc-finance", rollup.CostCenter)
+	})
+}
+
 func TestProjectUsage_FreeUsedStorageSpace(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/jitter"
 	"storj.io/storj/satellite/accounting"
 )
 
@@ -19,6 +20,7 @@ import (
 type Config struct {
 	Interval      time.Duration `help:"how frequently rollup should run" releaseDefault:"24h" devDefault:"120s" testDefault:"$TESTINTERVAL"`
 	DeleteTallies bool          `help:"option for deleting tallies after they are rolled up" default:"true"`
+	StartJitter   float64       `help:"fraction of the interval by which the first run is randomly delayed, negative uses chore-jitter.fraction" default:"-1"`
 }
 
 // Service is the rollup service for totalling data on storage nodes on daily intervals.
@@ -26,6 +28,7 @@ type Config struct {
 // architecture: Chore
 type Service struct {
 	logger          *zap.Logger
+	startDelay      *jitter.Delay
 	Loop            *sync2.Cycle
 	sdb             accounting.StoragenodeAccounting
 	deleteTallies   bool
@@ -33,12 +36,13 @@ type Service struct {
 }
 
 // New creates a new rollup service.
-func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, interval time.Duration, deleteTallies bool, orderExpiration time.Duration) *Service {
+func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, config Config, orderExpiration time.Duration) *Service {
 	return &Service{
 		logger:          logger,
-		Loop:            sync2.NewCycle(interval),
+		startDelay:      jitter.NewDelay(config.Interval, config.StartJitter),
+		Loop:            sync2.NewCycle(config.Interval),
 		sdb:             sdb,
-		deleteTallies:   deleteTallies,
+		deleteTallies:   config.DeleteTallies,
 		OrderExpiration: orderExpiration,
 	}
 }
@@ -46,6 +50,11 @@ func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, interval time
 // Run the Rollup loop.
 func (r *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	if !r.startDelay.Wait(ctx) {
+		return ctx.Err()
+	}
+
 	return r.Loop.Run(ctx, func(ctx context.Context) error {
 		err := r.Rollup(ctx)
 		if err != nil {
@@ -57,6 +66,7 @@ func (r *Service) Run(ctx context.Context) (err error) {
 
 // Close stops the service and releases any resources.
 func (r *Service) Close() error {
+	r.startDelay.Close()
 	r.Loop.Close()
 	return nil
 }
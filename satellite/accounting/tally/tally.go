@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/jitter"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/metabase"
 )
@@ -31,15 +32,18 @@ type Config struct {
 
 	ListLimit          int           `help:"how many objects to query in a batch" default:"2500"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+
+	StartJitter float64 `help:"fraction of the interval by which the first run is randomly delayed, negative uses chore-jitter.fraction" default:"-1"`
 }
 
 // Service is the tally service for data stored on each storage node.
 //
 // architecture: Chore
 type Service struct {
-	log    *zap.Logger
-	config Config
-	Loop   *sync2.Cycle
+	log        *zap.Logger
+	config     Config
+	startDelay *jitter.Delay
+	Loop       *sync2.Cycle
 
 	metabase                *metabase.DB
 	liveAccounting          accounting.Cache
@@ -51,9 +55,10 @@ type Service struct {
 // New creates a new tally Service.
 func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting accounting.Cache, metabase *metabase.DB, config Config) *Service {
 	return &Service{
-		log:    log,
-		config: config,
-		Loop:   sync2.NewCycle(config.Interval),
+		log:        log,
+		config:     config,
+		startDelay: jitter.NewDelay(config.Interval, config.StartJitter),
+		Loop:       sync2.NewCycle(config.Interval),
 
 		metabase:                metabase,
 		liveAccounting:          liveAccounting,
@@ -67,6 +72,10 @@ func New(log *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.P
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if !service.startDelay.Wait(ctx) {
+		return ctx.Err()
+	}
+
 	return service.Loop.Run(ctx, func(ctx context.Context) error {
 		err := service.Tally(ctx)
 		if err != nil {
@@ -78,6 +87,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 
 // Close stops the service and releases any resources.
 func (service *Service) Close() error {
+	service.startDelay.Close()
 	service.Loop.Close()
 	return nil
 }
@@ -104,6 +104,37 @@ func (server *Server) deleteGeofenceForBucket(w http.ResponseWriter, r *http.Req
 	server.updateBucket(w, r, storj.EveryCountry)
 }
 
+// setBucketFrozen sets whether uploads to the bucket are rejected.
+func (server *Server) setBucketFrozen(w http.ResponseWriter, r *http.Request, frozen bool) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.UpdateBucketFrozen(ctx, bucket, project.UUID, frozen)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusBadRequest)
+		} else {
+			sendJSONError(w, "unable to update bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (server *Server) freezeBucket(w http.ResponseWriter, r *http.Request) {
+	server.setBucketFrozen(w, r, true)
+}
+
+func (server *Server) unfreezeBucket(w http.ResponseWriter, r *http.Request) {
+	server.setBucketFrozen(w, r, false)
+}
+
 func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -113,3 +113,39 @@ func TestAdminBucketGeofenceAPI(t *testing.T) {
 		}
 	})
 }
+
+func TestAdminBucketFreezeAPI(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplink := planet.Uplinks[0]
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "bucket"))
+
+		freezeURL := fmt.Sprintf("http://%s/api/projects/%s/buckets/bucket/freeze", address, projectID)
+		assertReq(ctx, t, freezeURL, "POST", "", http.StatusOK, "", sat.Config.Console.AuthToken)
+
+		bucket, err := sat.DB.Buckets().GetMinimalBucket(ctx, []byte("bucket"), projectID)
+		require.NoError(t, err)
+		require.True(t, bucket.Frozen)
+
+		assertReq(ctx, t, freezeURL, "DELETE", "", http.StatusOK, "", sat.Config.Console.AuthToken)
+
+		bucket, err = sat.DB.Buckets().GetMinimalBucket(ctx, []byte("bucket"), projectID)
+		require.NoError(t, err)
+		require.False(t, bucket.Frozen)
+
+		missingURL := fmt.Sprintf("http://%s/api/projects/%s/buckets/non-existent/freeze", address, projectID)
+		assertReq(ctx, t, missingURL, "POST", "", http.StatusBadRequest, `{"error":"bucket does not exist","detail":""}`, sat.Config.Console.AuthToken)
+	})
+}
@@ -111,6 +111,8 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/freeze", server.freezeBucket).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/freeze", server.unfreezeBucket).Methods("DELETE")
 	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	api.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	api.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
@@ -43,6 +43,8 @@ const (
 	eventUploadInWebClicked         = "Upload In Web Clicked"
 	eventNewProjectClicked          = "New Project Clicked"
 	eventLogoutClicked              = "Logout Clicked"
+	eventBucketCreated              = "Bucket Created"
+	eventBucketDeleted              = "Bucket Deleted"
 )
 
 var (
@@ -374,3 +376,34 @@ func (service *Service) TrackCreditCardAdded(userID uuid.UUID, email string) {
 	})
 
 }
+
+// TrackBucketCreated sends a "Bucket Created" event to Segment.
+func (service *Service) TrackBucketCreated(projectID uuid.UUID, userAgent string, partnerID uuid.UUID) {
+	service.trackBucketEvent(eventBucketCreated, projectID, userAgent, partnerID)
+}
+
+// TrackBucketDeleted sends a "Bucket Deleted" event to Segment.
+func (service *Service) TrackBucketDeleted(projectID uuid.UUID, userAgent string, partnerID uuid.UUID) {
+	service.trackBucketEvent(eventBucketDeleted, projectID, userAgent, partnerID)
+}
+
+// trackBucketEvent sends a bucket lifecycle event to Segment. Buckets are managed with
+// API keys, which don't identify a user, so the events are attributed to the project.
+func (service *Service) trackBucketEvent(eventName string, projectID uuid.UUID, userAgent string, partnerID uuid.UUID) {
+	if !service.config.Enabled {
+		return
+	}
+
+	props := segment.NewProperties()
+	props.Set("project_id", projectID.String())
+	props.Set("user_agent", userAgent)
+	if !partnerID.IsZero() {
+		props.Set("partner_id", partnerID.String())
+	}
+
+	service.enqueueMessage(segment.Track{
+		AnonymousId: projectID.String(),
+		Event:       service.satelliteName + " " + eventName,
+		Properties:  props,
+	})
+}
@@ -431,6 +431,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Overlay.Service,
 			peer.DB.Attribution(),
 			peer.Marketing.PartnersService,
+			peer.Analytics.Service,
 			peer.DB.PeerIdentities(),
 			peer.DB.Console().APIKeys(),
 			peer.Accounting.ProjectUsage,
@@ -449,6 +450,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
+			Run:   peer.Metainfo.Endpoint.Run,
 			Close: peer.Metainfo.Endpoint.Close,
 		})
 	}
@@ -465,15 +467,16 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup mailservice
-		peer.Mail.Service, err = setupMailService(peer.Log, *config)
+		peer.Mail.Service, err = setupMailService(peer.Log, db, *config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "mail:service",
-			Close: peer.Mail.Service.Close,
+			Close: closeMailService(peer.Mail.Service, config.Mail.Queue.DrainTimeout),
 		})
+		peer.Debug.Server.Panel.Add(mailServiceDebugButtons(peer.Mail.Service))
 	}
 
 	{ // setup payments
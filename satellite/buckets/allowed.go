@@ -0,0 +1,38 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"strings"
+
+	"storj.io/common/macaroon"
+)
+
+// AllowedPrefixWildcard marks an allowed bucket of an API key caveat as a bucket name prefix,
+// e.g. an allowed bucket "logs-*" allows every bucket whose name starts with "logs-".
+// Bucket names can't contain it, so it never matches a bucket by its exact name.
+//
+// Only the bucket listing evaluates the prefixes, the macaroon checks of requests for
+// a specific bucket still compare the allowed buckets by their exact names.
+const AllowedPrefixWildcard = "*"
+
+// IsAllowed returns whether the bucket is allowed by the allowed buckets,
+// either by its exact name or by an allowed bucket name prefix.
+func IsAllowed(allowed macaroon.AllowedBuckets, bucketName []byte) bool {
+	if allowed.All {
+		return true
+	}
+	if _, ok := allowed.Buckets[string(bucketName)]; ok {
+		return true
+	}
+	for bucket := range allowed.Buckets {
+		if !strings.HasSuffix(bucket, AllowedPrefixWildcard) {
+			continue
+		}
+		if strings.HasPrefix(string(bucketName), strings.TrimSuffix(bucket, AllowedPrefixWildcard)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/macaroon"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestIsAllowed(t *testing.T) {
+	exact := macaroon.AllowedBuckets{Buckets: map[string]struct{}{"logs": {}}}
+	prefix := macaroon.AllowedBuckets{Buckets: map[string]struct{}{"logs-*": {}}}
+	all := macaroon.AllowedBuckets{All: true}
+
+	for _, tt := range []struct {
+		allowed macaroon.AllowedBuckets
+		bucket  string
+		want    bool
+	}{
+		{exact, "logs", true},
+		{exact, "logs-a", false},
+		{exact, "other", false},
+		{prefix, "logs-a", true},
+		{prefix, "logs-", true},
+		{prefix, "logs", false},
+		{prefix, "other-logs-a", false},
+		{all, "anything", true},
+		{macaroon.AllowedBuckets{}, "logs", false},
+	} {
+		require.Equal(t, tt.want, buckets.IsAllowed(tt.allowed, []byte(tt.bucket)), "%v %q", tt.allowed, tt.bucket)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// Audited bucket mutations. Renaming a bucket, or transferring it to another project,
+// is audited as deleting the bucket with its old name or from its old project and
+// creating it with the new one.
+const (
+	AuditActionCreate  = "create"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+)
+
+// AuditRecord records who created, deleted or restored a bucket, for the security audit trail.
+type AuditRecord struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	BucketName []byte
+	// Action is the audited mutation, e.g. AuditActionCreate.
+	Action string
+	// APIKeyID is the id of the API key the mutation was made with.
+	APIKeyID uuid.UUID
+	// APIKeyHead is the head of the macaroon, which identifies the key without its secret.
+	APIKeyHead []byte
+	CreatedAt  time.Time
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"github.com/zeebo/errs"
+)
+
+const (
+	// MaxCORSRules is the maximum number of CORS rules a bucket can have.
+	MaxCORSRules = 100
+	// MaxCORSMaxAgeSeconds is the maximum time a preflight response may be cached for.
+	MaxCORSMaxAgeSeconds = 24 * 60 * 60
+)
+
+// ErrInvalidCORS is returned when a bucket CORS configuration is invalid.
+var ErrInvalidCORS = errs.Class("invalid bucket CORS configuration")
+
+// corsMethods are the methods, which CORS rules may allow.
+var corsMethods = map[string]struct{}{
+	"GET":    {},
+	"PUT":    {},
+	"POST":   {},
+	"DELETE": {},
+	"HEAD":   {},
+}
+
+// CORSRule describes the cross-origin requests allowed by a bucket.
+type CORSRule struct {
+	// AllowedOrigins are the origins, which may access the bucket, "*" allows any origin.
+	AllowedOrigins []string `json:"allowed_origins"`
+	// AllowedMethods are the HTTP methods the origins may use.
+	AllowedMethods []string `json:"allowed_methods"`
+	// AllowedHeaders are the headers allowed in preflight requests.
+	AllowedHeaders []string `json:"allowed_headers,omitempty"`
+	// MaxAgeSeconds is how long the browser may cache the preflight response.
+	MaxAgeSeconds int `json:"max_age_seconds,omitempty"`
+}
+
+// ValidateCORS checks whether the CORS rules are valid.
+func ValidateCORS(rules []CORSRule) error {
+	if len(rules) > MaxCORSRules {
+		return ErrInvalidCORS.New("at most %d rules are allowed, got %d", MaxCORSRules, len(rules))
+	}
+	for i, rule := range rules {
+		if len(rule.AllowedOrigins) == 0 {
+			return ErrInvalidCORS.New("rule %d must allow at least one origin", i)
+		}
+		for _, origin := range rule.AllowedOrigins {
+			if origin == "" {
+				return ErrInvalidCORS.New("rule %d has an empty origin", i)
+			}
+		}
+		if len(rule.AllowedMethods) == 0 {
+			return ErrInvalidCORS.New("rule %d must allow at least one method", i)
+		}
+		for _, method := range rule.AllowedMethods {
+			if _, ok := corsMethods[method]; !ok {
+				return ErrInvalidCORS.New("rule %d has unsupported method %q", i, method)
+			}
+		}
+		if rule.MaxAgeSeconds < 0 || rule.MaxAgeSeconds > MaxCORSMaxAgeSeconds {
+			return ErrInvalidCORS.New("rule %d max age must be between 0 and %d seconds, got %d", i, MaxCORSMaxAgeSeconds, rule.MaxAgeSeconds)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
@@ -17,6 +18,166 @@ import (
 type Bucket struct {
 	Name      []byte
 	CreatedAt time.Time
+
+	// DefaultEncryptionParameters are the encryption parameters supplied
+	// when the bucket was created. Zero values mean satellite defaults.
+	DefaultEncryptionParameters storj.EncryptionParameters
+	// Placement is the placement constraint of the bucket.
+	Placement storj.PlacementConstraint
+	// DefaultSegmentSize is the segment size suggested to clients uploading to the
+	// bucket. Zero means the satellite default.
+	DefaultSegmentSize memory.Size
+
+	// ObjectLockEnabled is whether objects in the bucket are protected by object lock.
+	ObjectLockEnabled bool
+	// DefaultRetention is the retention applied to objects when object lock is enabled.
+	DefaultRetention DefaultRetention
+
+	// Tags are the user defined key/value pairs attached to the bucket.
+	Tags map[string]string
+	// CORS are the rules for the cross-origin requests allowed by the bucket.
+	CORS []CORSRule
+
+	// DefaultObjectTTL is how long objects uploaded without an expiration are kept.
+	// Zero means that they don't expire.
+	DefaultObjectTTL time.Duration
+
+	// CreatedBy is the user, who created the bucket. It's zero for the buckets
+	// created before it was recorded, or with an API key without a known creator.
+	CreatedBy uuid.UUID
+
+	// Logging is the access logging configuration of the bucket.
+	Logging Logging
+
+	// Frozen is whether writes to the bucket are prevented, e.g. while the account
+	// is under billing review. The objects of a frozen bucket can still be read,
+	// listed and deleted.
+	Frozen bool
+
+	// LastModified is when the bucket was last changed. Creating a bucket counts
+	// as modifying it.
+	LastModified time.Time
+
+	// CostCenter is the label, which the usage of the bucket is allocated to in
+	// the usage reports. Empty means the bucket isn't allocated to one.
+	CostCenter string
+}
+
+// CreateSettings is the configuration, which a bucket is created with. It's
+// stored together with the bucket, so the bucket never exists without it.
+type CreateSettings struct {
+	// ObjectLockEnabled is whether objects in the bucket are protected by object lock.
+	ObjectLockEnabled bool
+	// DefaultRetention is the retention applied to objects when object lock is enabled.
+	DefaultRetention DefaultRetention
+
+	// Tags are the user defined key/value pairs attached to the bucket.
+	Tags map[string]string
+	// CORS are the rules for the cross-origin requests allowed by the bucket.
+	CORS []CORSRule
+	// DefaultObjectTTL is how long objects uploaded without an expiration are kept.
+	DefaultObjectTTL time.Duration
+
+	// CostCenter is the label, which the usage of the bucket is allocated to.
+	CostCenter string
+}
+
+// RetentionMode is the object lock retention mode.
+type RetentionMode int
+
+const (
+	// NoRetention means that objects aren't retained.
+	NoRetention RetentionMode = iota
+	// ComplianceMode means that objects can't be deleted by anyone until the retention period expires.
+	ComplianceMode
+	// GovernanceMode means that objects can't be deleted until the retention period expires,
+	// unless the user has special permissions.
+	GovernanceMode
+)
+
+// DefaultRetention is the default object lock retention of a bucket.
+type DefaultRetention struct {
+	Mode RetentionMode
+	Days int
+}
+
+// IsZero returns whether the retention is not set.
+func (retention DefaultRetention) IsZero() bool {
+	return retention == DefaultRetention{}
+}
+
+// LockedSince returns the creation time after which objects are still retained at now.
+func (retention DefaultRetention) LockedSince(now time.Time) time.Time {
+	return now.AddDate(0, 0, -retention.Days)
+}
+
+// ValidateObjectLock checks whether the object lock configuration is valid.
+func ValidateObjectLock(enabled bool, retention DefaultRetention) error {
+	if !enabled {
+		if !retention.IsZero() {
+			return ErrObjectLock.New("default retention requires object lock to be enabled")
+		}
+		return nil
+	}
+
+	switch retention.Mode {
+	case ComplianceMode, GovernanceMode:
+	default:
+		return ErrObjectLock.New("invalid retention mode %d", retention.Mode)
+	}
+	if retention.Days <= 0 {
+		return ErrObjectLock.New("retention period must be positive, got %d days", retention.Days)
+	}
+	return nil
+}
+
+// ValidateDefaultObjectTTL checks whether the default object TTL of a bucket is valid.
+func ValidateDefaultObjectTTL(ttl time.Duration) error {
+	if ttl <= 0 {
+		return ErrDefaultObjectTTL.New("TTL must be positive, got %v", ttl)
+	}
+	return nil
+}
+
+// BucketOrder is the order in which buckets are listed.
+type BucketOrder int
+
+const (
+	// OrderByName lists buckets by name, in ascending order.
+	OrderByName BucketOrder = iota
+	// OrderByCreatedAt lists buckets by creation time, newest first.
+	// Buckets created at the same time are listed by name, in ascending order.
+	OrderByCreatedAt
+)
+
+// ListOptions are the options for listing buckets.
+type ListOptions struct {
+	storj.BucketListOptions
+
+	// Prefix restricts the listing to buckets whose name starts with it.
+	Prefix string
+
+	// OrderBy is the order of the listing. It defaults to OrderByName.
+	OrderBy BucketOrder
+	// CursorCreatedAt is the creation time of the cursor bucket.
+	// It's only used with OrderByCreatedAt, together with the cursor name.
+	CursorCreatedAt time.Time
+	// ModifiedSince, when set, lists only the buckets modified after it.
+	ModifiedSince time.Time
+}
+
+// MinimalBucketList is a list of buckets with the minimal bucket fields.
+type MinimalBucketList struct {
+	Items []Bucket
+	More  bool
+}
+
+// ProjectBucketCount is the number of buckets of a project together with its bucket limit.
+type ProjectBucketCount struct {
+	ProjectID uuid.UUID
+	Count     int
+	// MaxBuckets is nil when the project uses the default limit.
+	MaxBuckets *int
 }
 
 // DB is the interface for the database to interact with buckets.
@@ -25,22 +186,112 @@ type Bucket struct {
 type DB interface {
 	// CreateBucket creates a new bucket
 	CreateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
+	// CreateBucketBy creates a new bucket with the settings, recording the user, who created it.
+	// A zero createdBy isn't recorded.
+	CreateBucketBy(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings CreateSettings) (_ storj.Bucket, err error)
+	// CreateCaseInsensitiveBucket creates a new bucket like CreateBucketBy, which is also matched by
+	// its normalized name. It fails, when a bucket already has that normalized name or is named like it.
+	CreateCaseInsensitiveBucket(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings CreateSettings) (_ storj.Bucket, err error)
+	// GetCaseInsensitiveBucketNames returns the names of the buckets, which bucketNames refer to, when bucket
+	// names match case-insensitively. That's the bucket with exactly that name, otherwise the bucket with
+	// the same normalized name, otherwise the bucket named like the normalized name. A name, which doesn't
+	// refer to any bucket, is returned unchanged. Soft-deleted buckets are included.
+	GetCaseInsensitiveBucketNames(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (_ [][]byte, err error)
 	// GetBucket returns an existing bucket
 	GetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket storj.Bucket, err error)
 	// GetBucketPlacement returns with the placement constraint identifier.
 	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement storj.PlacementConstraint, err error)
 	// GetMinimalBucket returns existing bucket with minimal number of fields.
 	GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket Bucket, err error)
-	// HasBucket returns if a bucket exists.
+	// HasBucket returns if a bucket exists, soft-deleted buckets are reported as non-existent.
 	HasBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (exists bool, err error)
+	// HasBuckets returns whether each of the buckets exists, in the order of bucketNames.
+	HasBuckets(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (exists []bool, err error)
 	// GetBucketID returns an existing bucket id.
 	GetBucketID(ctx context.Context, bucket metabase.BucketLocation) (id uuid.UUID, err error)
+	// UpdateBucketObjectLock updates the object lock configuration of an existing bucket.
+	UpdateBucketObjectLock(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool, retention DefaultRetention) (err error)
+	// UpdateBucketTags replaces the tags of an existing bucket, an empty set removes all tags.
+	UpdateBucketTags(ctx context.Context, bucketName []byte, projectID uuid.UUID, tags map[string]string) (err error)
+	// UpdateBucketCORS replaces the CORS rules of an existing bucket, no rules removes the CORS configuration.
+	UpdateBucketCORS(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []CORSRule) (err error)
+	// UpdateBucketDefaultObjectTTL sets the default object TTL of an existing bucket, zero removes it.
+	// The TTL is stored with a second precision, rounded up.
+	UpdateBucketDefaultObjectTTL(ctx context.Context, bucketName []byte, projectID uuid.UUID, ttl time.Duration) (err error)
+	// UpdateBucketCostCenter sets the cost center of an existing bucket, empty removes it.
+	UpdateBucketCostCenter(ctx context.Context, bucketName []byte, projectID uuid.UUID, costCenter string) (err error)
+	// UpdateBucketFrozen sets whether an existing bucket is frozen.
+	UpdateBucketFrozen(ctx context.Context, bucketName []byte, projectID uuid.UUID, frozen bool) (err error)
+	// UpdateBucketLogging replaces the access logging configuration of an existing bucket,
+	// a configuration without a target bucket disables access logging.
+	UpdateBucketLogging(ctx context.Context, bucketName []byte, projectID uuid.UUID, logging Logging) (err error)
 	// UpdateBucket updates an existing bucket
 	UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error)
-	// DeleteBucket deletes a bucket
+	// DeleteBucket deletes a bucket, access logging into the bucket is disabled.
 	DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// ListBucketLoggingSources returns the names of the buckets of the project, which log
+	// their accesses into the target bucket.
+	ListBucketLoggingSources(ctx context.Context, projectID uuid.UUID, targetBucket []byte) (_ [][]byte, err error)
 	// ListBuckets returns all buckets for a project
-	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
-	// CountBuckets returns the number of buckets a project currently has
+	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
+	// ListMinimalBuckets returns all buckets for a project with the fields of GetMinimalBucket.
+	ListMinimalBuckets(ctx context.Context, projectID uuid.UUID, listOpts ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList MinimalBucketList, err error)
+	// CountBuckets returns the number of buckets a project currently has, including soft-deleted buckets.
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// CountBucketsUpTo returns the number of buckets a project currently has like CountBuckets,
+	// but it counts at most limit buckets, so it's fast for projects with many buckets.
+	CountBucketsUpTo(ctx context.Context, projectID uuid.UUID, limit int) (int, error)
+	// ListProjectBucketCounts returns at most limit projects with an id after cursor, ordered by id,
+	// together with their bucket counts, including soft-deleted buckets, and bucket limits.
+	ListProjectBucketCounts(ctx context.Context, cursor uuid.UUID, limit int) (_ []ProjectBucketCount, err error)
+
+	// GetIdempotencyResult returns the result stored for the idempotency key after createdAfter.
+	// It returns ErrIdempotencyKeyNotFound when there's none.
+	GetIdempotencyResult(ctx context.Context, projectID uuid.UUID, key []byte, createdAfter time.Time) (_ IdempotencyResult, err error)
+	// StoreIdempotencyResult stores the result for the idempotency key, unless a result was
+	// already stored for it after createdAfter. Results of the project stored before are removed.
+	StoreIdempotencyResult(ctx context.Context, projectID uuid.UUID, key []byte, result IdempotencyResult, createdAfter time.Time) (err error)
+
+	// StartBucketRename renames the bucket and records the pending rename of its objects.
+	// Access logging into the bucket follows it to the new name. A case-insensitive bucket
+	// remains case-insensitive.
+	StartBucketRename(ctx context.Context, projectID uuid.UUID, oldName, newName []byte) (err error)
+	// GetBucketRename returns the pending rename of the bucket with the old name.
+	// It returns ErrBucketRenameNotFound when there's none.
+	GetBucketRename(ctx context.Context, projectID uuid.UUID, oldName []byte) (_ Rename, err error)
+	// FinishBucketRename removes the record of the pending rename of the bucket with the old name.
+	FinishBucketRename(ctx context.Context, projectID uuid.UUID, oldName []byte) (err error)
+
+	// StartBucketTransfer moves the bucket to the new project and records the pending transfer of its objects.
+	StartBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte, newProjectID uuid.UUID) (err error)
+	// GetBucketTransfer returns the pending transfer of the bucket from the project.
+	// It returns ErrBucketTransferNotFound when there's none.
+	GetBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ Transfer, err error)
+	// FinishBucketTransfer removes the record of the pending transfer of the bucket from the project.
+	FinishBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error)
+
+	// EnqueueAccessLog stores the access log record until it's delivered.
+	EnqueueAccessLog(ctx context.Context, record AccessLogRecord) (err error)
+	// ListAccessLogs returns at most limit of the oldest access log records.
+	ListAccessLogs(ctx context.Context, limit int) (_ []AccessLogRecord, err error)
+	// DeleteAccessLogs removes the delivered access log records.
+	DeleteAccessLogs(ctx context.Context, ids []uuid.UUID) (err error)
+
+	// InsertAuditRecord stores the audit record of a bucket mutation.
+	InsertAuditRecord(ctx context.Context, record AuditRecord) (err error)
+	// ListAuditRecords returns at most limit of the latest audit records of the project.
+	ListAuditRecords(ctx context.Context, projectID uuid.UUID, limit int) (_ []AuditRecord, err error)
+
+	// SoftDeleteBucket marks a bucket as deleted, hiding it until it's restored or removed.
+	// Access logging into the bucket is disabled.
+	SoftDeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID, deletedAt time.Time) (err error)
+	// RestoreBucket clears the deletion mark of a bucket soft-deleted after deletedAfter.
+	RestoreBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID, deletedAfter time.Time) (err error)
+	// ListSoftDeletedBuckets returns at most limit buckets soft-deleted before deletedBefore.
+	ListSoftDeletedBuckets(ctx context.Context, deletedBefore time.Time, limit int) (_ []metabase.BucketLocation, err error)
+	// DeleteSoftDeletedBucket removes a bucket, when it was soft-deleted before deletedBefore.
+	DeleteSoftDeletedBucket(ctx context.Context, bucket metabase.BucketLocation, deletedBefore time.Time) (deleted bool, err error)
+
+	// TestingSetBucketCreatedAt sets the creation time of a bucket.
+	TestingSetBucketCreatedAt(ctx context.Context, bucketName []byte, projectID uuid.UUID, createdAt time.Time) (err error)
 }
@@ -4,7 +4,10 @@
 package buckets_test
 
 import (
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +17,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -99,12 +103,422 @@ func TestBasicBucketOperations(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2, count)
 
+		// CountBucketsUpTo
+		count, err = bucketsDB.CountBucketsUpTo(ctx, project.ID, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		count, err = bucketsDB.CountBucketsUpTo(ctx, project.ID, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
 		// DeleteBucket
 		err = bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID)
 		require.NoError(t, err)
 	})
 }
 
+func TestCreateBucketBy(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		createdBy := testrand.UUID()
+
+		_, err = bucketsDB.CreateBucketBy(ctx, newTestBucket("created-by", project.ID), createdBy, buckets.CreateSettings{})
+		require.NoError(t, err)
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("unknown", project.ID))
+		require.NoError(t, err)
+
+		bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte("created-by"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, createdBy, bucket.CreatedBy)
+
+		bucket, err = bucketsDB.GetMinimalBucket(ctx, []byte("unknown"), project.ID)
+		require.NoError(t, err)
+		require.True(t, bucket.CreatedBy.IsZero())
+
+		list, err := bucketsDB.ListMinimalBuckets(ctx, project.ID, buckets.ListOptions{
+			BucketListOptions: storj.BucketListOptions{Direction: storj.Forward, Limit: 10},
+			OrderBy:           buckets.OrderByCreatedAt,
+		}, macaroon.AllowedBuckets{All: true})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		for _, item := range list.Items {
+			if string(item.Name) == "created-by" {
+				require.Equal(t, createdBy, item.CreatedBy)
+			} else {
+				require.True(t, item.CreatedBy.IsZero())
+			}
+		}
+	})
+}
+
+func TestCreateBucketWithSettings(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		settings := buckets.CreateSettings{
+			ObjectLockEnabled: true,
+			DefaultRetention:  buckets.DefaultRetention{Mode: buckets.GovernanceMode, Days: 3},
+			Tags:              map[string]string{"team": "storage"},
+			CORS: []buckets.CORSRule{{
+				AllowedOrigins: []string{"https://example.com"},
+				AllowedMethods: []string{"GET"},
+			}},
+			DefaultObjectTTL: time.Hour,
+			CostCenter:       "research",
+		}
+
+		_, err = bucketsDB.CreateBucketBy(ctx, newTestBucket("settings", project.ID), uuid.UUID{}, settings)
+		require.NoError(t, err)
+		_, err = bucketsDB.CreateCaseInsensitiveBucket(ctx, newTestBucket("Insensitive", project.ID), uuid.UUID{}, settings)
+		require.NoError(t, err)
+
+		for _, name := range []string{"settings", "Insensitive"} {
+			bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte(name), project.ID)
+			require.NoError(t, err)
+			require.True(t, bucket.ObjectLockEnabled)
+			require.Equal(t, settings.DefaultRetention, bucket.DefaultRetention)
+			require.Equal(t, settings.Tags, bucket.Tags)
+			require.Equal(t, settings.CORS, bucket.CORS)
+			require.Equal(t, settings.DefaultObjectTTL, bucket.DefaultObjectTTL)
+			require.Equal(t, settings.CostCenter, bucket.CostCenter)
+		}
+
+		_, err = bucketsDB.CreateBucketBy(ctx, newTestBucket("plain", project.ID), uuid.UUID{}, buckets.CreateSettings{})
+		require.NoError(t, err)
+
+		bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte("plain"), project.ID)
+		require.NoError(t, err)
+		require.False(t, bucket.ObjectLockEnabled)
+		require.Empty(t, bucket.Tags)
+		require.Empty(t, bucket.CORS)
+		require.Zero(t, bucket.DefaultObjectTTL)
+		require.Empty(t, bucket.CostCenter)
+	})
+}
+
+func TestCaseInsensitiveBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		// buckets created before have no normalized name
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("legacy", project.ID))
+		require.NoError(t, err)
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("mixeD", project.ID))
+		require.NoError(t, err)
+
+		created, err := bucketsDB.CreateCaseInsensitiveBucket(ctx, newTestBucket("MyBucket", project.ID), uuid.UUID{}, buckets.CreateSettings{})
+		require.NoError(t, err)
+		require.Equal(t, "MyBucket", created.Name)
+
+		for _, name := range []string{"mybucket", "MYBUCKET", "Legacy"} {
+			_, err = bucketsDB.CreateCaseInsensitiveBucket(ctx, newTestBucket(name, project.ID), uuid.UUID{}, buckets.CreateSettings{})
+			require.True(t, buckets.ErrBucketAlreadyExists.Has(err), name)
+		}
+
+		names, err := bucketsDB.GetCaseInsensitiveBucketNames(ctx, [][]byte{
+			[]byte("mybucket"), []byte("MYBUCKET"), []byte("LEGACY"), []byte("mixeD"), []byte("mixed"), []byte("missing"),
+		}, project.ID)
+		require.NoError(t, err)
+		// mixed case buckets created before are only matched by their exact name,
+		// names of no bucket are returned unchanged
+		require.Equal(t, [][]byte{
+			[]byte("MyBucket"), []byte("MyBucket"), []byte("legacy"), []byte("mixeD"), []byte("mixed"), []byte("missing"),
+		}, names)
+
+		names, err = bucketsDB.GetCaseInsensitiveBucketNames(ctx, nil, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, names)
+
+		// the renamed bucket is still matched case-insensitively
+		require.NoError(t, bucketsDB.StartBucketRename(ctx, project.ID, []byte("MyBucket"), []byte("Renamed")))
+		names, err = bucketsDB.GetCaseInsensitiveBucketNames(ctx, [][]byte{[]byte("renamed"), []byte("mybucket")}, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("Renamed"), []byte("mybucket")}, names)
+	})
+}
+
+func TestBucketFrozen(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("frozen", project.ID))
+		require.NoError(t, err)
+
+		bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte("frozen"), project.ID)
+		require.NoError(t, err)
+		require.False(t, bucket.Frozen)
+
+		require.NoError(t, bucketsDB.UpdateBucketFrozen(ctx, []byte("frozen"), project.ID, true))
+
+		bucket, err = bucketsDB.GetMinimalBucket(ctx, []byte("frozen"), project.ID)
+		require.NoError(t, err)
+		require.True(t, bucket.Frozen)
+
+		list, err := bucketsDB.ListMinimalBuckets(ctx, project.ID, buckets.ListOptions{
+			BucketListOptions: storj.BucketListOptions{Direction: storj.Forward, Limit: 10},
+		}, macaroon.AllowedBuckets{All: true})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		require.True(t, list.Items[0].Frozen)
+
+		require.NoError(t, bucketsDB.UpdateBucketFrozen(ctx, []byte("frozen"), project.ID, false))
+		bucket, err = bucketsDB.GetMinimalBucket(ctx, []byte("frozen"), project.ID)
+		require.NoError(t, err)
+		require.False(t, bucket.Frozen)
+
+		err = bucketsDB.UpdateBucketFrozen(ctx, []byte("unknown"), project.ID, true)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+
+		// soft-deleted buckets aren't frozen
+		require.NoError(t, bucketsDB.SoftDeleteBucket(ctx, []byte("frozen"), project.ID, time.Now()))
+		err = bucketsDB.UpdateBucketFrozen(ctx, []byte("frozen"), project.ID, true)
+		require.True(t, storj.ErrBucketNotFound.Has(err))
+	})
+}
+
+func TestListBucketsModifiedSince(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		since := time.Now().Add(-time.Hour)
+		for _, name := range []string{"aaa", "bbb", "ccc", "ddd", "eee"} {
+			_, err := bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+			require.NoError(t, bucketsDB.TestingSetBucketCreatedAt(ctx, []byte(name), project.ID, since.Add(-time.Hour)))
+		}
+
+		// creating a bucket counts as modifying it
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("fff", project.ID))
+		require.NoError(t, err)
+
+		require.NoError(t, bucketsDB.UpdateBucketTags(ctx, []byte("bbb"), project.ID, map[string]string{"team": "storage"}))
+		require.NoError(t, bucketsDB.UpdateBucketTags(ctx, []byte("ddd"), project.ID, map[string]string{"team": "billing"}))
+
+		tagged, err := bucketsDB.GetMinimalBucket(ctx, []byte("bbb"), project.ID)
+		require.NoError(t, err)
+		require.True(t, tagged.LastModified.After(since))
+
+		untouched, err := bucketsDB.GetMinimalBucket(ctx, []byte("aaa"), project.ID)
+		require.NoError(t, err)
+		require.False(t, untouched.LastModified.After(since))
+
+		listAll := func(limit int) (names []string) {
+			listOpts := buckets.ListOptions{
+				BucketListOptions: storj.BucketListOptions{
+					Direction: storj.Forward,
+					Limit:     limit,
+				},
+				ModifiedSince: since,
+			}
+			for {
+				bucketList, err := bucketsDB.ListMinimalBuckets(ctx, project.ID, listOpts, macaroon.AllowedBuckets{All: true})
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(bucketList.Items), limit)
+				for _, item := range bucketList.Items {
+					names = append(names, string(item.Name))
+				}
+				if !bucketList.More || len(bucketList.Items) == 0 {
+					return names
+				}
+				listOpts.Cursor = string(bucketList.Items[len(bucketList.Items)-1].Name)
+				listOpts.Direction = storj.After
+			}
+		}
+
+		for _, limit := range []int{1, 2, 3, 10} {
+			require.Equal(t, []string{"bbb", "ddd", "fff"}, listAll(limit), "limit %d", limit)
+		}
+
+		// without the filter all the buckets are listed
+		bucketList, err := bucketsDB.ListBuckets(ctx, project.ID, buckets.ListOptions{
+			BucketListOptions: storj.BucketListOptions{Direction: storj.Forward},
+		}, macaroon.AllowedBuckets{All: true})
+		require.NoError(t, err)
+		require.Len(t, bucketList.Items, 6)
+	})
+}
+
+func TestBucketAccessLogs(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		for _, name := range []string{"data", "logs"} {
+			_, err = bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+		}
+
+		logging := buckets.Logging{TargetBucket: []byte("logs"), TargetPrefix: "data/"}
+		require.NoError(t, bucketsDB.UpdateBucketLogging(ctx, []byte("data"), project.ID, logging))
+		require.True(t, buckets.ErrInvalidLogging.Has(bucketsDB.UpdateBucketLogging(ctx, []byte("data"), project.ID,
+			buckets.Logging{TargetBucket: []byte("missing")})))
+
+		bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte("data"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, logging, bucket.Logging)
+
+		now := time.Now().Truncate(time.Microsecond)
+		var records []buckets.AccessLogRecord
+		for i, operation := range []string{"PUT", "GET"} {
+			record := buckets.AccessLogRecord{
+				ID:           testrand.UUID(),
+				ProjectID:    project.ID,
+				BucketName:   []byte("data"),
+				TargetBucket: logging.TargetBucket,
+				TargetPrefix: logging.TargetPrefix,
+				Operation:    operation,
+				ObjectKey:    []byte("object"),
+				APIKeyID:     testrand.UUID(),
+				CreatedAt:    now.Add(time.Duration(i) * time.Second),
+			}
+			require.NoError(t, bucketsDB.EnqueueAccessLog(ctx, record))
+			records = append(records, record)
+		}
+
+		listed, err := bucketsDB.ListAccessLogs(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		for i := range listed {
+			require.Equal(t, records[i].ID, listed[i].ID)
+			require.Equal(t, records[i].Operation, listed[i].Operation)
+			require.WithinDuration(t, records[i].CreatedAt, listed[i].CreatedAt, time.Second)
+		}
+
+		require.NoError(t, bucketsDB.DeleteAccessLogs(ctx, []uuid.UUID{records[0].ID}))
+		listed, err = bucketsDB.ListAccessLogs(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, records[1].ID, listed[0].ID)
+	})
+}
+
+func TestBucketLoggingTargetRemoved(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		for _, name := range []string{"data", "other", "logs", "deleted-logs", "soft-deleted-logs"} {
+			_, err = bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+		}
+
+		logging := func(bucketName string) buckets.Logging {
+			bucket, err := bucketsDB.GetMinimalBucket(ctx, []byte(bucketName), project.ID)
+			require.NoError(t, err)
+			return bucket.Logging
+		}
+
+		require.NoError(t, bucketsDB.UpdateBucketLogging(ctx, []byte("data"), project.ID, buckets.Logging{TargetBucket: []byte("logs"), TargetPrefix: "data/"}))
+		require.NoError(t, bucketsDB.UpdateBucketLogging(ctx, []byte("other"), project.ID, buckets.Logging{TargetBucket: []byte("logs")}))
+
+		sources, err := bucketsDB.ListBucketLoggingSources(ctx, project.ID, []byte("logs"))
+		require.NoError(t, err)
+		require.ElementsMatch(t, [][]byte{[]byte("data"), []byte("other")}, sources)
+
+		// logging follows the renamed target
+		require.NoError(t, bucketsDB.StartBucketRename(ctx, project.ID, []byte("logs"), []byte("renamed-logs")))
+		require.Equal(t, buckets.Logging{TargetBucket: []byte("renamed-logs"), TargetPrefix: "data/"}, logging("data"))
+
+		sources, err = bucketsDB.ListBucketLoggingSources(ctx, project.ID, []byte("logs"))
+		require.NoError(t, err)
+		require.Empty(t, sources)
+
+		// logging into a deleted target is disabled
+		require.NoError(t, bucketsDB.UpdateBucketLogging(ctx, []byte("data"), project.ID, buckets.Logging{TargetBucket: []byte("deleted-logs")}))
+		require.NoError(t, bucketsDB.DeleteBucket(ctx, []byte("deleted-logs"), project.ID))
+		require.False(t, logging("data").Enabled())
+
+		require.NoError(t, bucketsDB.UpdateBucketLogging(ctx, []byte("data"), project.ID, buckets.Logging{TargetBucket: []byte("soft-deleted-logs")}))
+		require.NoError(t, bucketsDB.SoftDeleteBucket(ctx, []byte("soft-deleted-logs"), project.ID, time.Now()))
+		require.False(t, logging("data").Enabled())
+
+		// the other targets aren't affected
+		require.Equal(t, buckets.Logging{TargetBucket: []byte("renamed-logs")}, logging("other"))
+	})
+}
+
+func TestBucketAuditRecords(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		bucketsDB := sat.API.Buckets.Service
+
+		projectID, otherProjectID := testrand.UUID(), testrand.UUID()
+		now := time.Now().Truncate(time.Microsecond)
+
+		var records []buckets.AuditRecord
+		for i, action := range []string{buckets.AuditActionCreate, buckets.AuditActionDelete} {
+			record := buckets.AuditRecord{
+				ID:         testrand.UUID(),
+				ProjectID:  projectID,
+				BucketName: []byte("bucket"),
+				Action:     action,
+				APIKeyID:   testrand.UUID(),
+				APIKeyHead: testrand.BytesInt(32),
+				CreatedAt:  now.Add(time.Duration(i) * time.Second),
+			}
+			require.NoError(t, bucketsDB.InsertAuditRecord(ctx, record))
+			records = append(records, record)
+		}
+		require.NoError(t, bucketsDB.InsertAuditRecord(ctx, buckets.AuditRecord{
+			ID:         testrand.UUID(),
+			ProjectID:  otherProjectID,
+			BucketName: []byte("bucket"),
+			Action:     buckets.AuditActionCreate,
+			APIKeyID:   testrand.UUID(),
+			APIKeyHead: testrand.BytesInt(32),
+			CreatedAt:  now,
+		}))
+
+		listed, err := bucketsDB.ListAuditRecords(ctx, projectID, 10)
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+		// the latest records are listed first
+		for i := range listed {
+			expected := records[len(records)-1-i]
+			require.Equal(t, expected.ID, listed[i].ID)
+			require.Equal(t, expected.BucketName, listed[i].BucketName)
+			require.Equal(t, expected.Action, listed[i].Action)
+			require.Equal(t, expected.APIKeyID, listed[i].APIKeyID)
+			require.Equal(t, expected.APIKeyHead, listed[i].APIKeyHead)
+			require.WithinDuration(t, expected.CreatedAt, listed[i].CreatedAt, time.Second)
+		}
+
+		listed, err = bucketsDB.ListAuditRecords(ctx, projectID, 1)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, records[1].ID, listed[0].ID)
+	})
+}
+
 func TestListBucketsAllAllowed(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -152,10 +566,12 @@ func TestListBucketsAllAllowed(t *testing.T) {
 			tt := tt // avoid scopelint error
 			t.Run(tt.name, func(t *testing.T) {
 
-				listOpts := storj.BucketListOptions{
-					Cursor:    tt.cursor,
-					Direction: storj.Forward,
-					Limit:     tt.limit,
+				listOpts := buckets.ListOptions{
+					BucketListOptions: storj.BucketListOptions{
+						Cursor:    tt.cursor,
+						Direction: storj.Forward,
+						Limit:     tt.limit,
+					},
 				}
 				bucketList, err := bucketsDB.ListBuckets(ctx, project.ID, listOpts, allowedBuckets)
 				require.NoError(t, err)
@@ -209,10 +625,12 @@ func TestListBucketsNotAllowed(t *testing.T) {
 
 		for _, tt := range testCases {
 			tt := tt // avoid scopelint error
-			listOpts := storj.BucketListOptions{
-				Cursor:    tt.cursor,
-				Direction: storj.Forward,
-				Limit:     tt.limit,
+			listOpts := buckets.ListOptions{
+				BucketListOptions: storj.BucketListOptions{
+					Cursor:    tt.cursor,
+					Direction: storj.Forward,
+					Limit:     tt.limit,
+				},
 			}
 			t.Run(tt.name, func(t *testing.T) {
 				allowed := macaroon.AllowedBuckets{
@@ -235,3 +653,259 @@ func TestListBucketsNotAllowed(t *testing.T) {
 		}
 	})
 }
+
+func TestListBucketsPrefix(t *testing.T) {
+	testCases := []struct {
+		name          string
+		prefix        string
+		cursor        string
+		limit         int
+		allowAll      bool
+		expectedNames []string
+		expectedMore  bool
+	}{
+		{"empty prefix", "", "", 3, true, []string{"0test", "123", "999"}, true},
+		{"no matching prefix", "nope", "", 10, true, []string{}, false},
+		{"prefix, exact limit", "test", "", 3, true, []string{"test-bucket.thing", "test-one", "test.bucket"}, false},
+		{"prefix, more", "test", "", 2, true, []string{"test-bucket.thing", "test-one"}, true},
+		{"prefix, next page", "test", "test-p", 2, true, []string{"test.bucket"}, false},
+		{"prefix, cursor before prefix", "test", "aaa", 10, true, []string{"test-bucket.thing", "test-one", "test.bucket"}, false},
+		{"prefix, cursor after prefix", "test", "zzz", 10, true, []string{}, false},
+		{"prefix, not allowed", "test", "", 10, false, []string{"test.bucket"}, false},
+	}
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.DB
+		consoleDB := db.Console()
+
+		project, err := consoleDB.Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		{ // setup some test buckets
+			var testBucketNames = []string{"aaa", "bbb", "mmm", "qqq", "zzz",
+				"test.bucket", "123", "0test", "999", "test-bucket.thing", "test-one",
+			}
+			for _, bucket := range testBucketNames {
+				_, err := bucketsDB.CreateBucket(ctx, newTestBucket(bucket, project.ID))
+				require.NoError(t, err)
+			}
+		}
+
+		for _, tt := range testCases {
+			tt := tt // avoid scopelint error
+			t.Run(tt.name, func(t *testing.T) {
+				listOpts := buckets.ListOptions{
+					BucketListOptions: storj.BucketListOptions{
+						Cursor:    tt.cursor,
+						Direction: storj.Forward,
+						Limit:     tt.limit,
+					},
+					Prefix: tt.prefix,
+				}
+				allowed := macaroon.AllowedBuckets{
+					All:     tt.allowAll,
+					Buckets: map[string]struct{}{"test.bucket": {}, "aaa": {}},
+				}
+				bucketList, err := bucketsDB.ListBuckets(ctx, project.ID, listOpts, allowed)
+				require.NoError(t, err)
+
+				names := []string{}
+				for _, item := range bucketList.Items {
+					names = append(names, item.Name)
+				}
+				require.Equal(t, tt.expectedNames, names)
+				require.Equal(t, tt.expectedMore, bucketList.More)
+			})
+		}
+	})
+}
+
+func TestListBucketsByCreatedAt(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+
+		now := time.Now().Truncate(time.Second)
+		createdAt := map[string]time.Time{
+			"bbb": now.Add(-2 * time.Hour),
+			"ddd": now.Add(-2 * time.Hour),
+			"aaa": now.Add(-time.Hour),
+			"ccc": now.Add(-time.Hour),
+			"eee": now.Add(-time.Hour),
+			"fff": now,
+		}
+		for name, created := range createdAt {
+			_, err := bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+			require.NoError(t, bucketsDB.TestingSetBucketCreatedAt(ctx, []byte(name), project.ID, created))
+		}
+
+		listAll := func(limit int, allowed macaroon.AllowedBuckets) (names []string) {
+			listOpts := buckets.ListOptions{
+				BucketListOptions: storj.BucketListOptions{
+					Direction: storj.Forward,
+					Limit:     limit,
+				},
+				OrderBy: buckets.OrderByCreatedAt,
+			}
+			for {
+				bucketList, err := bucketsDB.ListBuckets(ctx, project.ID, listOpts, allowed)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(bucketList.Items), limit)
+				for _, item := range bucketList.Items {
+					names = append(names, item.Name)
+				}
+				if !bucketList.More {
+					return names
+				}
+				last := bucketList.Items[len(bucketList.Items)-1]
+				listOpts.Cursor = last.Name
+				listOpts.CursorCreatedAt = last.Created
+				listOpts.Direction = storj.After
+			}
+		}
+
+		for _, limit := range []int{1, 2, 3, 4, 10} {
+			require.Equal(t, []string{"fff", "aaa", "ccc", "eee", "bbb", "ddd"},
+				listAll(limit, macaroon.AllowedBuckets{All: true}), "limit %d", limit)
+		}
+
+		allowed := macaroon.AllowedBuckets{
+			Buckets: map[string]struct{}{"fff": {}, "aaa": {}, "eee": {}, "ddd": {}},
+		}
+		require.Equal(t, []string{"fff", "aaa", "eee", "ddd"}, listAll(2, allowed))
+
+		// the default order is still by name
+		bucketList, err := bucketsDB.ListBuckets(ctx, project.ID, buckets.ListOptions{
+			BucketListOptions: storj.BucketListOptions{Direction: storj.Forward},
+		}, macaroon.AllowedBuckets{All: true})
+		require.NoError(t, err)
+		names := []string{}
+		for _, item := range bucketList.Items {
+			names = append(names, item.Name)
+		}
+		require.Equal(t, []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff"}, names)
+	})
+}
+
+func TestValidateObjectLock(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		enabled     bool
+		retention   buckets.DefaultRetention
+		expectError bool
+	}{
+		{description: "disabled"},
+		{description: "disabled with retention", retention: buckets.DefaultRetention{Mode: buckets.ComplianceMode, Days: 1}, expectError: true},
+		{description: "compliance", enabled: true, retention: buckets.DefaultRetention{Mode: buckets.ComplianceMode, Days: 30}},
+		{description: "governance", enabled: true, retention: buckets.DefaultRetention{Mode: buckets.GovernanceMode, Days: 1}},
+		{description: "zero retention period", enabled: true, retention: buckets.DefaultRetention{Mode: buckets.ComplianceMode}, expectError: true},
+		{description: "negative retention period", enabled: true, retention: buckets.DefaultRetention{Mode: buckets.GovernanceMode, Days: -1}, expectError: true},
+		{description: "missing mode", enabled: true, retention: buckets.DefaultRetention{Days: 1}, expectError: true},
+	} {
+		err := buckets.ValidateObjectLock(tt.enabled, tt.retention)
+		if tt.expectError {
+			require.True(t, buckets.ErrObjectLock.Has(err), tt.description)
+		} else {
+			require.NoError(t, err, tt.description)
+		}
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	tooMany := map[string]string{}
+	for i := 0; i <= buckets.MaxTags; i++ {
+		tooMany["key"+strconv.Itoa(i)] = "value"
+	}
+	maxTags := map[string]string{}
+	for i := 0; i < buckets.MaxTags; i++ {
+		maxTags["key"+strconv.Itoa(i)] = "value"
+	}
+
+	for _, tt := range []struct {
+		description string
+		tags        map[string]string
+		expectError bool
+	}{
+		{description: "no tags"},
+		{description: "simple", tags: map[string]string{"env": "prod", "cost-center": "42"}},
+		{description: "empty value", tags: map[string]string{"env": ""}},
+		{description: "max tags", tags: maxTags},
+		{description: "too many tags", tags: tooMany, expectError: true},
+		{description: "empty key", tags: map[string]string{"": "value"}, expectError: true},
+		{description: "max key length", tags: map[string]string{strings.Repeat("k", buckets.MaxTagKeyLength): "value"}},
+		{description: "key too long", tags: map[string]string{strings.Repeat("k", buckets.MaxTagKeyLength+1): "value"}, expectError: true},
+		{description: "max value length", tags: map[string]string{"key": strings.Repeat("v", buckets.MaxTagValueLength)}},
+		{description: "value too long", tags: map[string]string{"key": strings.Repeat("v", buckets.MaxTagValueLength+1)}, expectError: true},
+	} {
+		err := buckets.ValidateTags(tt.tags)
+		if tt.expectError {
+			require.True(t, buckets.ErrInvalidTags.Has(err), tt.description)
+		} else {
+			require.NoError(t, err, tt.description)
+		}
+	}
+}
+
+func TestValidateCORS(t *testing.T) {
+	valid := buckets.CORSRule{
+		AllowedOrigins: []string{"https://app.example.test"},
+		AllowedMethods: []string{"GET", "PUT", "POST", "DELETE", "HEAD"},
+		AllowedHeaders: []string{"*"},
+		MaxAgeSeconds:  3600,
+	}
+	withRule := func(update func(rule *buckets.CORSRule)) []buckets.CORSRule {
+		rule := valid
+		update(&rule)
+		return []buckets.CORSRule{rule}
+	}
+
+	for _, tt := range []struct {
+		description string
+		rules       []buckets.CORSRule
+		expectError bool
+	}{
+		{description: "no rules"},
+		{description: "valid", rules: []buckets.CORSRule{valid}},
+		{description: "too many rules", rules: make([]buckets.CORSRule, buckets.MaxCORSRules+1), expectError: true},
+		{description: "no origin", rules: withRule(func(rule *buckets.CORSRule) { rule.AllowedOrigins = nil }), expectError: true},
+		{description: "empty origin", rules: withRule(func(rule *buckets.CORSRule) { rule.AllowedOrigins = []string{""} }), expectError: true},
+		{description: "no method", rules: withRule(func(rule *buckets.CORSRule) { rule.AllowedMethods = nil }), expectError: true},
+		{description: "unknown method", rules: withRule(func(rule *buckets.CORSRule) { rule.AllowedMethods = []string{"PATCH"} }), expectError: true},
+		{description: "lowercase method", rules: withRule(func(rule *buckets.CORSRule) { rule.AllowedMethods = []string{"get"} }), expectError: true},
+		{description: "negative max age", rules: withRule(func(rule *buckets.CORSRule) { rule.MaxAgeSeconds = -1 }), expectError: true},
+		{description: "max age too long", rules: withRule(func(rule *buckets.CORSRule) { rule.MaxAgeSeconds = buckets.MaxCORSMaxAgeSeconds + 1 }), expectError: true},
+	} {
+		err := buckets.ValidateCORS(tt.rules)
+		if tt.expectError {
+			require.True(t, buckets.ErrInvalidCORS.Has(err), tt.description)
+		} else {
+			require.NoError(t, err, tt.description)
+		}
+	}
+}
+
+func TestValidateDefaultObjectTTL(t *testing.T) {
+	require.NoError(t, buckets.ValidateDefaultObjectTTL(time.Hour))
+	require.True(t, buckets.ErrDefaultObjectTTL.Has(buckets.ValidateDefaultObjectTTL(0)))
+	require.True(t, buckets.ErrDefaultObjectTTL.Has(buckets.ValidateDefaultObjectTTL(-time.Hour)))
+}
+
+func TestValidateLogging(t *testing.T) {
+	require.NoError(t, buckets.ValidateLogging([]byte("data"), buckets.Logging{}))
+	require.NoError(t, buckets.ValidateLogging([]byte("data"), buckets.Logging{TargetBucket: []byte("logs"), TargetPrefix: "data/"}))
+
+	for _, logging := range []buckets.Logging{
+		{TargetPrefix: "data/"},
+		{TargetBucket: []byte("data")},
+		{TargetBucket: []byte("logs"), TargetPrefix: strings.Repeat("a", buckets.MaxLoggingPrefixLength+1)},
+	} {
+		require.True(t, buckets.ErrInvalidLogging.Has(buckets.ValidateLogging([]byte("data"), logging)), logging)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrIdempotencyKeyNotFound is returned when no result is stored for an idempotency key.
+var ErrIdempotencyKeyNotFound = errs.Class("idempotency key not found")
+
+// IdempotencyResult is the result of a bucket request stored by its idempotency key,
+// so a retry of the request can replay it instead of executing the request again.
+type IdempotencyResult struct {
+	// Operation and BucketName identify the request the key was used for.
+	Operation  string
+	BucketName []byte
+	// Response is the encoded response of the request.
+	Response  []byte
+	CreatedAt time.Time
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"bytes"
+	"time"
+	"unicode/utf8"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// MaxLoggingPrefixLength is the maximum length of the access log prefix in characters.
+const MaxLoggingPrefixLength = 512
+
+// ErrInvalidLogging is returned when a bucket access logging configuration is invalid.
+var ErrInvalidLogging = errs.Class("invalid bucket logging configuration")
+
+// Logging is the access logging configuration of a bucket.
+type Logging struct {
+	// TargetBucket is the bucket of the same project the access logs are delivered to.
+	TargetBucket []byte
+	// TargetPrefix is prepended to the keys of the delivered access log objects.
+	TargetPrefix string
+}
+
+// Enabled returns whether the accesses of the bucket are logged.
+func (logging Logging) Enabled() bool {
+	return len(logging.TargetBucket) > 0
+}
+
+// ValidateLogging checks whether the access logging configuration of the bucket is valid.
+// It doesn't check whether the target bucket exists.
+func ValidateLogging(bucketName []byte, logging Logging) error {
+	if !logging.Enabled() {
+		if logging.TargetPrefix != "" {
+			return ErrInvalidLogging.New("target prefix requires a target bucket")
+		}
+		return nil
+	}
+	// the access log objects would be logged again, endlessly
+	if bytes.Equal(bucketName, logging.TargetBucket) {
+		return ErrInvalidLogging.New("bucket %q can't log its accesses into itself", bucketName)
+	}
+	if n := utf8.RuneCountInString(logging.TargetPrefix); n > MaxLoggingPrefixLength {
+		return ErrInvalidLogging.New("target prefix is %d characters, at most %d are allowed", n, MaxLoggingPrefixLength)
+	}
+	return nil
+}
+
+// AccessLogRecord is an access to an object of a bucket with access logging enabled,
+// which waits to be delivered to the target bucket.
+type AccessLogRecord struct {
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+	BucketName []byte
+
+	TargetBucket []byte
+	TargetPrefix string
+
+	// Operation is the kind of access, e.g. GET or PUT.
+	Operation string
+	// ObjectKey is the encrypted key of the accessed object.
+	ObjectKey []byte
+	// APIKeyID is the id of the API key the object was accessed with.
+	APIKeyID  uuid.UUID
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+// NormalizeName returns the bucket name with its ASCII letters lowercased. Buckets,
+// whose names match case-insensitively, are matched by their normalized names.
+func NormalizeName(bucketName []byte) []byte {
+	normalized := make([]byte, len(bucketName))
+	for i, b := range bucketName {
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		normalized[i] = b
+	}
+	return normalized
+}
@@ -0,0 +1,26 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrBucketRenameNotFound is returned when a bucket has no pending rename.
+var ErrBucketRenameNotFound = errs.Class("bucket rename not found")
+
+// Rename is a pending rename of a bucket.
+//
+// A bucket is renamed in two phases, since the bucket and its objects are in different
+// databases. First the bucket gets its new name together with a record of the rename,
+// then its objects are moved to the new name and finally the record is removed.
+// While the record exists, the old name can't be used for a new bucket, so a rename
+// interrupted in the second phase can be resumed.
+type Rename struct {
+	OldName   []byte
+	NewName   []byte
+	CreatedAt time.Time
+}
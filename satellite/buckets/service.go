@@ -5,16 +5,28 @@ package buckets
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
 var (
 	// ErrBucketNotEmpty is returned when a caller attempts to change placement constraints.
 	ErrBucketNotEmpty = errs.Class("bucket must be empty")
+
+	// ErrObjectLock is returned when the object lock configuration of a bucket is invalid.
+	ErrObjectLock = errs.Class("object lock")
+
+	// ErrDefaultObjectTTL is returned when the default object TTL of a bucket is invalid.
+	ErrDefaultObjectTTL = errs.Class("default object TTL")
+
+	// ErrBucketAlreadyExists is returned when creating a bucket with the name of an existing
+	// or soft-deleted bucket.
+	ErrBucketAlreadyExists = errs.Class("bucket already exists")
 )
 
 // NewService converts the provided db and metabase calls into a single DB interface.
@@ -56,3 +68,66 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// UpdateBucketObjectLock overrides the default UpdateBucketObjectLock behaviour by validating the
+// configuration and ensuring that object lock isn't disabled once it has been enabled.
+func (buckets *Service) UpdateBucketObjectLock(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool, retention DefaultRetention) error {
+	if err := ValidateObjectLock(enabled, retention); err != nil {
+		return err
+	}
+
+	current, err := buckets.GetMinimalBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	if current.ObjectLockEnabled && !enabled {
+		return ErrObjectLock.New("object lock cannot be disabled once enabled")
+	}
+
+	return buckets.DB.UpdateBucketObjectLock(ctx, bucketName, projectID, enabled, retention)
+}
+
+// UpdateBucketTags overrides the default UpdateBucketTags behaviour by validating the tag set.
+func (buckets *Service) UpdateBucketTags(ctx context.Context, bucketName []byte, projectID uuid.UUID, tags map[string]string) error {
+	if err := ValidateTags(tags); err != nil {
+		return err
+	}
+	return buckets.DB.UpdateBucketTags(ctx, bucketName, projectID, tags)
+}
+
+// UpdateBucketCORS overrides the default UpdateBucketCORS behaviour by validating the rules.
+func (buckets *Service) UpdateBucketCORS(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []CORSRule) error {
+	if err := ValidateCORS(rules); err != nil {
+		return err
+	}
+	return buckets.DB.UpdateBucketCORS(ctx, bucketName, projectID, rules)
+}
+
+// UpdateBucketDefaultObjectTTL overrides the default UpdateBucketDefaultObjectTTL behaviour by validating the TTL.
+func (buckets *Service) UpdateBucketDefaultObjectTTL(ctx context.Context, bucketName []byte, projectID uuid.UUID, ttl time.Duration) error {
+	if err := ValidateDefaultObjectTTL(ttl); err != nil {
+		return err
+	}
+	return buckets.DB.UpdateBucketDefaultObjectTTL(ctx, bucketName, projectID, ttl)
+}
+
+// UpdateBucketLogging overrides the default UpdateBucketLogging behaviour by validating the configuration
+// and ensuring that the target bucket exists in the same project.
+func (buckets *Service) UpdateBucketLogging(ctx context.Context, bucketName []byte, projectID uuid.UUID, logging Logging) error {
+	if err := ValidateLogging(bucketName, logging); err != nil {
+		return err
+	}
+
+	if logging.Enabled() {
+		exists, err := buckets.HasBucket(ctx, logging.TargetBucket, projectID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrInvalidLogging.New("target bucket %q does not exist", logging.TargetBucket)
+		}
+	}
+
+	return buckets.DB.UpdateBucketLogging(ctx, bucketName, projectID, logging)
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"unicode/utf8"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// MaxTags is the maximum number of tags a bucket can have.
+	MaxTags = 50
+	// MaxTagKeyLength is the maximum length of a tag key in characters.
+	MaxTagKeyLength = 128
+	// MaxTagValueLength is the maximum length of a tag value in characters.
+	MaxTagValueLength = 256
+)
+
+// ErrInvalidTags is returned when a bucket tag set exceeds the limits.
+var ErrInvalidTags = errs.Class("invalid bucket tags")
+
+// ValidateTags checks whether the tag set fits in the bucket tagging limits.
+func ValidateTags(tags map[string]string) error {
+	if len(tags) > MaxTags {
+		return ErrInvalidTags.New("at most %d tags are allowed, got %d", MaxTags, len(tags))
+	}
+	for key, value := range tags {
+		if key == "" {
+			return ErrInvalidTags.New("tag key can't be empty")
+		}
+		if n := utf8.RuneCountInString(key); n > MaxTagKeyLength {
+			return ErrInvalidTags.New("tag key %q is %d characters, at most %d are allowed", key, n, MaxTagKeyLength)
+		}
+		if n := utf8.RuneCountInString(value); n > MaxTagValueLength {
+			return ErrInvalidTags.New("value of tag %q is %d characters, at most %d are allowed", key, n, MaxTagValueLength)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrBucketTransferNotFound is returned when a bucket has no pending transfer.
+var ErrBucketTransferNotFound = errs.Class("bucket transfer not found")
+
+// Transfer is a pending transfer of a bucket to another project.
+//
+// A bucket is transferred in two phases, like a Rename. First the bucket moves to the
+// new project together with a record of the transfer, then its objects are moved to the
+// new project and finally the record is removed. While the record exists, the name can't
+// be used for a new bucket of the old project, so a transfer interrupted in the second
+// phase can be resumed.
+type Transfer struct {
+	ProjectID    uuid.UUID
+	BucketName   []byte
+	NewProjectID uuid.UUID
+	CreatedAt    time.Time
+}
@@ -59,6 +59,9 @@ type APIKeyInfo struct {
 	Head      []byte    `json:"-"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+	// CreatedBy is the user, who created the key. It's zero for the keys created
+	// before it was recorded and the ones created by the admin.
+	CreatedBy uuid.UUID `json:"createdBy"`
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/text/language"
 
 	"storj.io/common/uuid"
 	"storj.io/storj/private/post"
@@ -225,6 +226,7 @@ func (a *Auth) Register(w http.ResponseWriter, r *http.Request) {
 				LetUsKnowURL:               a.LetUsKnowURL,
 				ContactInfoURL:             a.ContactInfoURL,
 				TermsAndConditionsURL:      a.TermsAndConditionsURL,
+				UserLocale:                 requestLocale(r),
 			},
 		)
 		userID = verified.ID
@@ -332,6 +334,7 @@ func (a *Auth) Register(w http.ResponseWriter, r *http.Request) {
 			ActivationLink: link,
 			Origin:         a.ExternalAddress,
 			UserName:       userName,
+			UserLocale:     requestLocale(r),
 		},
 	)
 }
@@ -355,6 +358,16 @@ func loadSession(req *http.Request) string {
 	return sessionCookie.Value
 }
 
+// requestLocale returns the locale the user prefers most, according to the
+// Accept-Language header of the request, the emails sent in response are rendered in it.
+func requestLocale(req *http.Request) string {
+	tags, _, err := language.ParseAcceptLanguage(req.Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+	return tags[0].String()
+}
+
 // UpdateAccount updates user's full name and short name.
 func (a *Auth) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -534,6 +547,7 @@ func (a *Auth) ForgotPassword(w http.ResponseWriter, r *http.Request) {
 			LetUsKnowURL:               letUsKnowURL,
 			ContactInfoURL:             contactInfoURL,
 			TermsAndConditionsURL:      termsAndConditionsURL,
+			UserLocale:                 requestLocale(r),
 		},
 	)
 }
@@ -579,6 +593,7 @@ func (a *Auth) ResendEmail(w http.ResponseWriter, r *http.Request) {
 				LetUsKnowURL:               a.LetUsKnowURL,
 				ContactInfoURL:             a.ContactInfoURL,
 				TermsAndConditionsURL:      a.TermsAndConditionsURL,
+				UserLocale:                 requestLocale(r),
 			},
 		)
 		return
@@ -610,6 +625,7 @@ func (a *Auth) ResendEmail(w http.ResponseWriter, r *http.Request) {
 			TermsAndConditionsURL: termsAndConditionsURL,
 			ContactInfoURL:        contactInfoURL,
 			UserName:              userName,
+			UserLocale:            requestLocale(r),
 		},
 	)
 }
@@ -20,6 +20,15 @@ const (
 	TermsAndConditionsURL = "termsAndConditionsURL"
 )
 
+// EmailTemplates returns the names of the templates used by the console emails.
+func EmailTemplates() []string {
+	return []string{
+		(*AccountActivationEmail)(nil).Template(),
+		(*ForgotPasswordEmail)(nil).Template(),
+		(*ProjectInvitationEmail)(nil).Template(),
+	}
+}
+
 // AccountActivationEmail is mailservice template with activation data.
 type AccountActivationEmail struct {
 	Origin                string
@@ -27,6 +36,9 @@ type AccountActivationEmail struct {
 	ContactInfoURL        string
 	TermsAndConditionsURL string
 	UserName              string
+
+	// UserLocale is the locale of the recipient, which the email is rendered in.
+	UserLocale string
 }
 
 // Template returns email template name.
@@ -35,6 +47,9 @@ func (*AccountActivationEmail) Template() string { return "Welcome" }
 // Subject gets email subject.
 func (*AccountActivationEmail) Subject() string { return "Activate your email" }
 
+// Locale returns the locale the email is rendered in.
+func (email *AccountActivationEmail) Locale() string { return email.UserLocale }
+
 // ForgotPasswordEmail is mailservice template with reset password data.
 type ForgotPasswordEmail struct {
 	Origin                     string
@@ -44,6 +59,9 @@ type ForgotPasswordEmail struct {
 	LetUsKnowURL               string
 	ContactInfoURL             string
 	TermsAndConditionsURL      string
+
+	// UserLocale is the locale of the recipient, which the email is rendered in.
+	UserLocale string
 }
 
 // Template returns email template name.
@@ -52,6 +70,9 @@ func (*ForgotPasswordEmail) Template() string { return "Forgot" }
 // Subject gets email subject.
 func (*ForgotPasswordEmail) Subject() string { return "Password recovery request" }
 
+// Locale returns the locale the email is rendered in.
+func (email *ForgotPasswordEmail) Locale() string { return email.UserLocale }
+
 // ProjectInvitationEmail is mailservice template for project invitation email.
 type ProjectInvitationEmail struct {
 	Origin                string
@@ -61,6 +82,9 @@ type ProjectInvitationEmail struct {
 	LetUsKnowURL          string
 	ContactInfoURL        string
 	TermsAndConditionsURL string
+
+	// UserLocale is the locale of the recipient, which the email is rendered in.
+	UserLocale string
 }
 
 // Template returns email template name.
@@ -70,3 +94,6 @@ func (*ProjectInvitationEmail) Template() string { return "Invite" }
 func (email *ProjectInvitationEmail) Subject() string {
 	return "You were invited to join the Project " + email.ProjectName
 }
+
+// Locale returns the locale the email is rendered in.
+func (email *ProjectInvitationEmail) Locale() string { return email.UserLocale }
@@ -177,7 +177,7 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						termsAndConditionsURL := rootObject[TermsAndConditionsURL].(string)
 
 						mailService.SendRenderedAsync(
-							p.Context,
+							mailservice.WithProjectID(p.Context, projectID),
 							[]post.Address{{Address: user.Email, Name: userName}},
 							&ProjectInvitationEmail{
 								Origin:                origin,
@@ -103,7 +103,7 @@ func TestGraphqlQuery(t *testing.T) {
 
 		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
 		require.NoError(t, err)
-		defer ctx.Check(mailService.Close)
+		defer ctx.Check(func() error { return mailService.Close(ctx) })
 
 		rootObject := make(map[string]interface{})
 		rootObject["origin"] = "http://doesntmatter.com/"
@@ -294,6 +294,11 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	analyticsRouter.Use(server.withAuth)
 	analyticsRouter.HandleFunc("/event", analyticsController.EventTriggered).Methods(http.MethodPost)
 
+	if mailService != nil && mailService.Bounces != nil {
+		// the notifications are authenticated by the signature of the mail provider
+		router.Handle("/api/v0/mail/bounces", mailService.Bounces).Methods(http.MethodPost)
+	}
+
 	if server.config.StaticDir != "" {
 		oidc := oidc.NewEndpoint(server.config.ExternalAddress, logger, oidcService, service,
 			server.config.OauthCodeExpiry, server.config.OauthAccessTokenExpiry, server.config.OauthRefreshTokenExpiry)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 )
 
@@ -47,6 +48,11 @@ type Projects interface {
 	GetMaxBuckets(ctx context.Context, id uuid.UUID) (*int, error)
 	// UpdateBucketLimit is a method for updating projects bucket limit.
 	UpdateBucketLimit(ctx context.Context, id uuid.UUID, newLimit int) error
+
+	// GetDefaultRedundancyScheme returns the project specific redundancy scheme, nil when the project uses the satellite default.
+	GetDefaultRedundancyScheme(ctx context.Context, id uuid.UUID) (*storj.RedundancyScheme, error)
+	// UpdateDefaultRedundancyScheme sets the project specific redundancy scheme, nil resets it to the satellite default.
+	UpdateDefaultRedundancyScheme(ctx context.Context, id uuid.UUID, rs *storj.RedundancyScheme) error
 }
 
 // UsageLimitsConfig is a configuration struct for default per-project usage limits.
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/errs2"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -393,6 +394,41 @@ func TestGetMaxBuckets(t *testing.T) {
 	})
 }
 
+func TestDefaultRedundancyScheme(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		projectsDB := db.Console().Projects()
+		project, err := projectsDB.Insert(ctx, &console.Project{Name: "testproject1"})
+		require.NoError(t, err)
+
+		rs, err := projectsDB.GetDefaultRedundancyScheme(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, rs)
+
+		expected := storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		}
+		require.NoError(t, projectsDB.UpdateDefaultRedundancyScheme(ctx, project.ID, &expected))
+
+		rs, err = projectsDB.GetDefaultRedundancyScheme(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, &expected, rs)
+
+		invalid := expected
+		invalid.RepairShares = 1
+		require.Error(t, projectsDB.UpdateDefaultRedundancyScheme(ctx, project.ID, &invalid))
+
+		require.NoError(t, projectsDB.UpdateDefaultRedundancyScheme(ctx, project.ID, nil))
+		rs, err = projectsDB.GetDefaultRedundancyScheme(ctx, project.ID)
+		require.NoError(t, err)
+		require.Nil(t, rs)
+	})
+}
+
 func TestValidateNameAndDescription(t *testing.T) {
 	t.Run("Project name and description validation test", func(t *testing.T) {
 		validDescription := randString(100)
@@ -31,6 +31,7 @@ import (
 	"storj.io/storj/private/blockchain"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/monetary"
@@ -1749,6 +1750,7 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		Secret:    secret,
 		PartnerID: auth.User.PartnerID,
 		UserAgent: auth.User.UserAgent,
+		CreatedBy: auth.User.ID,
 	}
 
 	info, err := s.store.APIKeys().Create(ctx, key.Head(), apikey)
@@ -1820,6 +1822,7 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		Secret:    secret,
 		PartnerID: auth.User.PartnerID,
 		UserAgent: auth.User.UserAgent,
+		CreatedBy: auth.User.ID,
 	}
 
 	info, err := s.store.APIKeys().Create(ctx, key.Head(), apikey)
@@ -2075,8 +2078,10 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 		return nil, Error.Wrap(err)
 	}
 
-	listOptions := storj.BucketListOptions{
-		Direction: storj.Forward,
+	listOptions := buckets.ListOptions{
+		BucketListOptions: storj.BucketListOptions{
+			Direction: storj.Forward,
+		},
 	}
 
 	allowedBuckets := macaroon.AllowedBuckets{
@@ -39,6 +39,8 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
+	"storj.io/storj/satellite/metainfo/bucketlimitmonitor"
+	"storj.io/storj/satellite/metainfo/bucketsweeper"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/orders"
@@ -121,6 +123,14 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	BucketSweeper struct {
+		Chore *bucketsweeper.Chore
+	}
+
+	BucketLimitMonitor struct {
+		Chore *bucketlimitmonitor.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
@@ -218,15 +228,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup mailservice
-		peer.Mail.Service, err = setupMailService(peer.Log, *config)
+		peer.Mail.Service, err = setupMailService(peer.Log, db, *config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "mail:service",
-			Close: peer.Mail.Service.Close,
+			Close: closeMailService(peer.Mail.Service, config.Mail.Queue.DrainTimeout),
 		})
+		peer.Debug.Server.Panel.Add(mailServiceDebugButtons(peer.Mail.Service))
 	}
 
 	{ // setup email reminders
@@ -410,9 +421,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup expired segment cleanup
+		expiredDeletionConfig := config.ExpiredDeletion
+		expiredDeletionConfig.StartJitter = config.ChoreJitter.For(expiredDeletionConfig.StartJitter)
+
 		peer.ExpiredDeletion.Chore = expireddeletion.NewChore(
 			peer.Log.Named("core-expired-deletion"),
-			config.ExpiredDeletion,
+			expiredDeletionConfig,
 			peer.Metainfo.Metabase,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -425,9 +439,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 	}
 
 	{ // setup zombie objects cleanup
+		zombieDeletionConfig := config.ZombieDeletion
+		zombieDeletionConfig.StartJitter = config.ChoreJitter.For(zombieDeletionConfig.StartJitter)
+
 		peer.ZombieDeletion.Chore = zombiedeletion.NewChore(
 			peer.Log.Named("core-zombie-deletion"),
-			config.ZombieDeletion,
+			zombieDeletionConfig,
 			peer.Metainfo.Metabase,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -439,8 +456,44 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup soft-deleted buckets cleanup
+		peer.BucketSweeper.Chore = bucketsweeper.NewChore(
+			peer.Log.Named("core-bucket-sweeper"),
+			config.BucketSweeper,
+			config.Metainfo.BucketSoftDelete,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketsweeper:chore",
+			Run:   peer.BucketSweeper.Chore.Run,
+			Close: peer.BucketSweeper.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Soft-deleted Buckets Chore", peer.BucketSweeper.Chore.Loop))
+	}
+
+	{ // setup bucket limit monitor
+		peer.BucketLimitMonitor.Chore = bucketlimitmonitor.NewChore(
+			peer.Log.Named("core-bucket-limit-monitor"),
+			config.BucketLimitMonitor,
+			config.Metainfo.ProjectLimits.MaxBuckets,
+			peer.DB.Buckets(),
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "bucketlimitmonitor:chore",
+			Run:   peer.BucketLimitMonitor.Chore.Run,
+			Close: peer.BucketLimitMonitor.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Limit Monitor Chore", peer.BucketLimitMonitor.Chore.Loop))
+	}
+
 	{ // setup accounting
-		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, config.Tally)
+		tallyConfig := config.Tally
+		tallyConfig.StartJitter = config.ChoreJitter.For(tallyConfig.StartJitter)
+
+		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, tallyConfig)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "accounting:tally",
 			Run:   peer.Accounting.Tally.Run,
@@ -459,7 +512,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		// Lets add 1 more day so we catch any off by one errors when deleting tallies
 		orderExpirationPlusDay := config.Orders.Expiration + config.Rollup.Interval
-		peer.Accounting.Rollup = rollup.New(peer.Log.Named("accounting:rollup"), peer.DB.StoragenodeAccounting(), config.Rollup.Interval, config.Rollup.DeleteTallies, orderExpirationPlusDay)
+		rollupConfig := config.Rollup
+		rollupConfig.StartJitter = config.ChoreJitter.For(rollupConfig.StartJitter)
+		peer.Accounting.Rollup = rollup.New(peer.Log.Named("accounting:rollup"), peer.DB.StoragenodeAccounting(), rollupConfig, orderExpirationPlusDay)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "accounting:rollup",
 			Run:   peer.Accounting.Rollup.Run,
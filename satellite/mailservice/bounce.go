@@ -0,0 +1,169 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+)
+
+// ProjectIDHeader is the header, which SuppressionSender adds to the emails about
+// a project, so the bounces and complaints reported by the mail provider can be
+// recorded in the suppression list of the project.
+const ProjectIDHeader = "X-Storj-Project-ID"
+
+// BounceConfig defines the receiving of the bounce and complaint notifications.
+type BounceConfig struct {
+	Provider          string `help:"provider of the bounce and complaint notifications posted to /api/v0/mail/bounces, ses or mailgun, empty disables receiving them" default:""`
+	SESTopicARN       string `help:"arn of the sns topic the ses bounce and complaint notifications are published to, with the original headers included, used by the ses provider" default:""`
+	MailgunSigningKey string `help:"http webhook signing key, used by the mailgun provider" default:""`
+}
+
+// ErrBounceSignature is the error class for bounce notifications, whose signature
+// can't be verified.
+var ErrBounceSignature = errs.Class("bounce notification signature")
+
+// maxBounceNotificationSize is the maximum size of a bounce notification request body.
+const maxBounceNotificationSize = 1 << 20
+
+// BounceType is the kind of a bounce reported by a mail provider.
+type BounceType int
+
+const (
+	// BounceSoft is a temporary delivery failure, such as a full mailbox.
+	BounceSoft BounceType = iota
+	// BounceHard is a permanent delivery failure, such as a nonexistent address.
+	BounceHard
+	// BounceComplaint is the recipient marking the email as spam.
+	BounceComplaint
+)
+
+// String returns the name of the bounce type.
+func (bounceType BounceType) String() string {
+	switch bounceType {
+	case BounceSoft:
+		return "soft"
+	case BounceHard:
+		return "hard"
+	case BounceComplaint:
+		return "complaint"
+	default:
+		return "unknown"
+	}
+}
+
+// Bounce is a bounced or complained about email address.
+type Bounce struct {
+	Type  BounceType
+	Email string
+	// ProjectID is the project the email was about, it's zero when the email
+	// wasn't sent with WithProjectID.
+	ProjectID uuid.UUID
+}
+
+// BounceProvider verifies and parses the bounce and complaint notifications
+// posted by a mail provider.
+type BounceProvider interface {
+	// ParseBounces returns the bounces reported by the notification request.
+	// Requests, whose signature can't be verified, fail with ErrBounceSignature.
+	ParseBounces(ctx context.Context, req *http.Request) ([]Bounce, error)
+}
+
+// BounceReceiver is an http.Handler, which records the hard bounces and complaints
+// posted by the mail provider in the suppression lists, so those addresses aren't
+// emailed again. Soft bounces are ignored.
+type BounceReceiver struct {
+	log          *zap.Logger
+	provider     BounceProvider
+	suppressions *SuppressionSender
+}
+
+// NewBounceReceiver returns a receiver of the notifications of provider, which
+// adds the bounced addresses to suppressions.
+func NewBounceReceiver(log *zap.Logger, provider BounceProvider, suppressions *SuppressionSender) *BounceReceiver {
+	return &BounceReceiver{
+		log:          log,
+		provider:     provider,
+		suppressions: suppressions,
+	}
+}
+
+// ServeHTTP handles a single notification of the mail provider.
+func (receiver *BounceReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if req.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	req.Body = http.MaxBytesReader(w, req.Body, maxBounceNotificationSize)
+
+	bounces, err := receiver.provider.ParseBounces(ctx, req)
+	if err != nil {
+		if ErrBounceSignature.Has(err) {
+			mon.Meter("mail_bounce_rejected").Mark(1)
+			receiver.log.Warn("rejected bounce notification", zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		receiver.log.Debug("invalid bounce notification", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	for _, bounce := range bounces {
+		err = receiver.record(ctx, bounce)
+		if err != nil {
+			receiver.log.Error("unable to record bounce", zap.Stringer("Project ID", bounce.ProjectID), zap.Error(err))
+			// the provider retries the notification
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// record adds the address of a hard bounce or a complaint to the suppression list
+// of the project the email was about.
+func (receiver *BounceReceiver) record(ctx context.Context, bounce Bounce) error {
+	mon.Counter("mail_bounces", monkit.NewSeriesTag("type", bounce.Type.String())).Inc(1)
+
+	if bounce.Type == BounceSoft || bounce.Email == "" {
+		return nil
+	}
+	if bounce.ProjectID.IsZero() {
+		// the suppression lists are per project, so there's nothing to add it to
+		mon.Meter("mail_bounce_without_project").Mark(1)
+		receiver.log.Debug("bounce of an email without a project", zap.Stringer("type", bounce.Type))
+		return nil
+	}
+	return receiver.suppressions.AddSuppression(ctx, bounce.ProjectID, bounce.Email)
+}
+
+// MessageProjectID returns the project, which the message is about, as set by
+// SuppressionSender in ProjectIDHeader.
+func MessageProjectID(msg *post.Message) (uuid.UUID, bool) {
+	prefix := strings.ToLower(ProjectIDHeader) + ":"
+	for _, header := range msg.ExtraHeaders {
+		if !strings.HasPrefix(strings.ToLower(header), prefix) {
+			continue
+		}
+		projectID, err := uuid.FromString(strings.TrimSpace(header[len(prefix):]))
+		if err != nil {
+			return uuid.UUID{}, false
+		}
+		return projectID, true
+	}
+	return uuid.UUID{}, false
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// DKIMConfig defines the options used for DKIM signing outgoing mail.
+type DKIMConfig struct {
+	Domain         string `help:"domain used for DKIM signing, signing is disabled when empty" default:""`
+	Selector       string `help:"selector of the DKIM public key record" default:""`
+	PrivateKeyPath string `help:"path to the PEM encoded RSA private key used for DKIM signing" default:""`
+}
+
+// Load returns the configured DKIM signer or nil, when signing is disabled.
+func (config DKIMConfig) Load() (*post.DKIMSigner, error) {
+	if config.Domain == "" && config.Selector == "" && config.PrivateKeyPath == "" {
+		return nil, nil
+	}
+	if config.Domain == "" || config.Selector == "" || config.PrivateKeyPath == "" {
+		return nil, errs.New("dkim signing requires domain, selector and private key path to be set")
+	}
+
+	data, err := os.ReadFile(config.PrivateKeyPath)
+	if err != nil {
+		return nil, errs.New("unable to read dkim private key: %v", err)
+	}
+	key, err := post.ParseDKIMKey(data)
+	if err != nil {
+		return nil, errs.New("invalid dkim private key %q: %v", config.PrivateKeyPath, err)
+	}
+
+	return &post.DKIMSigner{
+		Domain:   config.Domain,
+		Selector: config.Selector,
+		Key:      key,
+	}, nil
+}
+
+// DKIMSender is a Sender, which adds a DKIM-Signature header to messages.
+type DKIMSender struct {
+	Sender
+
+	signer *post.DKIMSigner
+}
+
+// NewDKIMSender returns a sender, which signs messages with signer before sending them with sender.
+func NewDKIMSender(sender Sender, signer *post.DKIMSigner) *DKIMSender {
+	return &DKIMSender{
+		Sender: sender,
+		signer: signer,
+	}
+}
+
+// SendEmail signs and sends the message.
+func (sender *DKIMSender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	signed, err := sender.sign(msg)
+	if err != nil {
+		return err
+	}
+	return sender.Sender.SendEmail(ctx, signed)
+}
+
+// SendBatch signs the messages and sends the ones, which were signed, as a batch.
+func (sender *DKIMSender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs := make([]error, len(msgs))
+	signed := make([]*post.Message, 0, len(msgs))
+	positions := make([]int, 0, len(msgs))
+	for i, msg := range msgs {
+		signedMsg, err := sender.sign(msg)
+		if err != nil {
+			sendErrs[i] = err
+			continue
+		}
+		signed = append(signed, signedMsg)
+		positions = append(positions, i)
+	}
+
+	for i, err := range sendBatch(ctx, sender.Sender, signed) {
+		sendErrs[positions[i]] = err
+	}
+	return sendErrs
+}
+
+// sign returns a copy of the message with the DKIM-Signature header.
+func (sender *DKIMSender) sign(msg *post.Message) (_ *post.Message, err error) {
+	signed := *msg
+	if signed.Boundary == "" {
+		// the boundary must not change between signing and sending.
+		signed.Boundary, err = randomBoundary()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := signed.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := sender.signer.Sign(data, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	signed.ExtraHeaders = append([]string{header}, msg.ExtraHeaders...)
+
+	return &signed, nil
+}
+
+// Close closes the wrapped sender.
+func (sender *DKIMSender) Close() error {
+	return closeSender(sender.Sender)
+}
+
+// HealthCheck checks the wrapped sender.
+func (sender *DKIMSender) HealthCheck(ctx context.Context) error {
+	return healthCheckSender(ctx, sender.Sender)
+}
+
+// randomBoundary returns a random multipart boundary.
+func randomBoundary() (string, error) {
+	var buf [30]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/post"
+)
+
+// NamedSender is a Sender of a fallback chain, the name identifies it in logs and metrics.
+type NamedSender struct {
+	Name   string
+	Sender Sender
+}
+
+// FallbackSender is a Sender, which tries its senders in order until one of them
+// sends the email. It only falls back to the next sender, when the mail server is
+// unavailable or sending fails with a transient error, a permanent rejection,
+// e.g. of a bad recipient, is returned without trying the other senders.
+type FallbackSender struct {
+	log     *zap.Logger
+	senders []NamedSender
+
+	// ShouldFallback decides whether the next sender should be tried after an error.
+	ShouldFallback func(error) bool
+}
+
+// NewFallbackSender creates a new FallbackSender, which tries the senders in the given order.
+func NewFallbackSender(log *zap.Logger, senders ...NamedSender) *FallbackSender {
+	return &FallbackSender{
+		log:            log,
+		senders:        senders,
+		ShouldFallback: IsUnavailable,
+	}
+}
+
+// IsUnavailable returns whether sending failed, because the mail server couldn't be
+// reached or the session with it couldn't be established, e.g. the host doesn't
+// resolve or the TLS handshake fails, or with a transient error. Another sender
+// may still send the message.
+func IsUnavailable(err error) bool {
+	return post.ErrConnection.Has(err) || post.ErrTLS.Has(err) || IsTransient(err)
+}
+
+// FromAddress returns the from address of the first sender.
+func (sender *FallbackSender) FromAddress() post.Address {
+	return sender.senders[0].Sender.FromAddress()
+}
+
+// SendEmail sends the message with the first sender, which succeeds.
+// It returns the error of the last sender tried, when all of them fail.
+func (sender *FallbackSender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for i, named := range sender.senders {
+		err = named.Sender.SendEmail(ctx, msg)
+		if !sender.fallback(i, err) {
+			if err == nil {
+				sender.served(named)
+			}
+			return err
+		}
+	}
+	return err
+}
+
+// SendBatch sends the messages as a batch with the first sender and then tries the
+// ones, which failed because it was unavailable, as a batch with the next sender.
+func (sender *FallbackSender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs := make([]error, len(msgs))
+	positions := make([]int, len(msgs))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	for i, named := range sender.senders {
+		var remaining []*post.Message
+		var remainingPositions []int
+		for k, err := range sendBatch(ctx, named.Sender, msgs) {
+			sendErrs[positions[k]] = err
+			if sender.fallback(i, err) {
+				remaining = append(remaining, msgs[k])
+				remainingPositions = append(remainingPositions, positions[k])
+				continue
+			}
+			if err == nil {
+				sender.served(named)
+			}
+		}
+		if len(remaining) == 0 {
+			break
+		}
+		msgs, positions = remaining, remainingPositions
+	}
+	return sendErrs
+}
+
+// fallback returns whether the next sender should be tried after the i-th sender failed with err.
+func (sender *FallbackSender) fallback(i int, err error) bool {
+	if err == nil || i == len(sender.senders)-1 || !sender.ShouldFallback(err) {
+		return false
+	}
+	sender.log.Warn("sending email failed, falling back to the next sender",
+		zap.String("sender", sender.senders[i].Name),
+		zap.String("next", sender.senders[i+1].Name),
+		zap.Error(err))
+	return true
+}
+
+// served counts a message sent by the sender.
+func (sender *FallbackSender) served(named NamedSender) {
+	mon.Counter("mail_fallback_sent", monkit.NewSeriesTag("sender", named.Name)).Inc(1)
+}
+
+// Close closes all the senders.
+func (sender *FallbackSender) Close() error {
+	var group errs.Group
+	for _, named := range sender.senders {
+		group.Add(closeSender(named.Sender))
+	}
+	return group.Err()
+}
+
+// HealthCheck checks all the senders, so a broken backup is noticed before it's needed.
+func (sender *FallbackSender) HealthCheck(ctx context.Context) error {
+	var group errs.Group
+	for _, named := range sender.senders {
+		if err := healthCheckSender(ctx, named.Sender); err != nil {
+			group.Add(fmt.Errorf("%s: %w", named.Name, err))
+		}
+	}
+	return group.Err()
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// chainSender records its name in calls and fails with the error set for the subject.
+type chainSender struct {
+	name   string
+	calls  *[]string
+	errors map[string]error
+}
+
+func (sender *chainSender) FromAddress() post.Address {
+	return post.Address{Address: sender.name + "@mail.test"}
+}
+
+func (sender *chainSender) SendEmail(ctx context.Context, msg *post.Message) error {
+	*sender.calls = append(*sender.calls, sender.name+":"+msg.Subject)
+	return sender.errors[msg.Subject]
+}
+
+func TestFallbackSender(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	unavailable := mailservice.ErrTransient.New("connection refused")
+	greylisted := &textproto.Error{Code: 451, Msg: "greylisted, try again later"}
+	rejected := &textproto.Error{Code: 550, Msg: "mailbox unavailable"}
+
+	newChain := func(calls *[]string, errors ...map[string]error) *mailservice.FallbackSender {
+		var senders []mailservice.NamedSender
+		for i, name := range []string{"primary", "backup", "last"} {
+			senders = append(senders, mailservice.NamedSender{
+				Name:   name,
+				Sender: &chainSender{name: name, calls: calls, errors: errors[i]},
+			})
+		}
+		return mailservice.NewFallbackSender(zaptest.NewLogger(t), senders...)
+	}
+
+	t.Run("primary succeeds", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls, nil, nil, nil)
+
+		require.NoError(t, chain.SendEmail(ctx, &post.Message{Subject: "a"}))
+		require.Equal(t, []string{"primary:a"}, calls)
+		require.Equal(t, "primary@mail.test", chain.FromAddress().Address)
+	})
+
+	t.Run("transient failures fall back in order", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls,
+			map[string]error{"a": unavailable},
+			map[string]error{"a": greylisted},
+			nil)
+
+		require.NoError(t, chain.SendEmail(ctx, &post.Message{Subject: "a"}))
+		require.Equal(t, []string{"primary:a", "backup:a", "last:a"}, calls)
+	})
+
+	t.Run("unavailable primary falls back", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls,
+			map[string]error{
+				"a": post.ErrConnection.Wrap(&net.DNSError{Err: "no such host", Name: "mail.invalid", IsNotFound: true}),
+				"b": post.ErrTLS.Wrap(tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}),
+			},
+			nil, nil)
+
+		require.NoError(t, chain.SendEmail(ctx, &post.Message{Subject: "a"}))
+		require.NoError(t, chain.SendEmail(ctx, &post.Message{Subject: "b"}))
+		require.Equal(t, []string{"primary:a", "backup:a", "primary:b", "backup:b"}, calls)
+	})
+
+	t.Run("unreachable smtp server falls back", func(t *testing.T) {
+		// the server closes the connections without a greeting
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ctx.Check(listener.Close)
+		ctx.Go(func() error {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return nil
+				}
+				_ = conn.Close()
+			}
+		})
+
+		var calls []string
+		chain := mailservice.NewFallbackSender(zaptest.NewLogger(t),
+			mailservice.NamedSender{Name: "primary", Sender: &post.SMTPSender{
+				ServerAddress: listener.Addr().String(),
+				From:          post.Address{Address: "primary@mail.test"},
+				DialTimeout:   5 * time.Second,
+			}},
+			mailservice.NamedSender{Name: "backup", Sender: &chainSender{name: "backup", calls: &calls}},
+		)
+
+		require.NoError(t, chain.SendEmail(ctx, &post.Message{Subject: "a"}))
+		require.Equal(t, []string{"backup:a"}, calls)
+	})
+
+	t.Run("permanent rejection does not fall back", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls, map[string]error{"a": rejected}, nil, nil)
+
+		err := chain.SendEmail(ctx, &post.Message{Subject: "a"})
+		require.ErrorIs(t, err, rejected)
+		require.Equal(t, []string{"primary:a"}, calls)
+	})
+
+	t.Run("all senders fail", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls,
+			map[string]error{"a": unavailable},
+			map[string]error{"a": unavailable},
+			map[string]error{"a": greylisted})
+
+		err := chain.SendEmail(ctx, &post.Message{Subject: "a"})
+		require.ErrorIs(t, err, greylisted)
+		require.Equal(t, []string{"primary:a", "backup:a", "last:a"}, calls)
+	})
+
+	t.Run("batch falls back only the failed messages", func(t *testing.T) {
+		var calls []string
+		chain := newChain(&calls,
+			map[string]error{"b": unavailable, "c": rejected, "d": unavailable},
+			map[string]error{"d": rejected},
+			nil)
+
+		sendErrs := chain.SendBatch(ctx, []*post.Message{
+			{Subject: "a"}, {Subject: "b"}, {Subject: "c"}, {Subject: "d"},
+		})
+		require.Len(t, sendErrs, 4)
+		require.NoError(t, sendErrs[0])
+		require.NoError(t, sendErrs[1])
+		require.ErrorIs(t, sendErrs[2], rejected)
+		require.ErrorIs(t, sendErrs[3], rejected)
+		require.Equal(t, []string{
+			"primary:a", "primary:b", "primary:c", "primary:d",
+			"backup:b", "backup:d",
+		}, calls)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalizedMessage is implemented by messages, which are rendered in the locale
+// of their recipient.
+type LocalizedMessage interface {
+	Message
+	// Locale returns the locale of the recipient, e.g. "de" or "pt-BR", empty
+	// means the default locale.
+	Locale() string
+}
+
+// loadLocalizedTemplates loads the html templates of each of the locale
+// subdirectories of templatePath, e.g. templatePath/de/*.html.
+func loadLocalizedTemplates(templatePath string) (map[string]*htmltemplate.Template, error) {
+	entries, err := os.ReadDir(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	localized := map[string]*htmltemplate.Template{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		pattern := filepath.Join(templatePath, entry.Name(), "*.html")
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		templates, err := htmltemplate.ParseGlob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		localized[normalizeLocale(entry.Name())] = templates
+	}
+	return localized, nil
+}
+
+// normalizeLocale returns the locale in the form it's looked up with, e.g. "pt_BR" as "pt-br".
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// templatesFor returns the templates to render the template name for locale with.
+// The locale is tried first, then its parent locales, e.g. "pt" for "pt-BR", then
+// FallbackLocales, and finally the default templates in the template path itself.
+func (service *Service) templatesFor(name, locale string) *htmltemplate.Template {
+	for _, candidate := range service.localeChain(locale) {
+		if templates, ok := service.localized[candidate]; ok && templates.Lookup(name) != nil {
+			return templates
+		}
+	}
+	return service.html
+}
+
+// localeChain returns the locales tried in order for locale.
+func (service *Service) localeChain(locale string) []string {
+	var chain []string
+	for locale = normalizeLocale(locale); locale != ""; {
+		chain = append(chain, locale)
+		dash := strings.LastIndexByte(locale, '-')
+		if dash < 0 {
+			break
+		}
+		locale = locale[:dash]
+	}
+	for _, fallback := range service.FallbackLocales {
+		chain = append(chain, normalizeLocale(fallback))
+	}
+	return chain
+}
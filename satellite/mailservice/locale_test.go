@@ -0,0 +1,76 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// localizedMessage is a test message rendered in the locale of its recipient.
+type localizedMessage struct {
+	template string
+	locale   string
+}
+
+func (msg *localizedMessage) Template() string { return msg.template }
+func (msg *localizedMessage) Subject() string  { return msg.template }
+func (msg *localizedMessage) Locale() string   { return msg.locale }
+
+func TestServiceLocalizedTemplates(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	templates := map[string]string{
+		"Welcome.html":    "welcome",
+		"Forgot.html":     "forgot",
+		"test.html":       "test",
+		"de/Welcome.html": "willkommen",
+		"fr/Welcome.html": "bienvenue",
+		"fr/Forgot.html":  "oublié",
+		"pt/Welcome.html": "bem-vindo",
+	}
+	for name, content := range templates {
+		require.NoError(t, os.WriteFile(ctx.File("templates", name), []byte(content), 0644))
+	}
+
+	recorder := &recordingSender{}
+	service, err := mailservice.New(zaptest.NewLogger(t), recorder, ctx.Dir("templates"))
+	require.NoError(t, err)
+
+	render := func(template, locale string) string {
+		recorder.messages = nil
+		require.NoError(t, service.SendRendered(ctx, []post.Address{{Address: "foo@mail.test"}}, &localizedMessage{template: template, locale: locale}))
+		require.Len(t, recorder.messages, 1)
+		return recorder.messages[0].Parts[0].Content
+	}
+
+	require.Equal(t, "willkommen", render("Welcome", "de"))
+	require.Equal(t, "bienvenue", render("Welcome", "FR"))
+	// a regional locale uses the templates of its language
+	require.Equal(t, "bem-vindo", render("Welcome", "pt-BR"))
+
+	// without a locale or a translation, the default templates are used
+	require.Equal(t, "welcome", render("Welcome", ""))
+	require.Equal(t, "welcome", render("Welcome", "es"))
+	require.Equal(t, "forgot", render("Forgot", "de"))
+
+	// messages without a locale use the default templates
+	recorder.messages = nil
+	require.NoError(t, service.SendRendered(ctx, []post.Address{{Address: "foo@mail.test"}}, &testMessage{}))
+	require.Len(t, recorder.messages, 1)
+	require.Equal(t, "test", recorder.messages[0].Parts[0].Content)
+
+	// the fallback locales are tried before the default templates
+	service.FallbackLocales = []string{"es", "fr"}
+	require.Equal(t, "oublié", render("Forgot", "de"))
+	require.Equal(t, "willkommen", render("Welcome", "de"))
+	require.Equal(t, "bienvenue", render("Welcome", "it"))
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"storj.io/storj/private/post"
+)
+
+// LogConfig defines how the outcome of each sent email is logged.
+type LogConfig struct {
+	Level         string `help:"level of the log written for each sent email, failures are always logged as errors" default:"debug"`
+	FullAddresses bool   `help:"log the full recipient addresses instead of only their domains" default:"false"`
+}
+
+// ParseLevel returns the configured log level.
+func (config LogConfig) ParseLevel() (zapcore.Level, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(config.Level)); err != nil {
+		return level, errs.New("invalid mail log level %q: %v", config.Level, err)
+	}
+	return level, nil
+}
+
+// logSend logs the outcome of sending msg, rendered from template, which is empty
+// for messages not rendered from a template.
+func (service *Service) logSend(msg *post.Message, template string, err error) {
+	fields := []zap.Field{zap.Strings("recipients", service.loggedAddresses(msg.To))}
+	if template != "" {
+		fields = append(fields, zap.String("template", template))
+	}
+
+	if err == nil {
+		if entry := service.log.Check(service.SendLogLevel, "email sent"); entry != nil {
+			entry.Write(fields...)
+		}
+		return
+	}
+
+	errText := err.Error()
+	if !service.LogFullAddresses {
+		for _, recipient := range msg.To {
+			errText = strings.ReplaceAll(errText, recipient.Address, service.loggedAddress(recipient.Address))
+		}
+	}
+	fields = append(fields,
+		zap.String("category", errorCategory(err)),
+		zap.String("error", errText))
+	service.log.Error("sending email failed", fields...)
+}
+
+// loggedAddresses returns the addresses as they're logged.
+func (service *Service) loggedAddresses(addresses []post.Address) []string {
+	logged := make([]string, len(addresses))
+	for i, address := range addresses {
+		logged[i] = service.loggedAddress(address.Address)
+	}
+	return logged
+}
+
+// loggedAddress returns the address as it's logged, which is only its domain,
+// unless full addresses are logged.
+func (service *Service) loggedAddress(address string) string {
+	if service.LogFullAddresses {
+		return address
+	}
+	if at := strings.LastIndexByte(address, '@'); at >= 0 {
+		return "*" + address[at:]
+	}
+	return "*"
+}
+
+// errorCategory returns the category of a send failure.
+func errorCategory(err error) string {
+	var protoErr *textproto.Error
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "canceled"
+	case ErrSuppressed.Has(err):
+		return "suppressed"
+	case ErrRateLimited.Has(err):
+		return "rate limited"
+	case post.ErrAuth.Has(err):
+		return "auth"
+	case post.ErrTLS.Has(err):
+		return "tls"
+	case post.ErrConnection.Has(err):
+		return "connection"
+	case post.ErrDKIM.Has(err):
+		return "dkim"
+	case errors.As(err, &protoErr) && protoErr.Code >= 500:
+		return "rejected"
+	case IsTransient(err):
+		return "transient"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"fmt"
+	"net/textproto"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+func TestServiceSendLogging(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	rejected := &textproto.Error{Code: 550, Msg: "<rejected@mail.test> mailbox unavailable"}
+	send := func(t *testing.T, config mailservice.LogConfig) *observer.ObservedLogs {
+		core, logs := observer.New(zapcore.DebugLevel)
+		sender := &rejectingSender{rejected: "rejected@mail.test", err: rejected}
+		service, err := mailservice.New(zap.New(core), sender, ctx.Dir("templates"))
+		require.NoError(t, err)
+
+		service.SendLogLevel, err = config.ParseLevel()
+		require.NoError(t, err)
+		service.LogFullAddresses = config.FullAddresses
+
+		require.NoError(t, service.SendRendered(ctx, []post.Address{{Address: "foo@mail.test"}}, &testMessage{}))
+		require.Error(t, service.SendRendered(ctx, []post.Address{{Address: "rejected@mail.test"}}, &testMessage{}))
+		service.SendBatch(ctx, []post.Message{
+			{To: []post.Address{{Address: "bar@mail.test"}}},
+			{To: []post.Address{{Address: "rejected@mail.test"}}},
+		})
+		return logs
+	}
+
+	t.Run("default", func(t *testing.T) {
+		logs := send(t, mailservice.LogConfig{Level: "debug"})
+		require.Equal(t, 4, logs.Len())
+
+		for _, entry := range logs.All() {
+			logged := fmt.Sprint(entry.Message, entry.ContextMap())
+			for _, address := range []string{"foo@mail.test", "bar@mail.test", "rejected@mail.test"} {
+				require.NotContains(t, logged, address)
+			}
+			require.Contains(t, logged, "*@mail.test")
+		}
+
+		sent := logs.FilterMessage("email sent").All()
+		require.Len(t, sent, 2)
+		require.Equal(t, zapcore.DebugLevel, sent[0].Level)
+		require.Equal(t, "test", sent[0].ContextMap()["template"])
+
+		failed := logs.FilterMessage("sending email failed").All()
+		require.Len(t, failed, 2)
+		for _, entry := range failed {
+			require.Equal(t, zapcore.ErrorLevel, entry.Level)
+			require.Equal(t, "rejected", entry.ContextMap()["category"])
+		}
+	})
+
+	t.Run("configured level", func(t *testing.T) {
+		logs := send(t, mailservice.LogConfig{Level: "info"})
+		for _, entry := range logs.FilterMessage("email sent").All() {
+			require.Equal(t, zapcore.InfoLevel, entry.Level)
+		}
+	})
+
+	t.Run("full addresses", func(t *testing.T) {
+		logs := send(t, mailservice.LogConfig{Level: "debug", FullAddresses: true})
+		require.Equal(t, []interface{}{"foo@mail.test"}, logs.All()[0].ContextMap()["recipients"])
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		_, err := mailservice.LogConfig{Level: "loud"}.ParseLevel()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailgun
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// projectIDVariable is the Mailgun user variable, which the project of an email
+// is sent in, so the webhooks report it.
+const projectIDVariable = "storj-project-id"
+
+var _ mailservice.BounceProvider = (*BounceProvider)(nil)
+
+// BounceProvider verifies and parses the Mailgun webhooks of the failed and
+// complained events.
+//
+// architecture: Service
+type BounceProvider struct {
+	signingKey string
+}
+
+// NewBounceProvider creates a provider for the webhooks signed with the webhook signing key.
+func NewBounceProvider(signingKey string) (*BounceProvider, error) {
+	if signingKey == "" {
+		return nil, Error.New("webhook signing key is required")
+	}
+	return &BounceProvider{signingKey: signingKey}, nil
+}
+
+// webhook is the body of a request posted by a Mailgun webhook.
+type webhook struct {
+	Signature struct {
+		Timestamp string `json:"timestamp"`
+		Token     string `json:"token"`
+		Signature string `json:"signature"`
+	} `json:"signature"`
+	EventData struct {
+		Event         string            `json:"event"`
+		Severity      string            `json:"severity"`
+		Recipient     string            `json:"recipient"`
+		UserVariables map[string]string `json:"user-variables"`
+	} `json:"event-data"`
+}
+
+// ParseBounces implements mailservice.BounceProvider.
+func (provider *BounceProvider) ParseBounces(ctx context.Context, req *http.Request) (_ []mailservice.Bounce, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var hook webhook
+	if err := json.NewDecoder(req.Body).Decode(&hook); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(provider.signingKey))
+	_, _ = mac.Write([]byte(hook.Signature.Timestamp + hook.Signature.Token))
+	signature, err := hex.DecodeString(hook.Signature.Signature)
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, mailservice.ErrBounceSignature.New("invalid webhook signature")
+	}
+
+	var bounceType mailservice.BounceType
+	switch event := hook.EventData; {
+	case event.Event == "failed" && event.Severity == "permanent":
+		bounceType = mailservice.BounceHard
+	case event.Event == "failed":
+		bounceType = mailservice.BounceSoft
+	case event.Event == "complained":
+		bounceType = mailservice.BounceComplaint
+	default:
+		return nil, nil
+	}
+
+	projectID, _ := uuid.FromString(hook.EventData.UserVariables[projectIDVariable])
+	return []mailservice.Bounce{{
+		Type:      bounceType,
+		Email:     hook.EventData.Recipient,
+		ProjectID: projectID,
+	}}, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailgun_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/mailgun"
+)
+
+func TestBounceProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, err := mailgun.NewBounceProvider("")
+	require.Error(t, err)
+
+	provider, err := mailgun.NewBounceProvider("signing-key")
+	require.NoError(t, err)
+
+	projectID := testrand.UUID()
+	webhook := func(signingKey, event, severity string) *http.Request {
+		mac := hmac.New(sha256.New, []byte(signingKey))
+		_, _ = mac.Write([]byte("1652864400" + "token"))
+		body := `{
+			"signature": {"timestamp": "1652864400", "token": "token", "signature": "` + hex.EncodeToString(mac.Sum(nil)) + `"},
+			"event-data": {
+				"event": "` + event + `",
+				"severity": "` + severity + `",
+				"recipient": "gone@mail.test",
+				"user-variables": {"storj-project-id": "` + projectID.String() + `"}
+			}
+		}`
+		return httptest.NewRequest(http.MethodPost, "/api/v0/mail/bounces", strings.NewReader(body))
+	}
+
+	for _, tt := range []struct {
+		event    string
+		severity string
+		expected mailservice.BounceType
+	}{
+		{event: "failed", severity: "permanent", expected: mailservice.BounceHard},
+		{event: "failed", severity: "temporary", expected: mailservice.BounceSoft},
+		{event: "complained", expected: mailservice.BounceComplaint},
+	} {
+		bounces, err := provider.ParseBounces(ctx, webhook("signing-key", tt.event, tt.severity))
+		require.NoError(t, err, tt.event)
+		require.Equal(t, []mailservice.Bounce{{
+			Type:      tt.expected,
+			Email:     "gone@mail.test",
+			ProjectID: projectID,
+		}}, bounces, tt.event)
+	}
+
+	bounces, err := provider.ParseBounces(ctx, webhook("signing-key", "delivered", ""))
+	require.NoError(t, err)
+	require.Empty(t, bounces)
+
+	_, err = provider.ParseBounces(ctx, webhook("forged-key", "failed", "permanent"))
+	require.True(t, mailservice.ErrBounceSignature.Has(err))
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package mailgun implements mailservice.Sender using the Mailgun HTTP API.
+//
+// Messages are rendered the same way as for smtp and sent as MIME
+// to the messages.mime endpoint, so headers added by other senders,
+// such as DKIM signatures, are kept.
+package mailgun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for the mailgun sender.
+var Error = errs.Class("mailgun")
+
+var _ mailservice.Sender = (*Sender)(nil)
+
+// Sender sends emails using the Mailgun messages API.
+//
+// architecture: Service
+type Sender struct {
+	from   post.Address
+	domain string
+	apiKey string
+
+	// Endpoint is the url of the Mailgun API, it defaults to the US region.
+	Endpoint string
+	// Client is used for sending requests to the Mailgun API.
+	Client *http.Client
+}
+
+// New creates a new Mailgun sender for the sending domain using the API key.
+func New(from post.Address, domain, apiKey string) (*Sender, error) {
+	if domain == "" {
+		return nil, Error.New("domain is required")
+	}
+	if apiKey == "" {
+		return nil, Error.New("api key is required")
+	}
+
+	return &Sender{
+		from:   from,
+		domain: domain,
+		apiKey: apiKey,
+
+		Endpoint: "https://api.mailgun.net/v3",
+		Client:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// FromAddress implements mailservice.Sender.
+func (sender *Sender) FromAddress() post.Address {
+	return sender.from
+}
+
+// SendEmail sends the message as MIME. The project of the message is sent as
+// a user variable, so the bounce webhooks report it.
+//
+// Throttling and server errors are returned as mailservice.ErrTransient.
+func (sender *Sender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	raw, err := msg.Bytes()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for _, to := range msg.To {
+		if err := form.WriteField("to", to.String()); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	if projectID, ok := mailservice.MessageProjectID(msg); ok {
+		if err := form.WriteField("v:"+projectIDVariable, projectID.String()); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	message, err := form.CreateFormFile("message", "message.mime")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if _, err := message.Write(raw); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := form.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	endpoint := sender.Endpoint + "/" + url.PathEscape(sender.domain) + "/messages.mime"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.SetBasicAuth("api", sender.apiKey)
+
+	resp, err := sender.Client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode == http.StatusOK {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return Error.Wrap(err)
+	}
+
+	reason := readError(resp)
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return mailservice.ErrTransient.New("mailgun: %d: %s", resp.StatusCode, reason)
+	}
+	return Error.New("%d: %s", resp.StatusCode, reason)
+}
+
+// HealthCheck checks that the Mailgun API is reachable and accepts the api key
+// by requesting the sending domain, which doesn't send mail.
+//
+// A rejected api key is returned as post.ErrAuth and failed requests as
+// post.ErrConnection.
+func (sender *Sender) HealthCheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint := sender.Endpoint + "/domains/" + url.PathEscape(sender.domain)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.SetBasicAuth("api", sender.apiKey)
+
+	resp, err := sender.Client.Do(req)
+	if err != nil {
+		return post.ErrConnection.Wrap(Error.Wrap(err))
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode == http.StatusOK {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return Error.Wrap(err)
+	}
+
+	message := readError(resp)
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return post.ErrAuth.Wrap(Error.New("%d: %s", resp.StatusCode, message))
+	}
+	return Error.New("%d: %s", resp.StatusCode, message)
+}
+
+// readError returns the message of a failed Mailgun API response.
+func readError(resp *http.Response) string {
+	var response struct {
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil || response.Message == "" {
+		return resp.Status
+	}
+	return response.Message
+}
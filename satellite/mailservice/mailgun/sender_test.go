@@ -0,0 +1,164 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailgun_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/mailgun"
+)
+
+func TestNew(t *testing.T) {
+	from := post.Address{Address: "noreply@mail.test"}
+
+	_, err := mailgun.New(from, "", "key")
+	require.Error(t, err)
+
+	_, err = mailgun.New(from, "mail.test", "")
+	require.Error(t, err)
+
+	sender, err := mailgun.New(from, "mail.test", "key")
+	require.NoError(t, err)
+	require.Equal(t, "https://api.mailgun.net/v3", sender.Endpoint)
+	require.Equal(t, from, sender.FromAddress())
+}
+
+func TestSendEmail(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	msg := &post.Message{
+		From:      post.Address{Address: "noreply@mail.test"},
+		To:        []post.Address{{Address: "foo@mail.test"}},
+		Subject:   "hello",
+		PlainText: "world",
+	}
+
+	for _, tt := range []struct {
+		name      string
+		status    int
+		response  string
+		errText   string
+		transient bool
+	}{
+		{
+			name:     "success",
+			status:   http.StatusOK,
+			response: `{"id": "<1@mail.test>", "message": "Queued. Thank you."}`,
+		},
+		{
+			name:      "throttled",
+			status:    http.StatusTooManyRequests,
+			response:  `{"message": "Too many requests"}`,
+			errText:   "Too many requests",
+			transient: true,
+		},
+		{
+			name:      "server error",
+			status:    http.StatusServiceUnavailable,
+			response:  `unavailable`,
+			errText:   "503",
+			transient: true,
+		},
+		{
+			name:     "rejected",
+			status:   http.StatusBadRequest,
+			response: `{"message": "to parameter is not a valid address"}`,
+			errText:  "to parameter is not a valid address",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var path, to, rawMessage string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, password, ok := r.BasicAuth(); !ok || user != "api" || password != "key" {
+					http.Error(w, "unauthorized", http.StatusUnauthorized)
+					return
+				}
+
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					http.Error(w, "invalid request", http.StatusBadRequest)
+					return
+				}
+				path = r.URL.Path
+				to = r.MultipartForm.Value["to"][0]
+
+				file, _, err := r.FormFile("message")
+				if err != nil {
+					http.Error(w, "invalid message", http.StatusBadRequest)
+					return
+				}
+				data, _ := ioutil.ReadAll(file)
+				rawMessage = string(data)
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			sender, err := mailgun.New(msg.From, "mail.test", "key")
+			require.NoError(t, err)
+			sender.Endpoint = server.URL + "/v3"
+
+			err = sender.SendEmail(ctx, msg)
+			require.Equal(t, "/v3/mail.test/messages.mime", path)
+			require.Equal(t, "<foo@mail.test>", to)
+			require.Contains(t, rawMessage, "Subject: hello")
+			require.Contains(t, rawMessage, "To: <foo@mail.test>")
+
+			if tt.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errText)
+			require.Equal(t, tt.transient, mailservice.IsTransient(err))
+		})
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var path, method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, method = r.URL.Path, r.Method
+		if user, password, ok := r.BasicAuth(); !ok || user != "api" || password != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Invalid private key"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"domain": {"name": "mail.test"}}`))
+	}))
+	defer server.Close()
+
+	sender, err := mailgun.New(post.Address{Address: "noreply@mail.test"}, "mail.test", "key")
+	require.NoError(t, err)
+	sender.Endpoint = server.URL + "/v3"
+
+	require.NoError(t, sender.HealthCheck(ctx))
+	require.Equal(t, "/v3/domains/mail.test", path)
+	require.Equal(t, http.MethodGet, method)
+
+	invalid, err := mailgun.New(post.Address{Address: "noreply@mail.test"}, "mail.test", "invalid")
+	require.NoError(t, err)
+	invalid.Endpoint = server.URL + "/v3"
+
+	err = invalid.HealthCheck(ctx)
+	require.Error(t, err)
+	require.True(t, post.ErrAuth.Has(err))
+	require.Contains(t, err.Error(), "Invalid private key")
+
+	server.Close()
+	err = sender.HealthCheck(ctx)
+	require.Error(t, err)
+	require.True(t, post.ErrConnection.Has(err))
+}
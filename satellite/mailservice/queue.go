@@ -0,0 +1,158 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/context2"
+	"storj.io/storj/private/post"
+)
+
+// QueueConfig defines the queue of the emails sent asynchronously.
+type QueueConfig struct {
+	Size         int           `help:"maximum number of emails waiting to be sent asynchronously, more are dropped" default:"1000"`
+	Workers      int           `help:"number of emails sent asynchronously at the same time" default:"4"`
+	DrainTimeout time.Duration `help:"how long the queued emails are still sent on shutdown, the ones not sent by then are logged and dropped" default:"30s"`
+}
+
+const (
+	// defaultQueueSize and defaultQueueWorkers are used by the services, which
+	// weren't configured with a queue, such as the ones created by tests.
+	defaultQueueSize    = 1000
+	defaultQueueWorkers = 4
+
+	// asyncSendTimeout limits how long sending a queued email may take.
+	asyncSendTimeout = 5 * time.Second
+)
+
+// queuedEmail is an email waiting to be rendered and sent by the queue workers.
+type queuedEmail struct {
+	ctx context.Context
+	to  []post.Address
+	msg Message
+}
+
+// SendRenderedAsync queues the email to be rendered and sent in the background.
+// It never blocks, the email is logged and dropped when the queue is full or
+// the service is closed.
+func (service *Service) SendRenderedAsync(ctx context.Context, to []post.Address, msg Message) {
+	email := queuedEmail{
+		ctx: context2.WithoutCancellation(ctx),
+		to:  to,
+		msg: msg,
+	}
+
+	service.queueMu.Lock()
+	defer service.queueMu.Unlock()
+
+	if service.queueClosed {
+		service.logDropped(email, "mail service is closed")
+		return
+	}
+	service.startQueue()
+
+	select {
+	case service.queue <- email:
+	default:
+		service.logDropped(email, "mail queue is full")
+	}
+}
+
+// startQueue starts the queue workers, unless they're already running.
+// It must be called with queueMu held.
+func (service *Service) startQueue() {
+	if service.queue != nil {
+		return
+	}
+
+	size, workers := service.Queue.Size, service.Queue.Workers
+	if size <= 0 {
+		size = defaultQueueSize
+	}
+	if workers <= 0 {
+		workers = defaultQueueWorkers
+	}
+
+	service.queue = make(chan queuedEmail, size)
+	for i := 0; i < workers; i++ {
+		service.sending.Add(1)
+		go service.sendQueued()
+	}
+}
+
+// sendQueued sends the queued emails until the queue is closed. Once the drain
+// deadline passes, the remaining emails are logged instead of sent.
+func (service *Service) sendQueued() {
+	defer service.sending.Done()
+
+	for email := range service.queue {
+		select {
+		case <-service.drainExpired:
+			service.logDropped(email, "mail service closed before the email was sent")
+			continue
+		default:
+		}
+
+		service.sendQueuedEmail(email)
+	}
+}
+
+// sendQueuedEmail renders and sends the email, sending is canceled when the drain
+// deadline passes.
+func (service *Service) sendQueuedEmail(email queuedEmail) {
+	ctx, cancel := context.WithTimeout(email.ctx, asyncSendTimeout)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-service.drainExpired:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// the outcome is logged by SendRendered
+	_ = service.SendRendered(ctx, email.to, email.msg)
+}
+
+// Close stops accepting emails, sends the queued ones until ctx is done, and
+// closes the sender. The emails, which weren't sent by then, are logged.
+func (service *Service) Close(ctx context.Context) error {
+	service.queueMu.Lock()
+	if !service.queueClosed {
+		service.queueClosed = true
+		if service.queue != nil {
+			close(service.queue)
+		}
+	}
+	service.queueMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		service.sending.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		service.expireDrain.Do(func() { close(service.drainExpired) })
+		<-drained
+	}
+
+	return closeSender(service.Sender)
+}
+
+// logDropped logs the email, which won't be sent, so it isn't lost silently.
+func (service *Service) logDropped(email queuedEmail, reason string) {
+	mon.Meter("email_dropped").Mark(1)
+	service.log.Error("email dropped",
+		zap.String("reason", reason),
+		zap.Strings("recipients", service.loggedAddresses(email.to)),
+		zap.String("template", email.msg.Template()))
+}
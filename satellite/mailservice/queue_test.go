@@ -0,0 +1,135 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// blockingSender records the sent messages, but sends them only once it's released.
+// It signals started, when it starts sending a message.
+type blockingSender struct {
+	release chan struct{}
+	started chan struct{}
+
+	mu       sync.Mutex
+	messages []post.Message
+}
+
+func (sender *blockingSender) FromAddress() post.Address {
+	return post.Address{Address: "noreply@mail.test"}
+}
+
+func (sender *blockingSender) SendEmail(ctx context.Context, msg *post.Message) error {
+	select {
+	case sender.started <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-sender.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	sender.messages = append(sender.messages, *msg)
+	return nil
+}
+
+func (sender *blockingSender) Sent() int {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+	return len(sender.messages)
+}
+
+func TestServiceCloseDrainsQueue(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	sender := &blockingSender{release: make(chan struct{})}
+	service, err := mailservice.New(zaptest.NewLogger(t), sender, ctx.Dir("templates"))
+	require.NoError(t, err)
+	service.Queue = mailservice.QueueConfig{Size: 10, Workers: 1}
+
+	recipients := []string{"a@mail.test", "b@mail.test", "c@mail.test", "d@mail.test", "e@mail.test"}
+	for _, recipient := range recipients {
+		service.SendRenderedAsync(ctx, []post.Address{{Address: recipient}}, &testMessage{})
+	}
+
+	// the single worker blocks on the first email, so the rest are still queued
+	closed := make(chan error, 1)
+	go func() {
+		closeCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		closed <- service.Close(closeCtx)
+	}()
+	close(sender.release)
+	require.NoError(t, <-closed)
+
+	require.Len(t, sender.messages, len(recipients))
+	for i, recipient := range recipients {
+		require.Equal(t, recipient, sender.messages[i].To[0].Address)
+	}
+
+	// emails queued after closing aren't sent
+	service.SendRenderedAsync(ctx, []post.Address{{Address: "late@mail.test"}}, &testMessage{})
+	require.Equal(t, len(recipients), sender.Sent())
+}
+
+func TestServiceCloseLogsUndrained(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	core, logs := observer.New(zap.ErrorLevel)
+	sender := &blockingSender{release: make(chan struct{}), started: make(chan struct{}, 1)}
+	service, err := mailservice.New(zap.New(core), sender, ctx.Dir("templates"))
+	require.NoError(t, err)
+	service.Queue = mailservice.QueueConfig{Size: 2, Workers: 1}
+
+	// the first email blocks the single worker, the next two fill the queue
+	// and the last one is dropped
+	service.SendRenderedAsync(ctx, []post.Address{{Address: "a@mail.test"}}, &testMessage{})
+	<-sender.started
+	for _, recipient := range []string{"b@mail.test", "c@mail.test", "d@mail.test"} {
+		service.SendRenderedAsync(ctx, []post.Address{{Address: recipient}}, &testMessage{})
+	}
+
+	// the sender never sends, so nothing is drained before the deadline
+	closeCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.NoError(t, service.Close(closeCtx))
+	require.Zero(t, sender.Sent())
+
+	dropped := logs.FilterMessage("email dropped").All()
+	require.Len(t, dropped, 3)
+	reasons := map[string]int{}
+	for _, entry := range dropped {
+		reasons[entry.ContextMap()["reason"].(string)]++
+		require.Equal(t, "test", entry.ContextMap()["template"])
+	}
+	require.Equal(t, map[string]int{
+		"mail queue is full":                            1,
+		"mail service closed before the email was sent": 2,
+	}, reasons)
+
+	// the email being sent is canceled and logged as failed
+	require.Len(t, logs.FilterMessage("sending email failed").All(), 1)
+}
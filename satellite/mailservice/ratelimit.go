@@ -0,0 +1,157 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// ErrRateLimited is the error class for emails, which weren't sent, since
+// all of their recipients exceeded the per-recipient rate limit.
+var ErrRateLimited = errs.Class("mail rate limited")
+
+// RateLimitConfig defines the limit of emails sent to a single address.
+type RateLimitConfig struct {
+	Burst  int           `help:"maximum number of emails sent to a single address within the window, 0 disables the limit" default:"0"`
+	Window time.Duration `help:"time window of the per-recipient email limit" default:"1m"`
+}
+
+// RateLimitSender is a Sender, which limits the number of emails sent to each recipient
+// within a window. Recipients over the limit are dropped from the message.
+type RateLimitSender struct {
+	Sender
+
+	burst  int
+	window time.Duration
+	nowFn  func() time.Time
+
+	mu        sync.Mutex
+	sent      map[string][]time.Time
+	lastSweep time.Time
+}
+
+// NewRateLimitSender returns a sender, which sends at most config.Burst emails
+// to each recipient within config.Window using sender.
+func NewRateLimitSender(sender Sender, config RateLimitConfig) *RateLimitSender {
+	return &RateLimitSender{
+		Sender: sender,
+		burst:  config.Burst,
+		window: config.Window,
+		nowFn:  time.Now,
+		sent:   map[string][]time.Time{},
+	}
+}
+
+// TestingSetNow allows tests to have the sender act as if the current time is whatever they want.
+func (sender *RateLimitSender) TestingSetNow(nowFn func() time.Time) {
+	sender.nowFn = nowFn
+}
+
+// SendEmail sends the message to the recipients, which haven't exceeded the limit.
+// It returns ErrRateLimited, when none of the recipients is left.
+func (sender *RateLimitSender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limited, err := sender.limit(msg)
+	if err != nil {
+		return err
+	}
+	return sender.Sender.SendEmail(ctx, limited)
+}
+
+// SendBatch sends each of the messages to the recipients, which haven't exceeded the limit.
+// Messages without any recipient left fail with ErrRateLimited.
+func (sender *RateLimitSender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs := make([]error, len(msgs))
+	limited := make([]*post.Message, 0, len(msgs))
+	positions := make([]int, 0, len(msgs))
+	for i, msg := range msgs {
+		limitedMsg, err := sender.limit(msg)
+		if err != nil {
+			sendErrs[i] = err
+			continue
+		}
+		limited = append(limited, limitedMsg)
+		positions = append(positions, i)
+	}
+
+	for i, err := range sendBatch(ctx, sender.Sender, limited) {
+		sendErrs[positions[i]] = err
+	}
+	return sendErrs
+}
+
+// limit returns the message with only the recipients, which haven't exceeded the limit.
+// It returns ErrRateLimited, when none of the recipients is left.
+func (sender *RateLimitSender) limit(msg *post.Message) (*post.Message, error) {
+	allowed := sender.allow(msg.To)
+	if len(allowed) == len(msg.To) {
+		return msg, nil
+	}
+
+	mon.Counter("mail_rate_limited_recipients").Inc(int64(len(msg.To) - len(allowed)))
+	if len(allowed) == 0 {
+		return nil, ErrRateLimited.New("all recipients exceeded %d emails per %s", sender.burst, sender.window)
+	}
+
+	limited := *msg
+	limited.To = allowed
+	return &limited, nil
+}
+
+// allow returns the recipients, which are within the limit, and counts the email for them.
+func (sender *RateLimitSender) allow(recipients []post.Address) []post.Address {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	now := sender.nowFn()
+	windowStart := now.Add(-sender.window)
+
+	// addresses, which don't receive emails anymore, are removed once per window.
+	if now.Sub(sender.lastSweep) >= sender.window {
+		for address, times := range sender.sent {
+			if len(times) == 0 || !times[len(times)-1].After(windowStart) {
+				delete(sender.sent, address)
+			}
+		}
+		sender.lastSweep = now
+	}
+
+	allowed := make([]post.Address, 0, len(recipients))
+	for _, recipient := range recipients {
+		address := strings.ToLower(recipient.Address)
+
+		times := sender.sent[address]
+		for len(times) > 0 && !times[0].After(windowStart) {
+			times = times[1:]
+		}
+		if len(times) >= sender.burst {
+			sender.sent[address] = times
+			continue
+		}
+
+		sender.sent[address] = append(times, now)
+		allowed = append(allowed, recipient)
+	}
+	return allowed
+}
+
+// Close closes the wrapped sender.
+func (sender *RateLimitSender) Close() error {
+	return closeSender(sender.Sender)
+}
+
+// HealthCheck checks the wrapped sender.
+func (sender *RateLimitSender) HealthCheck(ctx context.Context) error {
+	return healthCheckSender(ctx, sender.Sender)
+}
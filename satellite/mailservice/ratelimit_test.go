@@ -0,0 +1,51 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+func TestRateLimitSender(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	alice := post.Address{Address: "alice@mail.test"}
+	bob := post.Address{Address: "bob@mail.test"}
+
+	recording := &recordingSender{}
+	limiter := mailservice.NewRateLimitSender(recording, mailservice.RateLimitConfig{
+		Burst:  2,
+		Window: time.Minute,
+	})
+	now := time.Now()
+	limiter.TestingSetNow(func() time.Time { return now })
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, limiter.SendEmail(ctx, &post.Message{To: []post.Address{alice}}))
+	}
+
+	// the third email within the window is rejected
+	err := limiter.SendEmail(ctx, &post.Message{To: []post.Address{alice}})
+	require.True(t, mailservice.ErrRateLimited.Has(err))
+
+	// addresses are compared case-insensitively
+	err = limiter.SendEmail(ctx, &post.Message{To: []post.Address{{Address: "Alice@Mail.Test"}}})
+	require.True(t, mailservice.ErrRateLimited.Has(err))
+
+	// recipients within the limit still get the email
+	require.NoError(t, limiter.SendEmail(ctx, &post.Message{To: []post.Address{alice, bob}}))
+	require.Equal(t, []post.Address{bob}, recording.messages[len(recording.messages)-1].To)
+
+	// once the window passed, emails are sent again
+	now = now.Add(time.Minute + time.Second)
+	require.NoError(t, limiter.SendEmail(ctx, &post.Message{To: []post.Address{alice}}))
+	require.Len(t, recording.messages, 4)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/textproto"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/private/post"
+)
+
+// ErrTransient is the error class for send failures which may succeed when retried.
+var ErrTransient = errs.Class("transient mail error")
+
+// IsTransient returns whether sending mail may succeed when retried after err.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if ErrTransient.Has(err) {
+		return true
+	}
+
+	// 4xx replies are temporary failures, e.g. greylisting.
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		return protoErr.Code >= 400 && protoErr.Code < 500
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// RetrySender is a Sender, which retries sending on transient errors
+// with exponential backoff.
+type RetrySender struct {
+	Sender
+
+	log *zap.Logger
+
+	// MaxRetries is the number of retries after the first failed attempt.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, it's doubled for every following retry.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the wait between retries.
+	MaxBackoff time.Duration
+	// IsTransient decides whether an error should be retried.
+	IsTransient func(error) bool
+}
+
+// NewRetrySender creates a new RetrySender, which retries at most maxRetries times.
+func NewRetrySender(log *zap.Logger, sender Sender, maxRetries int) *RetrySender {
+	return &RetrySender{
+		Sender:         sender,
+		log:            log,
+		MaxRetries:     maxRetries,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		IsTransient:    IsTransient,
+	}
+}
+
+// SendEmail sends the message, retrying on transient errors.
+// It returns the last error when all attempts fail.
+func (sender *RetrySender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return sender.retry(ctx, msg, sender.Sender.SendEmail(ctx, msg))
+}
+
+// SendBatch sends the messages as a batch and then retries the ones, which failed
+// with a transient error, one by one.
+func (sender *RetrySender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs := sendBatch(ctx, sender.Sender, msgs)
+	for i, err := range sendErrs {
+		if err != nil {
+			sendErrs[i] = sender.retry(ctx, msgs[i], err)
+		}
+	}
+	return sendErrs
+}
+
+// retry sends the message again, after the first attempt failed with err,
+// while it fails with a transient error and there are retries left.
+func (sender *RetrySender) retry(ctx context.Context, msg *post.Message, err error) error {
+	backoff := sender.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		if err == nil || attempt >= sender.MaxRetries || !sender.IsTransient(err) {
+			return err
+		}
+
+		sender.log.Debug("retrying sending email",
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+
+		if !sync2.Sleep(ctx, backoff) {
+			return errs.Combine(err, ctx.Err())
+		}
+
+		backoff *= 2
+		if sender.MaxBackoff > 0 && backoff > sender.MaxBackoff {
+			backoff = sender.MaxBackoff
+		}
+
+		err = sender.Sender.SendEmail(ctx, msg)
+	}
+}
+
+// Close closes the wrapped sender.
+func (sender *RetrySender) Close() error {
+	return closeSender(sender.Sender)
+}
+
+// HealthCheck checks the wrapped sender.
+func (sender *RetrySender) HealthCheck(ctx context.Context) error {
+	return healthCheckSender(ctx, sender.Sender)
+}
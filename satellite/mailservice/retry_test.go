@@ -0,0 +1,147 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// flakySender fails with the configured errors before succeeding.
+type flakySender struct {
+	errors []error
+	calls  int
+}
+
+func (sender *flakySender) FromAddress() post.Address {
+	return post.Address{Address: "noreply@mail.test"}
+}
+
+func (sender *flakySender) SendEmail(ctx context.Context, msg *post.Message) error {
+	sender.calls++
+	if sender.calls <= len(sender.errors) {
+		return sender.errors[sender.calls-1]
+	}
+	return nil
+}
+
+func newTestRetrySender(t *testing.T, sender mailservice.Sender, maxRetries int) *mailservice.RetrySender {
+	retry := mailservice.NewRetrySender(zaptest.NewLogger(t), sender, maxRetries)
+	retry.InitialBackoff = time.Millisecond
+	retry.MaxBackoff = 2 * time.Millisecond
+	return retry
+}
+
+func TestRetrySender(t *testing.T) {
+	ctx := testcontext.New(t)
+	msg := &post.Message{Subject: "test"}
+
+	greylisted := &textproto.Error{Code: 451, Msg: "greylisted, try again later"}
+	rejected := &textproto.Error{Code: 550, Msg: "mailbox unavailable"}
+
+	t.Run("succeeds after transient failures", func(t *testing.T) {
+		flaky := &flakySender{errors: []error{mailservice.ErrTransient.New("connection reset"), greylisted}}
+
+		err := newTestRetrySender(t, flaky, 3).SendEmail(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		flaky := &flakySender{errors: []error{greylisted, greylisted, greylisted}}
+
+		err := newTestRetrySender(t, flaky, 2).SendEmail(ctx, msg)
+		require.ErrorIs(t, err, greylisted)
+		require.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("does not retry permanent failures", func(t *testing.T) {
+		flaky := &flakySender{errors: []error{rejected}}
+
+		err := newTestRetrySender(t, flaky, 3).SendEmail(ctx, msg)
+		require.ErrorIs(t, err, rejected)
+		require.Equal(t, 1, flaky.calls)
+	})
+
+	t.Run("zero retries", func(t *testing.T) {
+		flaky := &flakySender{errors: []error{greylisted}}
+
+		err := newTestRetrySender(t, flaky, 0).SendEmail(ctx, msg)
+		require.ErrorIs(t, err, greylisted)
+		require.Equal(t, 1, flaky.calls)
+	})
+
+	t.Run("stops on context cancellation", func(t *testing.T) {
+		flaky := &flakySender{errors: []error{greylisted, greylisted}}
+
+		retry := newTestRetrySender(t, flaky, 3)
+		retry.InitialBackoff = time.Hour
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := retry.SendEmail(cancelCtx, msg)
+		require.Error(t, err)
+		require.True(t, errs.Is(err, context.Canceled))
+		require.Equal(t, 1, flaky.calls)
+	})
+}
+
+func TestRetrySenderSMTPTimeout(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// the listener accepts connections, but never responds.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	connections := 0
+	ctx.Go(func() error {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return nil
+			}
+			mu.Lock()
+			connections++
+			mu.Unlock()
+			ctx.Go(func() error {
+				_, _ = conn.Read(make([]byte, 1))
+				return conn.Close()
+			})
+		}
+	})
+	defer ctx.Check(listener.Close)
+
+	const timeout = 100 * time.Millisecond
+	smtpSender := &post.SMTPSender{
+		ServerAddress: listener.Addr().String(),
+		From:          post.Address{Address: "noreply@mail.test"},
+		DialTimeout:   timeout,
+		SendTimeout:   timeout,
+	}
+
+	// every attempt gets its own timeout and timeouts are retried.
+	start := time.Now()
+	err = newTestRetrySender(t, smtpSender, 2).SendEmail(ctx, &post.Message{Subject: "test"})
+	require.Error(t, err)
+	require.True(t, mailservice.IsTransient(err), err)
+	require.Less(t, time.Since(start), 30*timeout)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, 3, connections)
+}
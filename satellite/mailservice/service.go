@@ -6,30 +6,156 @@ package mailservice
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
 	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"net/mail"
+	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
-	"storj.io/common/context2"
 	"storj.io/storj/private/post"
 )
 
 // Config defines values needed by mailservice service.
 type Config struct {
-	SMTPServerAddress string `help:"smtp server address" default:"" testDefault:"smtp.mail.test:587"`
-	TemplatePath      string `help:"path to email templates source" default:""`
-	From              string `help:"sender email address" default:"" testDefault:"Labs <storj@mail.test>"`
-	AuthType          string `help:"smtp authentication type" releaseDefault:"login" devDefault:"simulate"`
-	Login             string `help:"plain/login auth user login" default:""`
-	Password          string `help:"plain/login auth user password" default:""`
-	RefreshToken      string `help:"refresh token used to retrieve new access token" default:""`
-	ClientID          string `help:"oauth2 app's client id" default:""`
-	ClientSecret      string `help:"oauth2 app's client secret" default:""`
-	TokenURI          string `help:"uri which is used when retrieving new access token" default:""`
+	SMTPServerAddress  string        `help:"smtp server address" default:"" testDefault:"smtp.mail.test:587"`
+	TemplatePath       string        `help:"path to email templates source" default:""`
+	From               string        `help:"sender email address, may include a display name, e.g. \"Storj Support <support@storj.io>\"" default:"" testDefault:"Labs <storj@mail.test>"`
+	ReplyTo            string        `help:"reply-to email address added to every email, may include a display name" default:""`
+	AuthType           string        `help:"smtp authentication type" releaseDefault:"login" devDefault:"simulate"`
+	Login              string        `help:"plain/login/cram-md5/xoauth2 auth user login, xoauth2 uses the from address when empty" default:""`
+	Password           string        `help:"plain/login/cram-md5 auth user password" default:""`
+	RefreshToken       string        `help:"refresh token used to retrieve new access token" default:""`
+	ClientID           string        `help:"oauth2 app's client id" default:""`
+	ClientSecret       string        `help:"oauth2 app's client secret" default:""`
+	TokenURI           string        `help:"uri which is used when retrieving new access token" default:""`
+	SESRegion          string        `help:"aws region of the ses api, used by ses auth type" default:""`
+	SESAccessKeyID     string        `help:"aws access key id, used by ses auth type" default:""`
+	SESSecretAccessKey string        `help:"aws secret access key, used by ses auth type" default:""`
+	MailgunDomain      string        `help:"sending domain of the mailgun api, used by mailgun auth type" default:""`
+	MailgunAPIKey      string        `help:"api key of the mailgun api, used by mailgun auth type" default:""`
+	MaxRetries         int           `help:"maximum number of retries when sending an email fails with a transient error" default:"0"`
+	PoolSize           int           `help:"maximum number of idle smtp connections kept open for reuse, 0 disables pooling" default:"0"`
+	DialTimeout        time.Duration `help:"maximum duration of connecting to the smtp server and establishing the session, 0 means no limit" default:"30s"`
+	SendTimeout        time.Duration `help:"maximum duration of sending an email over an established smtp session, 0 means no limit" default:"1m0s"`
+	FallbackAuthTypes  []string      `help:"auth types of the backup senders, which are tried in order when the mail server is unavailable or sending fails with a transient error, e.g. ses,mailgun" default:""`
+	FromDomains        []string      `help:"domains the from address is allowed to use, which catches a misconfigured from address failing SPF at startup, empty allows any domain" default:""`
+	FallbackLocales    []string      `help:"locales whose templates are used in order, when a template isn't translated to the locale of the recipient, before the default templates in the template path, e.g. en-GB,en" default:""`
+	TLS                TLSConfig
+	DKIM               DKIMConfig
+	XOAUTH2            XOAUTH2Config
+	RateLimit          RateLimitConfig
+	Suppression        SuppressionConfig
+	Bounces            BounceConfig
+	Log                LogConfig
+	Queue              QueueConfig
+}
+
+// ParseFrom returns the sender address, which may include a display name.
+// It fails when the address doesn't use one of the allowed FromDomains.
+func (config Config) ParseFrom() (*post.Address, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, errs.New("invalid mail from address %q: %v", config.From, err)
+	}
+	if err := config.checkFromDomain(from.Address); err != nil {
+		return nil, err
+	}
+	return from, nil
+}
+
+// checkFromDomain checks that the domain of the from address is one of the allowed domains.
+func (config Config) checkFromDomain(address string) error {
+	if len(config.FromDomains) == 0 {
+		return nil
+	}
+
+	domain := address[strings.LastIndexByte(address, '@')+1:]
+	for _, allowed := range config.FromDomains {
+		if strings.EqualFold(domain, strings.TrimSpace(allowed)) {
+			return nil
+		}
+	}
+	return errs.New("mail from address %q uses domain %q, which isn't one of the allowed domains %v", address, domain, config.FromDomains)
+}
+
+// ParseReplyTo returns the reply-to address or nil, when it's not configured.
+func (config Config) ParseReplyTo() (*post.Address, error) {
+	if config.ReplyTo == "" {
+		return nil, nil
+	}
+	replyTo, err := mail.ParseAddress(config.ReplyTo)
+	if err != nil {
+		return nil, errs.New("invalid mail reply-to address %q: %v", config.ReplyTo, err)
+	}
+	return replyTo, nil
+}
+
+// TLSConfig defines TLS options used when connecting to the smtp server.
+type TLSConfig struct {
+	InsecureSkipVerify bool   `help:"skip verification of the smtp server certificate" default:"false"`
+	CAFile             string `help:"path to PEM encoded CA certificates used to verify the smtp server certificate" default:""`
+	ServerName         string `help:"server name used to verify the smtp server certificate, defaults to the smtp server host" default:""`
+	ForceSTARTTLS      bool   `help:"refuse to send mail when the smtp server does not offer STARTTLS" default:"true"`
+}
+
+// Load returns the tls.Config for connecting to the smtp server at host.
+func (config TLSConfig) Load(host string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec // explicitly configured by the operator
+	}
+	if config.ServerName != "" {
+		tlsConfig.ServerName = config.ServerName
+	}
+
+	if config.CAFile != "" {
+		data, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errs.New("no certificates found in %q", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// XOAUTH2Config defines the bearer token used by the xoauth2 auth type.
+type XOAUTH2Config struct {
+	Token           string        `help:"static bearer token, used by xoauth2 auth type" default:""`
+	TokenPath       string        `help:"path to a file containing the bearer token, which is read again after the refresh interval, used by xoauth2 auth type" default:""`
+	RefreshInterval time.Duration `help:"how often the bearer token file is read again" default:"5m"`
+}
+
+// TokenSource returns the source of the configured bearer token.
+func (config XOAUTH2Config) TokenSource() (post.TokenSource, error) {
+	switch {
+	case config.Token != "" && config.TokenPath != "":
+		return nil, errs.New("xoauth2 token and token path can't both be set")
+	case config.Token != "":
+		return post.StaticToken(config.Token), nil
+	case config.TokenPath != "":
+		return &post.FileTokenSource{Path: config.TokenPath, RefreshInterval: config.RefreshInterval}, nil
+	default:
+		return nil, errs.New("xoauth2 auth requires a token or a token path to be set")
+	}
 }
 
 var (
@@ -44,6 +170,22 @@ type Sender interface {
 	FromAddress() post.Address
 }
 
+// HealthChecker is implemented by senders, which can check their configuration
+// without sending mail.
+//
+// Failures should be returned as post.ErrConnection, post.ErrTLS or post.ErrAuth,
+// when they can be attributed to one of them.
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
+// BatchSender is implemented by senders, which send multiple messages more
+// efficiently than one by one, e.g. by reusing a single connection.
+type BatchSender interface {
+	// SendBatch sends the messages and returns an error for each of them, in the same order.
+	SendBatch(ctx context.Context, msgs []*post.Message) []error
+}
+
 // Message defines mailservice template-backed message for SendRendered method.
 type Message interface {
 	Template() string
@@ -56,18 +198,51 @@ type Message interface {
 type Service struct {
 	log    *zap.Logger
 	Sender Sender
+	// ReplyTo is added to every email, which doesn't set a reply-to address itself.
+	ReplyTo *post.Address
+	// Suppressions manages the suppression lists checked by Sender, it's nil
+	// when the suppression lists aren't checked.
+	Suppressions *SuppressionSender
+	// Bounces receives the bounce and complaint notifications of the mail provider,
+	// it's nil when they aren't received.
+	Bounces *BounceReceiver
+	// SendLogLevel is the level of the log written for each sent email.
+	SendLogLevel zapcore.Level
+	// LogFullAddresses logs the full recipient addresses instead of only their domains.
+	LogFullAddresses bool
+	// FallbackLocales are tried in order, when a template isn't translated to
+	// the locale of a LocalizedMessage, before the default templates.
+	FallbackLocales []string
+	// Queue configures the queue of the emails sent by SendRenderedAsync, it's
+	// only read when the first email is queued.
+	Queue QueueConfig
 
 	html *htmltemplate.Template
+	// localized are the templates of the locale subdirectories of the template path,
+	// by the normalized locale.
+	localized map[string]*htmltemplate.Template
 	// TODO(yar): prepare plain text version
 	// text *texttemplate.Template
 
-	sending sync.WaitGroup
+	queueMu     sync.Mutex
+	queueClosed bool
+	queue       chan queuedEmail
+	sending     sync.WaitGroup
+	// drainExpired is closed when Close stops sending the queued emails.
+	drainExpired chan struct{}
+	expireDrain  sync.Once
 }
 
-// New creates new service.
+// New creates new service. The templates translated to a locale are loaded from
+// the subdirectory of templatePath named after the locale, e.g. templatePath/de.
 func New(log *zap.Logger, sender Sender, templatePath string) (*Service, error) {
 	var err error
-	service := &Service{log: log, Sender: sender}
+	service := &Service{
+		log:          log,
+		Sender:       sender,
+		SendLogLevel: zapcore.DebugLevel,
+		drainExpired: make(chan struct{}),
+	}
 
 	// TODO(yar): prepare plain text version
 	// service.text, err = texttemplate.ParseGlob(filepath.Join(templatePath, "*.txt"))
@@ -80,47 +255,122 @@ func New(log *zap.Logger, sender Sender, templatePath string) (*Service, error)
 		return nil, err
 	}
 
+	service.localized, err = loadLocalizedTemplates(templatePath)
+	if err != nil {
+		return nil, err
+	}
+
 	return service, nil
 }
 
-// Close closes and waits for any pending actions.
-func (service *Service) Close() error {
-	service.sending.Wait()
+// ValidateTemplates checks that the html template of each of the names exists
+// in templatePath and parses, so misconfigured templates are reported at startup
+// instead of when the first email is sent.
+func ValidateTemplates(templatePath string, names ...string) error {
+	var missing, malformed []string
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(templatePath, name+".html"))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				missing = append(missing, name)
+				continue
+			}
+			malformed = append(malformed, fmt.Sprintf("%s (%v)", name, err))
+			continue
+		}
+
+		if _, err := htmltemplate.New(name + ".html").Parse(string(data)); err != nil {
+			malformed = append(malformed, fmt.Sprintf("%s (%v)", name, err))
+		}
+	}
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing: "+strings.Join(missing, ", "))
+	}
+	if len(malformed) > 0 {
+		problems = append(problems, "malformed: "+strings.Join(malformed, ", "))
+	}
+	if len(problems) > 0 {
+		return errs.New("invalid mail templates in %q: %s", templatePath, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// closeSender closes the sender, when it holds resources such as pooled connections.
+func closeSender(sender Sender) error {
+	if closer, ok := sender.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// HealthCheck checks that the sender is able to connect and authenticate to the
+// mail server without sending mail. Senders, which don't implement HealthChecker,
+// such as the simulated one, are always considered healthy.
+func (service *Service) HealthCheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return healthCheckSender(ctx, service.Sender)
+}
+
+// healthCheckSender checks the sender, when it implements HealthChecker.
+func healthCheckSender(ctx context.Context, sender Sender) error {
+	if checker, ok := sender.(HealthChecker); ok {
+		return checker.HealthCheck(ctx)
+	}
 	return nil
 }
 
 // Send is generalized method for sending custom email message.
 func (service *Service) Send(ctx context.Context, msg *post.Message) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return service.Sender.SendEmail(ctx, msg)
+
+	msg = service.withReplyTo(msg)
+	err = service.Sender.SendEmail(ctx, msg)
+	service.logSend(msg, "", err)
+	return err
 }
 
-// SendRenderedAsync renders content from htmltemplate and texttemplate templates then sends it asynchronously.
-func (service *Service) SendRenderedAsync(ctx context.Context, to []post.Address, msg Message) {
-	// TODO: think of a better solution
-	service.sending.Add(1)
-	go func() {
-		defer service.sending.Done()
+// SendBatch sends the messages and returns an error for each of them, in the same
+// order, so the caller can retry only the failed ones. Senders, which don't implement
+// BatchSender, such as the api senders without an endpoint for sending distinct
+// messages at once, send the messages one by one.
+func (service *Service) SendBatch(ctx context.Context, msgs []post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
 
-		ctx, cancel := context.WithTimeout(context2.WithoutCancellation(ctx), 5*time.Second)
-		defer cancel()
+	prepared := make([]*post.Message, len(msgs))
+	for i := range msgs {
+		prepared[i] = service.withReplyTo(&msgs[i])
+	}
 
-		err := service.SendRendered(ctx, to, msg)
+	sendErrs := sendBatch(ctx, service.Sender, prepared)
+	for i, err := range sendErrs {
+		service.logSend(prepared[i], "", err)
+	}
+	return sendErrs
+}
 
-		var recipients []string
-		for _, recipient := range to {
-			recipients = append(recipients, recipient.String())
-		}
+// sendBatch sends the messages with the sender, one by one, when it doesn't implement BatchSender.
+func sendBatch(ctx context.Context, sender Sender, msgs []*post.Message) []error {
+	if batcher, ok := sender.(BatchSender); ok {
+		return batcher.SendBatch(ctx, msgs)
+	}
 
-		if err != nil {
-			service.log.Error("fail sending email",
-				zap.Strings("recipients", recipients),
-				zap.Error(err))
-		} else {
-			service.log.Info("email sent successfully",
-				zap.Strings("recipients", recipients))
-		}
-	}()
+	sendErrs := make([]error, len(msgs))
+	for i, msg := range msgs {
+		sendErrs[i] = sender.SendEmail(ctx, msg)
+	}
+	return sendErrs
+}
+
+// withReplyTo returns msg with the configured reply-to address, when it doesn't set one.
+func (service *Service) withReplyTo(msg *post.Message) *post.Message {
+	if service.ReplyTo == nil || len(msg.ReplyTo) > 0 {
+		return msg
+	}
+	copied := *msg
+	copied.ReplyTo = []post.Address{*service.ReplyTo}
+	return &copied
 }
 
 // SendRendered renders content from htmltemplate and texttemplate templates then sends it.
@@ -135,7 +385,14 @@ func (service *Service) SendRendered(ctx context.Context, to []post.Address, msg
 	// 	return
 	// }
 
-	if err = service.html.ExecuteTemplate(&htmlBuffer, msg.Template()+".html", msg); err != nil {
+	var locale string
+	if localized, ok := msg.(LocalizedMessage); ok {
+		locale = localized.Locale()
+	}
+
+	templates := service.templatesFor(msg.Template()+".html", locale)
+	if err = templates.ExecuteTemplate(&htmlBuffer, msg.Template()+".html", msg); err != nil {
+		service.logSend(&post.Message{To: to}, msg.Template(), err)
 		return
 	}
 
@@ -152,5 +409,8 @@ func (service *Service) SendRendered(ctx context.Context, to []post.Address, msg
 		},
 	}
 
-	return service.Sender.SendEmail(ctx, m)
+	m = service.withReplyTo(m)
+	err = service.Sender.SendEmail(ctx, m)
+	service.logSend(m, msg.Template(), err)
+	return err
 }
@@ -0,0 +1,250 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+func TestConfigAddresses(t *testing.T) {
+	t.Run("bare address", func(t *testing.T) {
+		from, err := mailservice.Config{From: "noreply@mail.test"}.ParseFrom()
+		require.NoError(t, err)
+		require.Equal(t, post.Address{Address: "noreply@mail.test"}, *from)
+	})
+
+	t.Run("named address", func(t *testing.T) {
+		config := mailservice.Config{
+			From:    `"Storj Support" <support@mail.test>`,
+			ReplyTo: "Storj Support <help@mail.test>",
+		}
+
+		from, err := config.ParseFrom()
+		require.NoError(t, err)
+		require.Equal(t, post.Address{Name: "Storj Support", Address: "support@mail.test"}, *from)
+
+		replyTo, err := config.ParseReplyTo()
+		require.NoError(t, err)
+		require.Equal(t, post.Address{Name: "Storj Support", Address: "help@mail.test"}, *replyTo)
+	})
+
+	t.Run("invalid addresses", func(t *testing.T) {
+		_, err := mailservice.Config{From: "Storj Support"}.ParseFrom()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid mail from address")
+
+		_, err = mailservice.Config{ReplyTo: "help@"}.ParseReplyTo()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid mail reply-to address")
+	})
+
+	t.Run("allowed from domain", func(t *testing.T) {
+		from, err := mailservice.Config{
+			From:        "Storj Support <support@Mail.Test>",
+			FromDomains: []string{"storj.test", "mail.test"},
+		}.ParseFrom()
+		require.NoError(t, err)
+		require.Equal(t, "support@Mail.Test", from.Address)
+	})
+
+	t.Run("disallowed from domain", func(t *testing.T) {
+		_, err := mailservice.Config{
+			From:        "support@mail.example",
+			FromDomains: []string{"mail.test"},
+		}.ParseFrom()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `domain "mail.example"`)
+	})
+
+	t.Run("empty from domains", func(t *testing.T) {
+		from, err := mailservice.Config{From: "support@mail.example"}.ParseFrom()
+		require.NoError(t, err)
+		require.Equal(t, "support@mail.example", from.Address)
+	})
+
+	t.Run("reply-to not configured", func(t *testing.T) {
+		replyTo, err := mailservice.Config{}.ParseReplyTo()
+		require.NoError(t, err)
+		require.Nil(t, replyTo)
+	})
+}
+
+func TestServiceReplyTo(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	recorder := &recordingSender{}
+	service, err := mailservice.New(zaptest.NewLogger(t), recorder, ctx.Dir("templates"))
+	require.NoError(t, err)
+
+	replyTo := post.Address{Name: "Support", Address: "help@mail.test"}
+	service.ReplyTo = &replyTo
+
+	require.NoError(t, service.SendRendered(ctx, []post.Address{{Address: "foo@mail.test"}}, &testMessage{}))
+
+	explicit := &post.Message{ReplyTo: []post.Address{{Address: "other@mail.test"}}}
+	require.NoError(t, service.Send(ctx, explicit))
+
+	require.Len(t, recorder.messages, 2)
+	require.Equal(t, []post.Address{replyTo}, recorder.messages[0].ReplyTo)
+	require.Equal(t, explicit.ReplyTo, recorder.messages[1].ReplyTo)
+
+	data, err := recorder.messages[0].Bytes()
+	require.NoError(t, err)
+	require.Contains(t, string(data), "Reply-To: \"Support\" <help@mail.test>\r\n")
+}
+
+func TestServiceHealthCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	// senders without a health check are considered healthy
+	recorder := &recordingSender{}
+	service, err := mailservice.New(zaptest.NewLogger(t), recorder, ctx.Dir("templates"))
+	require.NoError(t, err)
+	require.NoError(t, service.HealthCheck(ctx))
+	require.Empty(t, recorder.messages)
+
+	// the check goes through wrapping senders
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	smtpSender := &post.SMTPSender{ServerAddress: address, From: post.Address{Address: "noreply@mail.test"}}
+	service, err = mailservice.New(zaptest.NewLogger(t), mailservice.NewRetrySender(zaptest.NewLogger(t), smtpSender, 3), ctx.Dir("templates"))
+	require.NoError(t, err)
+
+	err = service.HealthCheck(ctx)
+	require.Error(t, err)
+	require.True(t, post.ErrConnection.Has(err))
+}
+
+// rejectingSender fails sending to the rejected recipient and records the batches it sends.
+type rejectingSender struct {
+	recordingSender
+	rejected   string
+	err        error
+	rejections int
+	batches    int
+}
+
+func (sender *rejectingSender) SendEmail(ctx context.Context, msg *post.Message) error {
+	if msg.To[0].Address == sender.rejected {
+		sender.rejections++
+		return sender.err
+	}
+	return sender.recordingSender.SendEmail(ctx, msg)
+}
+
+// batchingSender is a rejectingSender, which implements mailservice.BatchSender.
+type batchingSender struct {
+	rejectingSender
+}
+
+func (sender *batchingSender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	sender.batches++
+	sendErrs := make([]error, len(msgs))
+	for i, msg := range msgs {
+		sendErrs[i] = sender.SendEmail(ctx, msg)
+	}
+	return sendErrs
+}
+
+func TestServiceSendBatch(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "test.html"), []byte("hello"), 0644))
+
+	msgs := []post.Message{
+		{To: []post.Address{{Address: "foo@mail.test"}}},
+		{To: []post.Address{{Address: "rejected@mail.test"}}},
+		{To: []post.Address{{Address: "bar@mail.test"}}},
+	}
+	rejected := &textproto.Error{Code: 550, Msg: "mailbox unavailable"}
+
+	t.Run("sequential fallback", func(t *testing.T) {
+		sender := &rejectingSender{rejected: "rejected@mail.test", err: rejected}
+		service, err := mailservice.New(zaptest.NewLogger(t), sender, ctx.Dir("templates"))
+		require.NoError(t, err)
+
+		sendErrs := service.SendBatch(ctx, msgs)
+		require.Equal(t, []error{nil, rejected, nil}, sendErrs)
+		require.Len(t, sender.messages, 2)
+		require.Equal(t, "foo@mail.test", sender.messages[0].To[0].Address)
+		require.Equal(t, "bar@mail.test", sender.messages[1].To[0].Address)
+	})
+
+	t.Run("batch through wrapping senders", func(t *testing.T) {
+		sender := &batchingSender{rejectingSender{rejected: "rejected@mail.test", err: rejected}}
+		wrapped := mailservice.NewRateLimitSender(newTestRetrySender(t, sender, 2), mailservice.RateLimitConfig{
+			Burst:  10,
+			Window: time.Minute,
+		})
+		service, err := mailservice.New(zaptest.NewLogger(t), wrapped, ctx.Dir("templates"))
+		require.NoError(t, err)
+
+		sendErrs := service.SendBatch(ctx, msgs)
+		require.Len(t, sendErrs, 3)
+		require.NoError(t, sendErrs[0])
+		require.Equal(t, rejected, sendErrs[1])
+		require.NoError(t, sendErrs[2])
+		require.Equal(t, 1, sender.batches)
+		require.Equal(t, 1, sender.rejections)
+		require.Len(t, sender.messages, 2)
+	})
+
+	t.Run("transient failure is retried", func(t *testing.T) {
+		sender := &batchingSender{rejectingSender{rejected: "rejected@mail.test", err: mailservice.ErrTransient.New("connection reset")}}
+		service, err := mailservice.New(zaptest.NewLogger(t), newTestRetrySender(t, sender, 2), ctx.Dir("templates"))
+		require.NoError(t, err)
+
+		sendErrs := service.SendBatch(ctx, msgs)
+		require.NoError(t, sendErrs[0])
+		require.True(t, mailservice.ErrTransient.Has(sendErrs[1]))
+		require.NoError(t, sendErrs[2])
+		require.Equal(t, 1, sender.batches)
+		require.Equal(t, 3, sender.rejections)
+		require.Len(t, sender.messages, 2)
+	})
+}
+
+type testMessage struct{}
+
+func (*testMessage) Template() string { return "test" }
+func (*testMessage) Subject() string  { return "test" }
+
+func TestValidateTemplates(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.NoError(t, os.WriteFile(ctx.File("templates", "Welcome.html"), []byte("hello {{.UserName}}"), 0644))
+	require.NoError(t, os.WriteFile(ctx.File("templates", "Broken.html"), []byte("hello {{.UserName"), 0644))
+	dir := ctx.Dir("templates")
+
+	require.NoError(t, mailservice.ValidateTemplates(dir, "Welcome"))
+
+	err := mailservice.ValidateTemplates(dir, "Welcome", "Forgot", "Invite")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing: Forgot, Invite")
+	require.NotContains(t, err.Error(), "Welcome")
+
+	err = mailservice.ValidateTemplates(dir, "Welcome", "Broken", "Forgot")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing: Forgot")
+	require.Contains(t, err.Error(), "malformed: Broken")
+}
@@ -0,0 +1,307 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ses
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	_ "crypto/sha1" //nolint:gosec // SNS signature version 1 uses SHA1
+	_ "crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var _ mailservice.BounceProvider = (*BounceProvider)(nil)
+
+// defaultTrustedURL matches the urls of the SNS endpoints, which the signing
+// certificates are downloaded from and the subscriptions are confirmed with.
+var defaultTrustedURL = regexp.MustCompile(`^https://sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?/`)
+
+// BounceProvider verifies and parses the SES bounce and complaint notifications,
+// which SNS posts from the topic the SES identity publishes them to.
+//
+// architecture: Service
+type BounceProvider struct {
+	topicARN string
+
+	// TrustedURL matches the urls of the SNS signing certificates and of the
+	// subscription confirmations, it defaults to the SNS endpoints.
+	TrustedURL *regexp.Regexp
+	// Client is used for downloading the signing certificates and confirming the subscription.
+	Client *http.Client
+
+	mu    sync.Mutex
+	certs map[string]*x509.Certificate
+}
+
+// NewBounceProvider creates a provider for the notifications published to the SNS topic.
+// Notifications of other topics are rejected, since anyone can sign those.
+func NewBounceProvider(topicARN string) (*BounceProvider, error) {
+	if topicARN == "" {
+		return nil, Error.New("topic arn is required")
+	}
+
+	return &BounceProvider{
+		topicARN: topicARN,
+
+		TrustedURL: defaultTrustedURL,
+		Client:     &http.Client{Timeout: 30 * time.Second},
+
+		certs: map[string]*x509.Certificate{},
+	}, nil
+}
+
+// snsMessage is the body of a request posted by SNS.
+type snsMessage struct {
+	Type             string
+	MessageID        string `json:"MessageId"`
+	Token            string
+	TopicArn         string
+	Subject          string
+	Message          string
+	SubscribeURL     string
+	Timestamp        string
+	SignatureVersion string
+	Signature        string
+	SigningCertURL   string
+}
+
+// sesNotification is the SES event published in the message of a notification.
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	// EventType is set instead of NotificationType by configuration sets.
+	EventType string `json:"eventType"`
+	Bounce    struct {
+		BounceType        string         `json:"bounceType"`
+		BouncedRecipients []sesRecipient `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplainedRecipients []sesRecipient `json:"complainedRecipients"`
+	} `json:"complaint"`
+	Mail struct {
+		Headers []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"headers"`
+	} `json:"mail"`
+}
+
+type sesRecipient struct {
+	EmailAddress string `json:"emailAddress"`
+}
+
+// ParseBounces implements mailservice.BounceProvider. Subscription confirmations
+// are confirmed and report no bounces.
+func (provider *BounceProvider) ParseBounces(ctx context.Context, req *http.Request) (_ []mailservice.Bounce, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var msg snsMessage
+	if err := json.NewDecoder(req.Body).Decode(&msg); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if msg.TopicArn != provider.topicARN {
+		return nil, mailservice.ErrBounceSignature.New("unexpected topic %q", msg.TopicArn)
+	}
+	if err := provider.verify(ctx, &msg); err != nil {
+		return nil, err
+	}
+
+	switch msg.Type {
+	case "SubscriptionConfirmation":
+		return nil, provider.confirmSubscription(ctx, msg.SubscribeURL)
+	case "Notification":
+		return parseNotification(msg.Message)
+	default:
+		return nil, nil
+	}
+}
+
+// parseNotification returns the bounces of the SES bounce or complaint notification.
+func parseNotification(message string) ([]mailservice.Bounce, error) {
+	var notification sesNotification
+	if err := json.Unmarshal([]byte(message), &notification); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var projectID uuid.UUID
+	for _, header := range notification.Mail.Headers {
+		if strings.EqualFold(header.Name, mailservice.ProjectIDHeader) {
+			projectID, _ = uuid.FromString(strings.TrimSpace(header.Value))
+		}
+	}
+
+	notificationType := notification.NotificationType
+	if notificationType == "" {
+		notificationType = notification.EventType
+	}
+
+	var bounceType mailservice.BounceType
+	var recipients []sesRecipient
+	switch notificationType {
+	case "Bounce":
+		bounceType = mailservice.BounceSoft
+		if notification.Bounce.BounceType == "Permanent" {
+			bounceType = mailservice.BounceHard
+		}
+		recipients = notification.Bounce.BouncedRecipients
+	case "Complaint":
+		bounceType = mailservice.BounceComplaint
+		recipients = notification.Complaint.ComplainedRecipients
+	default:
+		return nil, nil
+	}
+
+	bounces := make([]mailservice.Bounce, 0, len(recipients))
+	for _, recipient := range recipients {
+		bounces = append(bounces, mailservice.Bounce{
+			Type:      bounceType,
+			Email:     recipient.EmailAddress,
+			ProjectID: projectID,
+		})
+	}
+	return bounces, nil
+}
+
+// verify checks the signature of the message with the certificate it references.
+func (provider *BounceProvider) verify(ctx context.Context, msg *snsMessage) error {
+	var hash crypto.Hash
+	switch msg.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return mailservice.ErrBounceSignature.New("unsupported signature version %q", msg.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(msg.Signature)
+	if err != nil {
+		return mailservice.ErrBounceSignature.Wrap(err)
+	}
+
+	cert, err := provider.certificate(ctx, msg.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return mailservice.ErrBounceSignature.New("unsupported signing certificate key")
+	}
+
+	digest := hash.New()
+	_, _ = digest.Write(msg.stringToSign())
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, digest.Sum(nil), signature); err != nil {
+		return mailservice.ErrBounceSignature.Wrap(err)
+	}
+	return nil
+}
+
+// stringToSign returns the fields of the message, which its signature covers.
+func (msg *snsMessage) stringToSign() []byte {
+	var fields []string
+	add := func(name, value string) {
+		fields = append(fields, name, value)
+	}
+
+	add("Message", msg.Message)
+	add("MessageId", msg.MessageID)
+	if msg.Type == "Notification" {
+		if msg.Subject != "" {
+			add("Subject", msg.Subject)
+		}
+		add("Timestamp", msg.Timestamp)
+		add("TopicArn", msg.TopicArn)
+		add("Type", msg.Type)
+	} else {
+		add("SubscribeURL", msg.SubscribeURL)
+		add("Timestamp", msg.Timestamp)
+		add("Token", msg.Token)
+		add("TopicArn", msg.TopicArn)
+		add("Type", msg.Type)
+	}
+	return []byte(strings.Join(fields, "\n") + "\n")
+}
+
+// certificate returns the signing certificate downloaded from url, which must be trusted.
+func (provider *BounceProvider) certificate(ctx context.Context, url string) (_ *x509.Certificate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !provider.TrustedURL.MatchString(url) {
+		return nil, mailservice.ErrBounceSignature.New("untrusted signing certificate url %q", url)
+	}
+
+	provider.mu.Lock()
+	cert, ok := provider.certs[url]
+	provider.mu.Unlock()
+	if ok {
+		return cert, nil
+	}
+
+	data, err := provider.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, Error.New("invalid signing certificate")
+	}
+	cert, err = x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	provider.mu.Lock()
+	provider.certs[url] = cert
+	provider.mu.Unlock()
+	return cert, nil
+}
+
+// confirmSubscription confirms the subscription of the receiver to the topic.
+func (provider *BounceProvider) confirmSubscription(ctx context.Context, url string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !provider.TrustedURL.MatchString(url) {
+		return mailservice.ErrBounceSignature.New("untrusted subscribe url %q", url)
+	}
+	_, err = provider.get(ctx, url)
+	return err
+}
+
+// get returns the body of a successful GET request of url.
+func (provider *BounceProvider) get(ctx context.Context, url string) (_ []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	resp, err := provider.Client.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil, Error.New("unexpected status: %s", resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	return data, Error.Wrap(err)
+}
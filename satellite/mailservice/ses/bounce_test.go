@@ -0,0 +1,162 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ses_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/ses"
+)
+
+const testTopicARN = "arn:aws:sns:us-east-1:123456789012:ses-bounces"
+
+// suppressionDB records the added suppressions.
+type suppressionDB struct {
+	added map[uuid.UUID][]string
+}
+
+func (db *suppressionDB) AddSuppression(ctx context.Context, projectID uuid.UUID, email string) error {
+	db.added[projectID] = append(db.added[projectID], email)
+	return nil
+}
+
+func (db *suppressionDB) RemoveSuppression(ctx context.Context, projectID uuid.UUID, email string) error {
+	return nil
+}
+
+func (db *suppressionDB) ListSuppressions(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	return db.added[projectID], nil
+}
+
+// signNotification returns the SNS notification of the SES message, signed with key.
+func signNotification(t *testing.T, key *rsa.PrivateKey, certURL, message string) map[string]string {
+	notification := map[string]string{
+		"Type":             "Notification",
+		"MessageId":        "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+		"TopicArn":         testTopicARN,
+		"Message":          message,
+		"Timestamp":        "2022-05-18T09:00:00.000Z",
+		"SignatureVersion": "2",
+		"SigningCertURL":   certURL,
+	}
+
+	stringToSign := "Message\n" + notification["Message"] + "\n" +
+		"MessageId\n" + notification["MessageId"] + "\n" +
+		"Timestamp\n" + notification["Timestamp"] + "\n" +
+		"TopicArn\n" + notification["TopicArn"] + "\n" +
+		"Type\n" + notification["Type"] + "\n"
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	notification["Signature"] = base64.StdEncoding.EncodeToString(signature)
+	return notification
+}
+
+func TestBounceReceiver(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	sns := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(certPEM)
+	}))
+	defer sns.Close()
+	certURL := sns.URL + "/SimpleNotificationService.pem"
+
+	provider, err := ses.NewBounceProvider(testTopicARN)
+	require.NoError(t, err)
+	provider.Client = sns.Client()
+	provider.TrustedURL = regexp.MustCompile("^" + regexp.QuoteMeta(sns.URL) + "/")
+
+	sender, err := ses.New(post.Address{Address: "noreply@mail.test"}, "us-east-1", "key", "secret")
+	require.NoError(t, err)
+	db := &suppressionDB{added: map[uuid.UUID][]string{}}
+	suppressions := mailservice.NewSuppressionSender(sender, db, mailservice.SuppressionConfig{
+		CacheCapacity:   10,
+		CacheExpiration: time.Hour,
+	})
+	receiver := mailservice.NewBounceReceiver(zaptest.NewLogger(t), provider, suppressions)
+
+	notify := func(notification map[string]string) int {
+		body, err := json.Marshal(notification)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/v0/mail/bounces", bytes.NewReader(body)).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		receiver.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	projectID := testrand.UUID()
+	bounce := `{
+		"notificationType": "Bounce",
+		"bounce": {
+			"bounceType": "Permanent",
+			"bouncedRecipients": [{"emailAddress": "Gone@Mail.test"}]
+		},
+		"mail": {
+			"headers": [{"name": "X-Storj-Project-ID", "value": "` + projectID.String() + `"}]
+		}
+	}`
+
+	t.Run("valid bounce", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, notify(signNotification(t, key, certURL, bounce)))
+		require.Equal(t, []string{"gone@mail.test"}, db.added[projectID])
+	})
+
+	t.Run("forged bounce", func(t *testing.T) {
+		db.added = map[uuid.UUID][]string{}
+
+		// the message is changed after it was signed
+		notification := signNotification(t, key, certURL, bounce)
+		notification["Message"] = strings.ReplaceAll(notification["Message"], "Gone@Mail.test", "victim@mail.test")
+		require.Equal(t, http.StatusForbidden, notify(notification))
+
+		// the notification is signed for another topic
+		notification = signNotification(t, key, certURL, bounce)
+		notification["TopicArn"] = "arn:aws:sns:us-east-1:210987654321:other"
+		require.Equal(t, http.StatusForbidden, notify(notification))
+
+		// the signing certificate isn't from SNS
+		notification = signNotification(t, key, "https://attacker.test/cert.pem", bounce)
+		require.Equal(t, http.StatusForbidden, notify(notification))
+
+		require.Empty(t, db.added)
+	})
+}
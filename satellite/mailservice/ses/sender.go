@@ -0,0 +1,227 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ses implements mailservice.Sender using the Amazon SES API.
+//
+// Requests are signed with AWS Signature Version 4 directly, so that
+// deployments not using SES don't need to depend on the AWS SDK.
+package ses
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for the ses sender.
+var Error = errs.Class("ses")
+
+var _ mailservice.Sender = (*Sender)(nil)
+
+// Sender sends emails using the SES SendRawEmail API.
+//
+// architecture: Service
+type Sender struct {
+	from            post.Address
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+
+	// Endpoint is the url of the SES API, it defaults to the regional endpoint.
+	Endpoint string
+	// Client is used for sending requests to the SES API.
+	Client *http.Client
+}
+
+// New creates a new SES sender for the region using the IAM credentials.
+func New(from post.Address, region, accessKeyID, secretAccessKey string) (*Sender, error) {
+	if region == "" {
+		return nil, Error.New("region is required")
+	}
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, Error.New("access key id and secret access key are required")
+	}
+
+	return &Sender{
+		from:            from,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+
+		Endpoint: "https://email." + region + ".amazonaws.com/",
+		Client:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// FromAddress implements mailservice.Sender.
+func (sender *Sender) FromAddress() post.Address {
+	return sender.from
+}
+
+// SendEmail sends the message using SendRawEmail.
+//
+// Throttling and server errors are returned as mailservice.ErrTransient.
+func (sender *Sender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	raw, err := msg.Bytes()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	form := url.Values{}
+	form.Set("Action", "SendRawEmail")
+	form.Set("Version", "2010-12-01")
+	form.Set("RawMessage.Data", base64.StdEncoding.EncodeToString(raw))
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.Endpoint, strings.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	sender.sign(req, []byte(body), time.Now().UTC())
+
+	resp, err := sender.Client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode == http.StatusOK {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return Error.Wrap(err)
+	}
+
+	code, message := readError(resp)
+	if code == "Throttling" || resp.StatusCode >= http.StatusInternalServerError {
+		return mailservice.ErrTransient.New("ses: %s: %s", code, message)
+	}
+	return Error.New("%s: %s", code, message)
+}
+
+// HealthCheck checks that the SES API is reachable and accepts the credentials
+// by requesting the sending quota, which doesn't send mail.
+//
+// Rejected credentials are returned as post.ErrAuth and failed requests as
+// post.ErrConnection.
+func (sender *Sender) HealthCheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	form := url.Values{}
+	form.Set("Action", "GetSendQuota")
+	form.Set("Version", "2010-12-01")
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.Endpoint, strings.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	sender.sign(req, []byte(body), time.Now().UTC())
+
+	resp, err := sender.Client.Do(req)
+	if err != nil {
+		return post.ErrConnection.Wrap(Error.Wrap(err))
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode == http.StatusOK {
+		_, err = io.Copy(ioutil.Discard, resp.Body)
+		return Error.Wrap(err)
+	}
+
+	code, message := readError(resp)
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return post.ErrAuth.Wrap(Error.New("%s: %s", code, message))
+	}
+	return Error.New("%s: %s", code, message)
+}
+
+// readError returns the error code and message of a failed SES API response.
+func readError(resp *http.Response) (code, message string) {
+	var response struct {
+		Error struct {
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		} `xml:"Error"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&response); err != nil {
+		response.Error.Code = resp.Status
+	}
+	return response.Error.Code, response.Error.Message
+}
+
+// sign adds AWS Signature Version 4 authentication headers to req.
+func (sender *Sender) sign(req *http.Request, body []byte, now time.Time) {
+	const service = "ses"
+
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	const signedHeaders = "content-type;host;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		hashHex(body),
+	}, "\n")
+
+	scope := date + "/" + sender.region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+sender.secretAccessKey), date)
+	key = hmacSHA256(key, sender.region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sender.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ses_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/ses"
+)
+
+func TestNew(t *testing.T) {
+	from := post.Address{Address: "noreply@mail.test"}
+
+	_, err := ses.New(from, "", "key", "secret")
+	require.Error(t, err)
+
+	_, err = ses.New(from, "us-east-1", "", "secret")
+	require.Error(t, err)
+
+	_, err = ses.New(from, "us-east-1", "key", "")
+	require.Error(t, err)
+
+	sender, err := ses.New(from, "us-east-1", "key", "secret")
+	require.NoError(t, err)
+	require.Equal(t, "https://email.us-east-1.amazonaws.com/", sender.Endpoint)
+	require.Equal(t, from, sender.FromAddress())
+}
+
+func TestSendEmail(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	msg := &post.Message{
+		From:      post.Address{Address: "noreply@mail.test"},
+		To:        []post.Address{{Address: "foo@mail.test"}},
+		Subject:   "hello",
+		PlainText: "world",
+	}
+
+	for _, tt := range []struct {
+		name      string
+		status    int
+		response  string
+		errText   string
+		transient bool
+	}{
+		{
+			name:     "success",
+			status:   http.StatusOK,
+			response: `<SendRawEmailResponse><SendRawEmailResult><MessageId>1</MessageId></SendRawEmailResult></SendRawEmailResponse>`,
+		},
+		{
+			name:      "throttled",
+			status:    http.StatusBadRequest,
+			response:  `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Maximum sending rate exceeded.</Message></Error></ErrorResponse>`,
+			errText:   "Maximum sending rate exceeded.",
+			transient: true,
+		},
+		{
+			name:      "server error",
+			status:    http.StatusServiceUnavailable,
+			response:  `<ErrorResponse><Error><Type>Receiver</Type><Code>ServiceUnavailable</Code><Message>unavailable</Message></Error></ErrorResponse>`,
+			errText:   "ServiceUnavailable",
+			transient: true,
+		},
+		{
+			name:     "rejected",
+			status:   http.StatusBadRequest,
+			response: `<ErrorResponse><Error><Type>Sender</Type><Code>MessageRejected</Code><Message>Email address is not verified.</Message></Error></ErrorResponse>`,
+			errText:  "MessageRejected",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var rawMessage string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization := r.Header.Get("Authorization")
+				if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=key/") ||
+					!strings.Contains(authorization, "/eu-west-1/ses/aws4_request") ||
+					r.Header.Get("X-Amz-Date") == "" {
+					http.Error(w, "invalid signature", http.StatusForbidden)
+					return
+				}
+
+				if err := r.ParseForm(); err != nil || r.PostForm.Get("Action") != "SendRawEmail" {
+					http.Error(w, "invalid request", http.StatusBadRequest)
+					return
+				}
+
+				data, err := base64.StdEncoding.DecodeString(r.PostForm.Get("RawMessage.Data"))
+				if err != nil {
+					http.Error(w, "invalid message", http.StatusBadRequest)
+					return
+				}
+				rawMessage = string(data)
+
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			sender, err := ses.New(msg.From, "eu-west-1", "key", "secret")
+			require.NoError(t, err)
+			sender.Endpoint = server.URL
+
+			err = sender.SendEmail(ctx, msg)
+			require.Contains(t, rawMessage, "Subject: hello")
+			require.Contains(t, rawMessage, "To: <foo@mail.test>")
+
+			if tt.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.errText)
+			require.Equal(t, tt.transient, mailservice.IsTransient(err))
+		})
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var action string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		action = r.PostForm.Get("Action")
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Code>InvalidClientTokenId</Code><Message>The security token included in the request is invalid.</Message></Error></ErrorResponse>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<GetSendQuotaResponse><GetSendQuotaResult><Max24HourSend>200</Max24HourSend></GetSendQuotaResult></GetSendQuotaResponse>`))
+	}))
+	defer server.Close()
+
+	sender, err := ses.New(post.Address{Address: "noreply@mail.test"}, "eu-west-1", "key", "secret")
+	require.NoError(t, err)
+	sender.Endpoint = server.URL
+
+	require.NoError(t, sender.HealthCheck(ctx))
+	require.Equal(t, "GetSendQuota", action)
+
+	invalid, err := ses.New(post.Address{Address: "noreply@mail.test"}, "eu-west-1", "invalid", "secret")
+	require.NoError(t, err)
+	invalid.Endpoint = server.URL
+
+	err = invalid.HealthCheck(ctx)
+	require.Error(t, err)
+	require.True(t, post.ErrAuth.Has(err))
+	require.Contains(t, err.Error(), "InvalidClientTokenId")
+
+	server.Close()
+	err = sender.HealthCheck(ctx)
+	require.Error(t, err)
+	require.True(t, post.ErrConnection.Has(err))
+}
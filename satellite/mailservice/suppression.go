@@ -0,0 +1,190 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+)
+
+// ErrSuppressed is the error class for emails, which weren't sent, since
+// all of their recipients are on the suppression list of the project.
+var ErrSuppressed = errs.Class("mail suppressed")
+
+// SuppressionDB stores the addresses, which must not be emailed about a project.
+//
+// architecture: Database
+type SuppressionDB interface {
+	// AddSuppression adds the address to the suppression list of the project.
+	AddSuppression(ctx context.Context, projectID uuid.UUID, email string) error
+	// RemoveSuppression removes the address from the suppression list of the project.
+	RemoveSuppression(ctx context.Context, projectID uuid.UUID, email string) error
+	// ListSuppressions returns the suppressed addresses of the project.
+	ListSuppressions(ctx context.Context, projectID uuid.UUID) ([]string, error)
+}
+
+// SuppressionConfig defines the caching of the suppression lists.
+type SuppressionConfig struct {
+	CacheCapacity   int           `help:"number of projects whose email suppression lists are cached" default:"10000"`
+	CacheExpiration time.Duration `help:"how long an email suppression list is cached" default:"10m"`
+}
+
+type projectIDKey struct{}
+
+// WithProjectID returns a context, which marks the emails sent with it as being
+// about the project, so the suppression list of the project applies to them.
+func WithProjectID(ctx context.Context, projectID uuid.UUID) context.Context {
+	return context.WithValue(ctx, projectIDKey{}, projectID)
+}
+
+// projectIDFromContext returns the project set with WithProjectID.
+func projectIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	projectID, ok := ctx.Value(projectIDKey{}).(uuid.UUID)
+	return projectID, ok
+}
+
+// SuppressionSender is a Sender, which drops the recipients suppressed by the project
+// the email is about. Emails sent without a project in the context aren't changed.
+type SuppressionSender struct {
+	Sender
+
+	db    SuppressionDB
+	cache *lrucache.ExpiringLRU
+}
+
+// NewSuppressionSender returns a sender, which checks the suppression lists in db before sending with sender.
+func NewSuppressionSender(sender Sender, db SuppressionDB, config SuppressionConfig) *SuppressionSender {
+	return &SuppressionSender{
+		Sender: sender,
+		db:     db,
+		cache: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+	}
+}
+
+// AddSuppression adds the address to the suppression list of the project.
+func (sender *SuppressionSender) AddSuppression(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer sender.cache.Delete(projectID.String())
+
+	return sender.db.AddSuppression(ctx, projectID, strings.ToLower(email))
+}
+
+// RemoveSuppression removes the address from the suppression list of the project.
+func (sender *SuppressionSender) RemoveSuppression(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer sender.cache.Delete(projectID.String())
+
+	return sender.db.RemoveSuppression(ctx, projectID, strings.ToLower(email))
+}
+
+// SendEmail sends the message to the recipients, which aren't suppressed.
+// It returns ErrSuppressed, when none of the recipients is left.
+func (sender *SuppressionSender) SendEmail(ctx context.Context, msg *post.Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	filtered, err := sender.filter(ctx, msg)
+	if err != nil {
+		return err
+	}
+	return sender.Sender.SendEmail(ctx, filtered)
+}
+
+// SendBatch sends each of the messages to the recipients, which aren't suppressed.
+// Messages without any recipient left fail with ErrSuppressed.
+func (sender *SuppressionSender) SendBatch(ctx context.Context, msgs []*post.Message) []error {
+	defer mon.Task()(&ctx)(nil)
+
+	sendErrs := make([]error, len(msgs))
+	filtered := make([]*post.Message, 0, len(msgs))
+	positions := make([]int, 0, len(msgs))
+	for i, msg := range msgs {
+		filteredMsg, err := sender.filter(ctx, msg)
+		if err != nil {
+			sendErrs[i] = err
+			continue
+		}
+		filtered = append(filtered, filteredMsg)
+		positions = append(positions, i)
+	}
+
+	for i, err := range sendBatch(ctx, sender.Sender, filtered) {
+		sendErrs[positions[i]] = err
+	}
+	return sendErrs
+}
+
+// filter returns the message with only the recipients, which aren't suppressed,
+// and with the project in ProjectIDHeader. It returns ErrSuppressed, when none
+// of the recipients is left.
+func (sender *SuppressionSender) filter(ctx context.Context, msg *post.Message) (*post.Message, error) {
+	projectID, ok := projectIDFromContext(ctx)
+	if !ok {
+		return msg, nil
+	}
+
+	suppressed, err := sender.suppressed(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]post.Address, 0, len(msg.To))
+	for _, recipient := range msg.To {
+		if _, ok := suppressed[strings.ToLower(recipient.Address)]; !ok {
+			allowed = append(allowed, recipient)
+		}
+	}
+	if len(allowed) < len(msg.To) {
+		mon.Counter("mail_suppressed_recipients").Inc(int64(len(msg.To) - len(allowed)))
+	}
+	if len(allowed) == 0 {
+		return nil, ErrSuppressed.New("all recipients are suppressed by project %s", projectID)
+	}
+
+	// the project is sent along, so the bounces reported by the mail provider
+	// can be added to its suppression list
+	filtered := *msg
+	filtered.To = allowed
+	filtered.ExtraHeaders = append(append([]string(nil), msg.ExtraHeaders...), ProjectIDHeader+": "+projectID.String())
+	return &filtered, nil
+}
+
+// suppressed returns the set of suppressed lowercase addresses of the project.
+func (sender *SuppressionSender) suppressed(ctx context.Context, projectID uuid.UUID) (map[string]struct{}, error) {
+	value, err := sender.cache.Get(projectID.String(), func() (interface{}, error) {
+		emails, err := sender.db.ListSuppressions(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		suppressed := make(map[string]struct{}, len(emails))
+		for _, email := range emails {
+			suppressed[strings.ToLower(email)] = struct{}{}
+		}
+		return suppressed, nil
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return value.(map[string]struct{}), nil
+}
+
+// Close closes the wrapped sender.
+func (sender *SuppressionSender) Close() error {
+	return closeSender(sender.Sender)
+}
+
+// HealthCheck checks the wrapped sender.
+func (sender *SuppressionSender) HealthCheck(ctx context.Context) error {
+	return healthCheckSender(ctx, sender.Sender)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mailservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// memorySuppressionDB is an in-memory mailservice.SuppressionDB.
+type memorySuppressionDB struct {
+	emails map[uuid.UUID]map[string]struct{}
+	lists  int
+}
+
+func (db *memorySuppressionDB) AddSuppression(ctx context.Context, projectID uuid.UUID, email string) error {
+	if db.emails[projectID] == nil {
+		db.emails[projectID] = map[string]struct{}{}
+	}
+	db.emails[projectID][email] = struct{}{}
+	return nil
+}
+
+func (db *memorySuppressionDB) RemoveSuppression(ctx context.Context, projectID uuid.UUID, email string) error {
+	delete(db.emails[projectID], email)
+	return nil
+}
+
+func (db *memorySuppressionDB) ListSuppressions(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	db.lists++
+	var emails []string
+	for email := range db.emails[projectID] {
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+func TestSuppressionSender(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	db := &memorySuppressionDB{emails: map[uuid.UUID]map[string]struct{}{}}
+	recorder := &recordingSender{}
+	sender := mailservice.NewSuppressionSender(recorder, db, mailservice.SuppressionConfig{
+		CacheCapacity:   10,
+		CacheExpiration: time.Hour,
+	})
+
+	require.NoError(t, sender.AddSuppression(ctx, projectID, "Shared@Mail.test"))
+
+	projectCtx := mailservice.WithProjectID(ctx, projectID)
+	newMessage := func(to ...string) *post.Message {
+		msg := &post.Message{Subject: "test"}
+		for _, address := range to {
+			msg.To = append(msg.To, post.Address{Address: address})
+		}
+		return msg
+	}
+
+	t.Run("suppressed recipient", func(t *testing.T) {
+		recorder.messages = nil
+
+		err := sender.SendEmail(projectCtx, newMessage("SHARED@mail.test"))
+		require.Error(t, err)
+		require.True(t, mailservice.ErrSuppressed.Has(err))
+		require.Empty(t, recorder.messages)
+	})
+
+	t.Run("not suppressed recipient", func(t *testing.T) {
+		recorder.messages = nil
+
+		require.NoError(t, sender.SendEmail(projectCtx, newMessage("foo@mail.test", "shared@mail.test")))
+		require.Len(t, recorder.messages, 1)
+		require.Equal(t, []post.Address{{Address: "foo@mail.test"}}, recorder.messages[0].To)
+
+		// the project is sent along for the bounce notifications
+		sentProjectID, ok := mailservice.MessageProjectID(&recorder.messages[0])
+		require.True(t, ok)
+		require.Equal(t, projectID, sentProjectID)
+	})
+
+	t.Run("other project", func(t *testing.T) {
+		recorder.messages = nil
+
+		otherCtx := mailservice.WithProjectID(ctx, testrand.UUID())
+		require.NoError(t, sender.SendEmail(otherCtx, newMessage("shared@mail.test")))
+		require.Len(t, recorder.messages, 1)
+	})
+
+	t.Run("without project", func(t *testing.T) {
+		recorder.messages = nil
+
+		require.NoError(t, sender.SendEmail(ctx, newMessage("shared@mail.test")))
+		require.Len(t, recorder.messages, 1)
+		require.Empty(t, recorder.messages[0].ExtraHeaders)
+	})
+
+	t.Run("cached until changed", func(t *testing.T) {
+		recorder.messages = nil
+		lists := db.lists
+
+		require.Error(t, sender.SendEmail(projectCtx, newMessage("shared@mail.test")))
+		require.Equal(t, lists, db.lists)
+
+		require.NoError(t, sender.RemoveSuppression(ctx, projectID, "shared@MAIL.TEST"))
+		require.NoError(t, sender.SendEmail(projectCtx, newMessage("shared@mail.test")))
+		require.Len(t, recorder.messages, 1)
+		require.Equal(t, lists+1, db.lists)
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func BenchmarkBucketEmpty(b *testing.B) {
+	objects := 10000
+	if testing.Short() {
+		objects = 10
+	}
+
+	metabasetest.Bench(b, func(ctx *testcontext.Context, b *testing.B, db *metabase.DB) {
+		projectID := testrand.UUID()
+
+		// the probe should take the same time for the small and the large bucket
+		sizes := map[string]int{"small": 1, "large": objects}
+		for name, count := range sizes {
+			for i := 0; i < count; i++ {
+				_, err := db.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+					ObjectStream: metabase.ObjectStream{
+						ProjectID:  projectID,
+						BucketName: name,
+						ObjectKey:  metabase.ObjectKey(fmt.Sprintf("object-%08d", i)),
+						Version:    1,
+						StreamID:   testrand.UUID(),
+					},
+					Encryption: metabasetest.DefaultEncryption,
+				})
+				require.NoError(b, err)
+			}
+		}
+
+		for _, bucket := range []struct {
+			name  string
+			empty bool
+		}{
+			{name: "small", empty: false},
+			{name: "large", empty: false},
+			{name: "empty", empty: true},
+		} {
+			bucket := bucket
+			b.Run(fmt.Sprintf("objects=%d,bucket=%s", sizes[bucket.name], bucket.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					empty, err := db.BucketEmpty(ctx, metabase.BucketEmpty{
+						ProjectID:  projectID,
+						BucketName: bucket.name,
+					})
+					require.NoError(b, err)
+					require.Equal(b, bucket.empty, empty)
+				}
+			})
+		}
+	})
+}
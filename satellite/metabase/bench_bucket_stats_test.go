@@ -0,0 +1,77 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+// BenchmarkBucketStatsBatch compares getting the statistics of 100 buckets with
+// a single BucketStatsBatch query and with a BucketStats query per bucket.
+func BenchmarkBucketStatsBatch(b *testing.B) {
+	const bucketCount = 100
+	objectsPerBucket := 10
+	if testing.Short() {
+		objectsPerBucket = 1
+	}
+
+	metabasetest.Bench(b, func(ctx *testcontext.Context, b *testing.B, db *metabase.DB) {
+		projectID := testrand.UUID()
+
+		bucketNames := make([]string, bucketCount)
+		for i := range bucketNames {
+			bucketNames[i] = fmt.Sprintf("bucket-%03d", i)
+			for k := 0; k < objectsPerBucket; k++ {
+				obj := metabase.ObjectStream{
+					ProjectID:  projectID,
+					BucketName: bucketNames[i],
+					ObjectKey:  metabase.ObjectKey(fmt.Sprintf("object-%08d", k)),
+					Version:    1,
+					StreamID:   testrand.UUID(),
+				}
+				_, err := db.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				})
+				require.NoError(b, err)
+				_, err = db.CommitObject(ctx, metabase.CommitObject{
+					ObjectStream: obj,
+				})
+				require.NoError(b, err)
+			}
+		}
+
+		b.Run("batched", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				stats, err := db.BucketStatsBatch(ctx, metabase.BucketStatsBatch{
+					ProjectID:   projectID,
+					BucketNames: bucketNames,
+				})
+				require.NoError(b, err)
+				require.Len(b, stats, bucketCount)
+			}
+		})
+
+		b.Run("per-bucket", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, name := range bucketNames {
+					stats, err := db.BucketStats(ctx, metabase.BucketStats{
+						ProjectID:  projectID,
+						BucketName: name,
+					})
+					require.NoError(b, err)
+					require.EqualValues(b, objectsPerBucket, stats.ObjectCount)
+				}
+			}
+		})
+	})
+}
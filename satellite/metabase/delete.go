@@ -46,6 +46,9 @@ type DeleteObjectResult struct {
 type DeletedSegmentInfo struct {
 	RootPieceID storj.PieceID
 	Pieces      Pieces
+	// EncryptedSize is the size of the segment, it's set when the segment
+	// was deleted together with its bucket or by server-side copy aware deletes.
+	EncryptedSize int32
 }
 
 type deletedObjectInfo struct {
@@ -59,10 +62,11 @@ type deletedObjectInfo struct {
 }
 
 type deletedRemoteSegmentInfo struct {
-	Position    SegmentPosition
-	RootPieceID storj.PieceID
-	Pieces      Pieces
-	RepairedAt  *time.Time
+	Position      SegmentPosition
+	RootPieceID   storj.PieceID
+	Pieces        Pieces
+	EncryptedSize int32
+	RepairedAt    *time.Time
 }
 
 // DeleteObjectAnyStatusAllVersions contains arguments necessary for deleting all object versions.
@@ -189,6 +193,7 @@ SELECT
 	deleted_segments.root_piece_id,
 	-- piece to remove from storagenodes or link to new ancestor
 	deleted_segments.remote_alias_pieces,
+	deleted_segments.encrypted_size,
 	-- if set, caller needs to promote this stream_id to new ancestor or else object contents will be lost
 	promoted_ancestors.new_ancestor_stream_id
 	-- extra properties only returned when deleting single object
@@ -325,8 +330,9 @@ func (db *DB) deleteObjectExactVersionServerSideCopy(ctx context.Context, opts D
 		}
 		for _, segment := range object.Segments {
 			result.Segments = append(result.Segments, DeletedSegmentInfo{
-				RootPieceID: segment.RootPieceID,
-				Pieces:      segment.Pieces,
+				RootPieceID:   segment.RootPieceID,
+				Pieces:        segment.Pieces,
+				EncryptedSize: segment.EncryptedSize,
 			})
 		}
 	}
@@ -614,6 +620,7 @@ func (db *DB) scanObjectDeletionServerSideCopy(ctx context.Context, location Obj
 	var object deletedObjectInfo
 	var segment deletedRemoteSegmentInfo
 	var aliasPieces AliasPieces
+	var encryptedSize *int32
 
 	for rows.Next() {
 		object.ProjectID = location.ProjectID
@@ -626,6 +633,7 @@ func (db *DB) scanObjectDeletionServerSideCopy(ctx context.Context, location Obj
 			&segmentPosition,
 			&rootPieceID,
 			&aliasPieces,
+			&encryptedSize,
 			&object.PromotedAncestor,
 			// properties only for deleteObject functionality
 			&object.Version,
@@ -653,6 +661,9 @@ func (db *DB) scanObjectDeletionServerSideCopy(ctx context.Context, location Obj
 			if err != nil {
 				return nil, Error.Wrap(err)
 			}
+			if encryptedSize != nil {
+				segment.EncryptedSize = *encryptedSize
+			}
 			if len(segment.Pieces) > 0 {
 				result[len(result)-1].Segments = append(result[len(result)-1].Segments, segment)
 			}
@@ -30,6 +30,10 @@ type DeleteBucketObjects struct {
 	// DeletePieces is called for every batch of objects.
 	// Slice `segments` will be reused between calls.
 	DeletePieces func(ctx context.Context, segments []DeletedSegmentInfo) error
+
+	// Progress is called after every batch with the number of objects deleted so far.
+	// Returning an error stops the deletion.
+	Progress func(ctx context.Context, deletedObjectCount int64) error
 }
 
 var deleteObjectsCockroachSubSQL = `
@@ -122,27 +126,31 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureEnabled(ctx context.Context, opt
 			return deletedObjectCount, err
 		}
 
-		if opts.DeletePieces == nil {
-			// no callback, should only be in test path
-			continue
+		if opts.DeletePieces != nil {
+			for _, object := range objects {
+				if object.PromotedAncestor != nil {
+					// don't remove pieces, they are now linked to the new ancestor
+					continue
+				}
+				for _, segment := range object.Segments {
+					// Is there an advantage to batching this?
+					err := opts.DeletePieces(ctx, []DeletedSegmentInfo{
+						{
+							RootPieceID:   segment.RootPieceID,
+							Pieces:        segment.Pieces,
+							EncryptedSize: segment.EncryptedSize,
+						},
+					})
+					if err != nil {
+						return deletedObjectCount, err
+					}
+				}
+			}
 		}
 
-		for _, object := range objects {
-			if object.PromotedAncestor != nil {
-				// don't remove pieces, they are now linked to the new ancestor
-				continue
-			}
-			for _, segment := range object.Segments {
-				// Is there an advantage to batching this?
-				err := opts.DeletePieces(ctx, []DeletedSegmentInfo{
-					{
-						RootPieceID: segment.RootPieceID,
-						Pieces:      segment.Pieces,
-					},
-				})
-				if err != nil {
-					return deletedObjectCount, err
-				}
+		if opts.Progress != nil {
+			if err := opts.Progress(ctx, deletedObjectCount); err != nil {
+				return deletedObjectCount, err
 			}
 		}
 	}
@@ -158,6 +166,7 @@ func (db *DB) scanBucketObjectsDeletionServerSideCopy(ctx context.Context, locat
 	var segment deletedRemoteSegmentInfo
 	var aliasPieces AliasPieces
 	var segmentPosition *SegmentPosition
+	var encryptedSize *int32
 
 	for rows.Next() {
 		object.ProjectID = location.ProjectID
@@ -168,6 +177,7 @@ func (db *DB) scanBucketObjectsDeletionServerSideCopy(ctx context.Context, locat
 			&segmentPosition,
 			&rootPieceID,
 			&aliasPieces,
+			&encryptedSize,
 			&object.PromotedAncestor,
 		)
 		if err != nil {
@@ -184,6 +194,9 @@ func (db *DB) scanBucketObjectsDeletionServerSideCopy(ctx context.Context, locat
 			if err != nil {
 				return nil, Error.Wrap(err)
 			}
+			if encryptedSize != nil {
+				segment.EncryptedSize = *encryptedSize
+			}
 			if len(segment.Pieces) > 0 {
 				result[len(result)-1].Segments = append(result[len(result)-1].Segments, segment)
 			}
@@ -210,7 +223,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 		)
 		DELETE FROM segments
 		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
-		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces, segments.encrypted_size
 	`
 	case dbutil.Postgres:
 		query = `
@@ -225,7 +238,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 		)
 		DELETE FROM segments
 		WHERE segments.stream_id IN (SELECT deleted_objects.stream_id FROM deleted_objects)
-		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces
+		RETURNING segments.stream_id, segments.root_piece_id, segments.remote_alias_pieces, segments.encrypted_size
 	`
 	default:
 		return 0, Error.New("unhandled database: %v", db.impl)
@@ -235,7 +248,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 	deletedSegments := make([]DeletedSegmentInfo, 0, 100)
 	for {
 		if err := ctx.Err(); err != nil {
-			return 0, err
+			return deletedObjectCount, err
 		}
 
 		deletedSegments = deletedSegments[:0]
@@ -247,7 +260,7 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 				var streamID uuid.UUID
 				var segment DeletedSegmentInfo
 				var aliasPieces AliasPieces
-				err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces)
+				err := rows.Scan(&streamID, &segment.RootPieceID, &aliasPieces, &segment.EncryptedSize)
 				if err != nil {
 					return Error.Wrap(err)
 				}
@@ -284,5 +297,45 @@ func (db *DB) deleteBucketObjectsWithCopyFeatureDisabled(ctx context.Context, op
 				return deletedObjectCount, Error.Wrap(err)
 			}
 		}
+
+		if opts.Progress != nil {
+			err = opts.Progress(ctx, deletedObjectCount)
+			if err != nil {
+				return deletedObjectCount, Error.Wrap(err)
+			}
+		}
+	}
+}
+
+// CountBucketObjects contains arguments for counting the objects of a whole bucket.
+type CountBucketObjects struct {
+	Bucket BucketLocation
+
+	// Pending counts only the pending objects, which are the uploads in progress.
+	Pending bool
+}
+
+// CountBucketObjects returns the number of objects DeleteBucketObjects would delete
+// from the bucket, including pending objects. With opts.Pending it returns only the
+// number of pending objects.
+func (db *DB) CountBucketObjects(ctx context.Context, opts CountBucketObjects) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Bucket.Verify(); err != nil {
+		return 0, err
+	}
+
+	query := `
+		SELECT count(*) FROM objects
+		WHERE project_id = $1 AND bucket_name = $2
+	`
+	if opts.Pending {
+		query += ` AND status = ` + pendingStatus
+	}
+
+	err = db.db.QueryRowContext(ctx, query, opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName)).Scan(&count)
+	if err != nil {
+		return 0, Error.New("unable to count objects: %w", err)
 	}
+	return count, nil
 }
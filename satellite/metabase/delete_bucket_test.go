@@ -126,11 +126,14 @@ func TestDeleteBucketObjects(t *testing.T) {
 		t.Run("three objects", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
-			metabasetest.CreateObject(ctx, t, db, obj1, 2)
-			metabasetest.CreateObject(ctx, t, db, obj2, 2)
-			metabasetest.CreateObject(ctx, t, db, obj3, 2)
+			var expectedFreedBytes int64
+			for _, obj := range []metabase.ObjectStream{obj1, obj2, obj3} {
+				object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+				expectedFreedBytes += object.TotalEncryptedSize
+			}
 
 			nSegments := 0
+			var freedBytes int64
 			metabasetest.DeleteBucketObjects{
 				Opts: metabase.DeleteBucketObjects{
 					Bucket:    obj1.Location().Bucket(),
@@ -142,6 +145,7 @@ func TestDeleteBucketObjects(t *testing.T) {
 							if len(s.Pieces) != 1 {
 								return errors.New("expected 1 piece per segment")
 							}
+							freedBytes += int64(s.EncryptedSize)
 						}
 						return nil
 					},
@@ -150,6 +154,7 @@ func TestDeleteBucketObjects(t *testing.T) {
 			}.Check(ctx, t, db)
 
 			require.Equal(t, 6, nSegments)
+			require.Equal(t, expectedFreedBytes, freedBytes)
 
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
@@ -524,3 +529,60 @@ func TestDeleteBucketWithCopies(t *testing.T) {
 		}
 	})
 }
+
+func TestCountBucketObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj1 := metabasetest.RandObjectStream()
+		obj2 := metabasetest.RandObjectStream()
+		objX := metabasetest.RandObjectStream()
+
+		obj2.ProjectID, obj2.BucketName = obj1.ProjectID, obj1.BucketName
+		objX.ProjectID = obj1.ProjectID
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CountBucketObjects{
+				Opts:     metabase.CountBucketObjects{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("matches deletion", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 1)
+			metabasetest.CreateObject(ctx, t, db, objX, 1)
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj2,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj2.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.CountBucketObjects{
+				Opts:  metabase.CountBucketObjects{Bucket: obj1.Location().Bucket()},
+				Count: 2,
+			}.Check(ctx, t, db)
+
+			metabasetest.CountBucketObjects{
+				Opts:  metabase.CountBucketObjects{Bucket: obj1.Location().Bucket(), Pending: true},
+				Count: 1,
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteBucketObjects{
+				Opts:    metabase.DeleteBucketObjects{Bucket: obj1.Location().Bucket()},
+				Deleted: 2,
+			}.Check(ctx, t, db)
+
+			metabasetest.CountBucketObjects{
+				Opts:  metabase.CountBucketObjects{Bucket: obj1.Location().Bucket()},
+				Count: 0,
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -9,10 +9,13 @@ import (
 	"errors"
 	"time"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
 )
 
 // ErrSegmentNotFound is an error class for non-existing segment.
@@ -304,8 +307,21 @@ type BucketEmpty struct {
 	BucketName string
 }
 
+// bucketEmptyQuery probes for any object of the bucket. The condition is a prefix of the
+// primary key, so the probe stops at the first object found, regardless of how many objects
+// the bucket contains, and an empty bucket is an empty index range.
+const bucketEmptyQuery = `
+	SELECT
+		1
+	FROM objects
+	WHERE
+		project_id   = $1 AND
+		bucket_name  = $2
+	LIMIT 1
+`
+
 // BucketEmpty returns true if bucket does not contain objects (pending or committed).
-// This method doesn't check bucket existence.
+// This method doesn't check bucket existence. The probe is bounded by the context deadline.
 func (db *DB) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -316,6 +332,118 @@ func (db *DB) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, er
 		return false, ErrInvalidRequest.New("BucketName missing")
 	}
 
+	// the probe duration should be the same for empty buckets and any size of non-empty
+	// buckets, a growing duration of non-empty buckets means the probe isn't served by the
+	// primary key anymore.
+	start := time.Now()
+	defer func() {
+		if err != nil {
+			return
+		}
+		result := "not_empty"
+		if empty {
+			result = "empty"
+		}
+		mon.DurationVal("bucket_empty_probe_duration", monkit.NewSeriesTag("result", result)).Observe(time.Since(start))
+	}()
+
+	var value int
+	err = db.db.QueryRowContext(ctx, bucketEmptyQuery, opts.ProjectID, []byte(opts.BucketName)).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return true, nil
+		}
+		return false, Error.New("unable to query objects: %w", err)
+	}
+
+	return false, nil
+}
+
+// TestingBucketEmptyQuery returns the query of BucketEmpty, so tests can check its plan.
+func (db *DB) TestingBucketEmptyQuery() string {
+	return bucketEmptyQuery
+}
+
+// BucketsEmpty contains arguments necessary for checking if buckets are empty.
+type BucketsEmpty struct {
+	ProjectID   uuid.UUID
+	BucketNames []string
+}
+
+// BucketsEmpty returns for each of the bucket names whether the bucket does not
+// contain objects (pending or committed), checking all of them in a single query.
+// This method doesn't check bucket existence.
+func (db *DB) BucketsEmpty(ctx context.Context, opts BucketsEmpty) (empty map[string]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return nil, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	empty = make(map[string]bool, len(opts.BucketNames))
+	bucketNames := make([][]byte, 0, len(opts.BucketNames))
+	for _, name := range opts.BucketNames {
+		if name == "" {
+			return nil, ErrInvalidRequest.New("BucketName missing")
+		}
+		empty[name] = true
+		bucketNames = append(bucketNames, []byte(name))
+	}
+	if len(bucketNames) == 0 {
+		return empty, nil
+	}
+
+	// like in BucketEmpty, each lookup is a prefix of the primary key, so it stops
+	// at the first object, regardless of how many objects the bucket contains.
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			names.name
+		FROM unnest($2::BYTEA[]) AS names(name)
+		WHERE EXISTS (
+			SELECT 1
+			FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = names.name
+			LIMIT 1
+		)
+	`, opts.ProjectID, pgutil.ByteaArray(bucketNames)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var name []byte
+			if err := rows.Scan(&name); err != nil {
+				return err
+			}
+			empty[string(name)] = false
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query objects: %w", err)
+	}
+
+	return empty, nil
+}
+
+// BucketHasObjectsCreatedAfter contains arguments necessary for checking if bucket
+// contains objects created after a specific time.
+type BucketHasObjectsCreatedAfter struct {
+	ProjectID    uuid.UUID
+	BucketName   string
+	CreatedAfter time.Time
+}
+
+// BucketHasObjectsCreatedAfter returns true if bucket contains committed objects
+// created after opts.CreatedAfter. This method doesn't check bucket existence.
+func (db *DB) BucketHasObjectsCreatedAfter(ctx context.Context, opts BucketHasObjectsCreatedAfter) (has bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.ProjectID.IsZero():
+		return false, ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return false, ErrInvalidRequest.New("BucketName missing")
+	}
+
 	var value int
 	err = db.db.QueryRowContext(ctx, `
 		SELECT
@@ -323,17 +451,19 @@ func (db *DB) BucketEmpty(ctx context.Context, opts BucketEmpty) (empty bool, er
 		FROM objects
 		WHERE
 			project_id   = $1 AND
-			bucket_name  = $2
+			bucket_name  = $2 AND
+			status       = `+committedStatus+` AND
+			created_at   > $3
 		LIMIT 1
-	`, opts.ProjectID, []byte(opts.BucketName)).Scan(&value)
+	`, opts.ProjectID, []byte(opts.BucketName), opts.CreatedAfter).Scan(&value)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return true, nil
+			return false, nil
 		}
 		return false, Error.New("unable to query objects: %w", err)
 	}
 
-	return false, nil
+	return true, nil
 }
 
 // TestingAllCommittedObjects gets all objects from bucket.
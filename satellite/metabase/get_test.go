@@ -4,6 +4,8 @@
 package metabase_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/private/dbutil"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -1365,3 +1368,199 @@ func TestBucketEmpty(t *testing.T) {
 		})
 	})
 }
+
+func TestBucketEmptyQueryPlan(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		for i := 0; i < 10; i++ {
+			stream := obj
+			stream.ObjectKey = metabase.ObjectKey(fmt.Sprintf("object-%02d", i))
+			stream.StreamID = testrand.UUID()
+			metabasetest.CreateObject(ctx, t, db, stream, 0)
+		}
+
+		tx, err := db.UnderlyingTagSQL().BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, tx.Rollback()) }()
+
+		if db.Implementation() == dbutil.Postgres {
+			// the table is tiny, so the planner would rather scan it than the index,
+			// which it must still be able to use for a huge table
+			_, err = tx.ExecContext(ctx, "SET LOCAL enable_seqscan = off")
+			require.NoError(t, err)
+		}
+
+		rows, err := tx.QueryContext(ctx, "EXPLAIN "+db.TestingBucketEmptyQuery(), obj.ProjectID, []byte(obj.BucketName))
+		require.NoError(t, err)
+		var lines []string
+		for rows.Next() {
+			var line string
+			require.NoError(t, rows.Scan(&line))
+			lines = append(lines, line)
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+
+		// the probe is a limited lookup of a primary key prefix, so it takes the same time
+		// for any size of the bucket and never scans the whole table
+		plan := strings.ToLower(strings.Join(lines, "\n"))
+		require.Contains(t, plan, "limit", plan)
+		require.NotContains(t, plan, "seq scan", plan)
+		require.NotContains(t, plan, "full scan", plan)
+		switch db.Implementation() {
+		case dbutil.Postgres:
+			require.Contains(t, plan, "objects_pkey", plan)
+		case dbutil.Cockroach:
+			require.Contains(t, plan, "objects@primary", plan)
+		}
+	})
+}
+
+func TestBucketsEmpty(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		now := time.Now()
+		zombieDeadline := now.Add(24 * time.Hour)
+
+		t.Run("ProjectID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketsEmpty{
+				Opts:     metabase.BucketsEmpty{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("BucketName missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketsEmpty{
+				Opts: metabase.BucketsEmpty{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{obj.BucketName, ""},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketsEmpty{
+				Opts: metabase.BucketsEmpty{
+					ProjectID: obj.ProjectID,
+				},
+				Result: map[string]bool{},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("empty and non-empty buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			committed := obj
+			committed.BucketName = "committed"
+			committedObject := metabasetest.CreateObject(ctx, t, db, committed, 0)
+
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID = obj.ProjectID
+			pending.BucketName = "pending"
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: pending,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: 1,
+			}.Check(ctx, t, db)
+
+			// the same bucket name in another project doesn't count
+			otherProject := metabasetest.RandObjectStream()
+			otherProject.BucketName = "other-project"
+			otherObject := metabasetest.CreateObject(ctx, t, db, otherProject, 0)
+
+			metabasetest.BucketsEmpty{
+				Opts: metabase.BucketsEmpty{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{"committed", "pending", "empty", "other-project"},
+				},
+				Result: map[string]bool{
+					"committed":     false,
+					"pending":       false,
+					"empty":         true,
+					"other-project": true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(committedObject),
+					{
+						ObjectStream:           pending,
+						CreatedAt:              now,
+						Status:                 metabase.Pending,
+						Encryption:             metabasetest.DefaultEncryption,
+						ZombieDeletionDeadline: &zombieDeadline,
+					},
+					metabase.RawObject(otherObject),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestBucketHasObjectsCreatedAfter(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("BucketName missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketHasObjectsCreatedAfter{
+				Opts: metabase.BucketHasObjectsCreatedAfter{
+					ProjectID: obj.ProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("committed object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.BucketHasObjectsCreatedAfter{
+				Opts: metabase.BucketHasObjectsCreatedAfter{
+					ProjectID:    obj.ProjectID,
+					BucketName:   obj.BucketName,
+					CreatedAfter: object.CreatedAt.Add(-time.Hour),
+				},
+				Result: true,
+			}.Check(ctx, t, db)
+
+			metabasetest.BucketHasObjectsCreatedAfter{
+				Opts: metabase.BucketHasObjectsCreatedAfter{
+					ProjectID:    obj.ProjectID,
+					BucketName:   obj.BucketName,
+					CreatedAfter: object.CreatedAt.Add(time.Hour),
+				},
+				Result: false,
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
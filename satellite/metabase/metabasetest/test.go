@@ -154,6 +154,36 @@ func (step DeleteBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db
 	checkError(t, err, step.ErrClass, step.ErrText)
 }
 
+// MoveBucketObjects is for testing metabase.MoveBucketObjects.
+type MoveBucketObjects struct {
+	Opts     metabase.MoveBucketObjects
+	Moved    int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step MoveBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	moved, err := db.MoveBucketObjects(ctx, step.Opts)
+	require.Equal(t, step.Moved, moved)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
+// TransferBucketObjects is for testing metabase.TransferBucketObjects.
+type TransferBucketObjects struct {
+	Opts     metabase.TransferBucketObjects
+	Moved    int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step TransferBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	moved, err := db.TransferBucketObjects(ctx, step.Opts)
+	require.Equal(t, step.Moved, moved)
+	checkError(t, err, step.ErrClass, step.ErrText)
+}
+
 // UpdateObjectMetadata is for testing metabase.UpdateObjectMetadata.
 type UpdateObjectMetadata struct {
 	Opts     metabase.UpdateObjectMetadata
@@ -247,6 +277,102 @@ func (step BucketEmpty) Check(ctx *testcontext.Context, t testing.TB, db *metaba
 	require.Equal(t, step.Result, result)
 }
 
+// BucketsEmpty is for testing metabase.BucketsEmpty.
+type BucketsEmpty struct {
+	Opts     metabase.BucketsEmpty
+	Result   map[string]bool
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BucketsEmpty) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BucketsEmpty(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Result, result)
+}
+
+// BucketHasObjectsCreatedAfter is for testing metabase.BucketHasObjectsCreatedAfter.
+type BucketHasObjectsCreatedAfter struct {
+	Opts     metabase.BucketHasObjectsCreatedAfter
+	Result   bool
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BucketHasObjectsCreatedAfter) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BucketHasObjectsCreatedAfter(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Result, result)
+}
+
+// CountBucketObjects is for testing metabase.CountBucketObjects.
+type CountBucketObjects struct {
+	Opts     metabase.CountBucketObjects
+	Count    int64
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CountBucketObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	count, err := db.CountBucketObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Count, count)
+}
+
+// BucketStats is for testing metabase.BucketStats.
+type BucketStats struct {
+	Opts     metabase.BucketStats
+	Result   metabase.BucketStatsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BucketStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BucketStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Result, result)
+}
+
+// ProjectBucketsStats is for testing metabase.ProjectBucketsStats.
+type ProjectBucketsStats struct {
+	Opts     metabase.ProjectBucketsStats
+	Result   metabase.BucketStatsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ProjectBucketsStats) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ProjectBucketsStats(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Result, result)
+}
+
+// BucketStatsBatch is for testing metabase.BucketStatsBatch.
+type BucketStatsBatch struct {
+	Opts     metabase.BucketStatsBatch
+	Result   map[string]metabase.BucketStatsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step BucketStatsBatch) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.BucketStatsBatch(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	require.Equal(t, step.Result, result)
+}
+
 // ListSegments is for testing metabase.ListSegments.
 type ListSegments struct {
 	Opts     metabase.ListSegments
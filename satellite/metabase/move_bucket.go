@@ -0,0 +1,98 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+)
+
+const (
+	moveBatchSizeLimit = intLimitRange(1000)
+)
+
+// MoveBucketObjects contains arguments for moving all objects of a bucket to another bucket name.
+type MoveBucketObjects struct {
+	Bucket        BucketLocation
+	NewBucketName string
+	BatchSize     int
+}
+
+// Verify verifies move bucket objects request fields.
+func (opts *MoveBucketObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.NewBucketName == "":
+		return ErrInvalidRequest.New("NewBucketName missing")
+	case opts.NewBucketName == opts.Bucket.BucketName:
+		return ErrInvalidRequest.New("NewBucketName is the same as BucketName")
+	}
+	return nil
+}
+
+// MoveBucketObjects moves all objects of the bucket to the new bucket name.
+// Segments reference objects by their stream id, so they aren't modified.
+// Objects are moved in batches, so in case of an error the method returns the
+// number of objects moved so far, and calling it again continues the move.
+func (db *DB) MoveBucketObjects(ctx context.Context, opts MoveBucketObjects) (movedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	moveBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	var query string
+	switch db.impl {
+	case dbutil.Cockroach:
+		query = `
+			UPDATE objects SET bucket_name = $3
+			WHERE project_id = $1 AND bucket_name = $2
+			LIMIT $4
+		`
+	case dbutil.Postgres:
+		query = `
+			UPDATE objects SET bucket_name = $3
+			WHERE (project_id, bucket_name, object_key, version) IN (
+				SELECT project_id, bucket_name, object_key, version FROM objects
+				WHERE project_id = $1 AND bucket_name = $2
+				LIMIT $4
+			)
+		`
+	default:
+		return 0, Error.New("unhandled database: %v", db.impl)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return movedObjectCount, err
+		}
+
+		result, err := db.db.ExecContext(ctx, query,
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), []byte(opts.NewBucketName), opts.BatchSize)
+		if err != nil {
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return movedObjectCount, Error.Wrap(ErrObjectAlreadyExists.New(""))
+			}
+			return movedObjectCount, Error.New("unable to move bucket objects: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return movedObjectCount, Error.New("unable to move bucket objects: %w", err)
+		}
+		movedObjectCount += affected
+
+		if affected < int64(opts.BatchSize) {
+			return movedObjectCount, nil
+		}
+	}
+}
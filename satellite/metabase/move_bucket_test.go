@@ -0,0 +1,108 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestMoveBucketObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj1 := metabasetest.RandObjectStream()
+		obj2 := metabasetest.RandObjectStream()
+		objX := metabasetest.RandObjectStream()
+
+		obj2.ProjectID, obj2.BucketName = obj1.ProjectID, obj1.BucketName
+		objX.ProjectID = obj1.ProjectID
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket: metabase.BucketLocation{ProjectID: uuid.UUID{1}, BucketName: ""},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket: obj1.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:        obj1.Location().Bucket(),
+					NewBucketName: obj1.BucketName,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewBucketName is the same as BucketName",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("move in batches", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 0)
+			metabasetest.CreateObject(ctx, t, db, obj2, 0)
+			metabasetest.CreateObject(ctx, t, db, objX, 0)
+			now := time.Now()
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:        obj1.Location().Bucket(),
+					NewBucketName: "renamed",
+					BatchSize:     1,
+				},
+				Moved: 2,
+			}.Check(ctx, t, db)
+
+			moved1, moved2 := obj1, obj2
+			moved1.BucketName, moved2.BucketName = "renamed", "renamed"
+
+			var objects []metabase.RawObject
+			for _, stream := range []metabase.ObjectStream{moved1, moved2, objX} {
+				objects = append(objects, metabase.RawObject{
+					ObjectStream: stream,
+					CreatedAt:    now,
+					Status:       metabase.Committed,
+					Encryption:   metabasetest.DefaultEncryption,
+				})
+			}
+			metabasetest.Verify{Objects: objects}.Check(ctx, t, db)
+		})
+
+		t.Run("conflicting object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			conflicting := obj1
+			conflicting.BucketName = "renamed"
+			conflicting.StreamID = testrand.UUID()
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 0)
+			metabasetest.CreateObject(ctx, t, db, conflicting, 0)
+
+			metabasetest.MoveBucketObjects{
+				Opts: metabase.MoveBucketObjects{
+					Bucket:        obj1.Location().Bucket(),
+					NewBucketName: "renamed",
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/errs2"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
 )
 
 // GetTableStats contains arguments necessary for getting table statistics.
@@ -39,3 +42,157 @@ func (db *DB) GetTableStats(ctx context.Context, opts GetTableStats) (result Tab
 	err = errs.Combine(group.Wait()...)
 	return result, err
 }
+
+// BucketStats contains arguments necessary for getting bucket statistics.
+type BucketStats struct {
+	ProjectID  uuid.UUID
+	BucketName string
+}
+
+// BucketStatsResult contains the number of committed objects in a bucket
+// and their total encrypted size.
+type BucketStatsResult struct {
+	ObjectCount int64
+	TotalSize   int64
+}
+
+// BucketStats returns the number of committed objects in a bucket and their
+// total size in a single query. This method doesn't check bucket existence.
+func (db *DB) BucketStats(ctx context.Context, opts BucketStats) (result BucketStatsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case opts.ProjectID.IsZero():
+		return BucketStatsResult{}, ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return BucketStatsResult{}, ErrInvalidRequest.New("BucketName missing")
+	}
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT
+			count(*), coalesce(sum(total_encrypted_size), 0)
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			status       = `+committedStatus+`
+	`, opts.ProjectID, []byte(opts.BucketName)).Scan(&result.ObjectCount, &result.TotalSize)
+	if err != nil {
+		return BucketStatsResult{}, Error.New("unable to query bucket stats: %w", err)
+	}
+
+	return result, nil
+}
+
+// BucketStatsBatch contains arguments necessary for getting the statistics of
+// multiple buckets.
+type BucketStatsBatch struct {
+	ProjectID   uuid.UUID
+	BucketNames []string
+}
+
+// BucketStatsBatch returns for each of the bucket names the number of committed
+// objects in the bucket and their total size, getting all of them in a single
+// query. Buckets without committed objects have zero statistics. This method
+// doesn't check bucket existence.
+func (db *DB) BucketStatsBatch(ctx context.Context, opts BucketStatsBatch) (stats map[string]BucketStatsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return nil, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	stats = make(map[string]BucketStatsResult, len(opts.BucketNames))
+	bucketNames := make([][]byte, 0, len(opts.BucketNames))
+	for _, name := range opts.BucketNames {
+		if name == "" {
+			return nil, ErrInvalidRequest.New("BucketName missing")
+		}
+		stats[name] = BucketStatsResult{}
+		bucketNames = append(bucketNames, []byte(name))
+	}
+	if len(bucketNames) == 0 {
+		return stats, nil
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			bucket_name, count(*), coalesce(sum(total_encrypted_size), 0)
+		FROM objects
+		WHERE
+			project_id  = $1 AND
+			bucket_name = ANY($2::BYTEA[]) AND
+			status      = `+committedStatus+`
+		GROUP BY bucket_name
+	`, opts.ProjectID, pgutil.ByteaArray(bucketNames)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var name []byte
+			var result BucketStatsResult
+			if err := rows.Scan(&name, &result.ObjectCount, &result.TotalSize); err != nil {
+				return err
+			}
+			stats[string(name)] = result
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query bucket stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// ProjectBucketsStats contains arguments necessary for getting the statistics of
+// the buckets of a project.
+type ProjectBucketsStats struct {
+	ProjectID uuid.UUID
+	// BucketNames restricts the statistics to the buckets with these names,
+	// when it isn't nil.
+	BucketNames []string
+}
+
+// ProjectBucketsStats returns the number of committed objects in the buckets of
+// a project and their total size in a single query. This method doesn't check
+// bucket existence.
+func (db *DB) ProjectBucketsStats(ctx context.Context, opts ProjectBucketsStats) (result BucketStatsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return BucketStatsResult{}, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	if opts.BucketNames == nil {
+		err = db.db.QueryRowContext(ctx, `
+			SELECT
+				count(*), coalesce(sum(total_encrypted_size), 0)
+			FROM objects
+			WHERE
+				project_id = $1 AND
+				status     = `+committedStatus+`
+		`, opts.ProjectID).Scan(&result.ObjectCount, &result.TotalSize)
+	} else {
+		if len(opts.BucketNames) == 0 {
+			return BucketStatsResult{}, nil
+		}
+
+		bucketNames := make([][]byte, len(opts.BucketNames))
+		for i, name := range opts.BucketNames {
+			bucketNames[i] = []byte(name)
+		}
+
+		err = db.db.QueryRowContext(ctx, `
+			SELECT
+				count(*), coalesce(sum(total_encrypted_size), 0)
+			FROM objects
+			WHERE
+				project_id  = $1 AND
+				bucket_name = ANY($2::BYTEA[]) AND
+				status      = `+committedStatus+`
+		`, opts.ProjectID, pgutil.ByteaArray(bucketNames)).Scan(&result.ObjectCount, &result.TotalSize)
+	}
+	if err != nil {
+		return BucketStatsResult{}, Error.New("unable to query project buckets stats: %w", err)
+	}
+
+	return result, nil
+}
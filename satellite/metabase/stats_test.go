@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/private/dbutil"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
@@ -91,3 +92,247 @@ func TestGetTableStats(t *testing.T) {
 		}
 	})
 }
+
+func TestBucketStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("ProjectID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStats{
+				Opts:     metabase.BucketStats{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("BucketName missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStats{
+				Opts: metabase.BucketStats{
+					ProjectID: obj.ProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("empty bucket", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStats{
+				Opts: metabase.BucketStats{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				Result: metabase.BucketStatsResult{},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("committed objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj1 := obj
+			obj1.ObjectKey = "first"
+			object1 := metabasetest.CreateObject(ctx, t, db, obj1, 2)
+
+			obj2 := obj
+			obj2.ObjectKey = "second"
+			obj2.StreamID = testrand.UUID()
+			object2 := metabasetest.CreateObject(ctx, t, db, obj2, 3)
+
+			// pending objects are not counted
+			obj3 := obj
+			obj3.ObjectKey = "pending"
+			obj3.StreamID = testrand.UUID()
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj3,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj3.Version,
+			}.Check(ctx, t, db)
+
+			// objects from other buckets are not counted
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			metabasetest.BucketStats{
+				Opts: metabase.BucketStats{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				Result: metabase.BucketStatsResult{
+					ObjectCount: 2,
+					TotalSize:   object1.TotalEncryptedSize + object2.TotalEncryptedSize,
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestBucketStatsBatch(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("ProjectID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStatsBatch{
+				Opts:     metabase.BucketStatsBatch{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("BucketName missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStatsBatch{
+				Opts: metabase.BucketStatsBatch{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{"bucket", ""},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("no buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.BucketStatsBatch{
+				Opts: metabase.BucketStatsBatch{
+					ProjectID: obj.ProjectID,
+				},
+				Result: map[string]metabase.BucketStatsResult{},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("multiple buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj1 := obj
+			obj1.BucketName = "first"
+			object1 := metabasetest.CreateObject(ctx, t, db, obj1, 2)
+
+			obj2 := obj
+			obj2.BucketName = "second"
+			obj2.StreamID = testrand.UUID()
+			object2 := metabasetest.CreateObject(ctx, t, db, obj2, 3)
+
+			obj3 := obj2
+			obj3.ObjectKey = metabasetest.RandObjectKey()
+			obj3.StreamID = testrand.UUID()
+			object3 := metabasetest.CreateObject(ctx, t, db, obj3, 1)
+
+			// pending objects are not counted
+			pending := obj
+			pending.BucketName = "pending"
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: pending,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: pending.Version,
+			}.Check(ctx, t, db)
+
+			// objects from other projects are not counted
+			other := metabasetest.RandObjectStream()
+			other.BucketName = "first"
+			metabasetest.CreateObject(ctx, t, db, other, 1)
+
+			metabasetest.BucketStatsBatch{
+				Opts: metabase.BucketStatsBatch{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{"first", "second", "pending", "missing"},
+				},
+				Result: map[string]metabase.BucketStatsResult{
+					"first": {
+						ObjectCount: 1,
+						TotalSize:   object1.TotalEncryptedSize,
+					},
+					"second": {
+						ObjectCount: 2,
+						TotalSize:   object2.TotalEncryptedSize + object3.TotalEncryptedSize,
+					},
+					"pending": {},
+					"missing": {},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestProjectBucketsStats(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("ProjectID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ProjectBucketsStats{
+				Opts:     metabase.ProjectBucketsStats{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("empty project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ProjectBucketsStats{
+				Opts: metabase.ProjectBucketsStats{
+					ProjectID: obj.ProjectID,
+				},
+				Result: metabase.BucketStatsResult{},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("multiple buckets", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj1 := obj
+			obj1.BucketName = "first"
+			object1 := metabasetest.CreateObject(ctx, t, db, obj1, 2)
+
+			obj2 := obj
+			obj2.BucketName = "second"
+			obj2.StreamID = testrand.UUID()
+			object2 := metabasetest.CreateObject(ctx, t, db, obj2, 3)
+
+			// objects from other projects are not counted
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			metabasetest.ProjectBucketsStats{
+				Opts: metabase.ProjectBucketsStats{
+					ProjectID: obj.ProjectID,
+				},
+				Result: metabase.BucketStatsResult{
+					ObjectCount: 2,
+					TotalSize:   object1.TotalEncryptedSize + object2.TotalEncryptedSize,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ProjectBucketsStats{
+				Opts: metabase.ProjectBucketsStats{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{"second", "missing"},
+				},
+				Result: metabase.BucketStatsResult{
+					ObjectCount: 1,
+					TotalSize:   object2.TotalEncryptedSize,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ProjectBucketsStats{
+				Opts: metabase.ProjectBucketsStats{
+					ProjectID:   obj.ProjectID,
+					BucketNames: []string{},
+				},
+				Result: metabase.BucketStatsResult{},
+			}.Check(ctx, t, db)
+		})
+	})
+}
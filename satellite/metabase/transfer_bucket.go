@@ -0,0 +1,95 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
+)
+
+// TransferBucketObjects contains arguments for moving all objects of a bucket to another project.
+type TransferBucketObjects struct {
+	Bucket       BucketLocation
+	NewProjectID uuid.UUID
+	BatchSize    int
+}
+
+// Verify verifies transfer bucket objects request fields.
+func (opts *TransferBucketObjects) Verify() error {
+	if err := opts.Bucket.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.NewProjectID.IsZero():
+		return ErrInvalidRequest.New("NewProjectID missing")
+	case opts.NewProjectID == opts.Bucket.ProjectID:
+		return ErrInvalidRequest.New("NewProjectID is the same as ProjectID")
+	}
+	return nil
+}
+
+// TransferBucketObjects moves all objects of the bucket to the bucket with the same name
+// in the new project. Segments reference objects by their stream id, so they aren't modified.
+// Objects are moved in batches, so in case of an error the method returns the number of
+// objects moved so far, and calling it again continues the transfer.
+func (db *DB) TransferBucketObjects(ctx context.Context, opts TransferBucketObjects) (movedObjectCount int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return 0, err
+	}
+
+	moveBatchSizeLimit.Ensure(&opts.BatchSize)
+
+	var query string
+	switch db.impl {
+	case dbutil.Cockroach:
+		query = `
+			UPDATE objects SET project_id = $3
+			WHERE project_id = $1 AND bucket_name = $2
+			LIMIT $4
+		`
+	case dbutil.Postgres:
+		query = `
+			UPDATE objects SET project_id = $3
+			WHERE (project_id, bucket_name, object_key, version) IN (
+				SELECT project_id, bucket_name, object_key, version FROM objects
+				WHERE project_id = $1 AND bucket_name = $2
+				LIMIT $4
+			)
+		`
+	default:
+		return 0, Error.New("unhandled database: %v", db.impl)
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return movedObjectCount, err
+		}
+
+		result, err := db.db.ExecContext(ctx, query,
+			opts.Bucket.ProjectID, []byte(opts.Bucket.BucketName), opts.NewProjectID, opts.BatchSize)
+		if err != nil {
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return movedObjectCount, Error.Wrap(ErrObjectAlreadyExists.New(""))
+			}
+			return movedObjectCount, Error.New("unable to transfer bucket objects: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return movedObjectCount, Error.New("unable to transfer bucket objects: %w", err)
+		}
+		movedObjectCount += affected
+
+		if affected < int64(opts.BatchSize) {
+			return movedObjectCount, nil
+		}
+	}
+}
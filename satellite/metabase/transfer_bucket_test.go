@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestTransferBucketObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj1 := metabasetest.RandObjectStream()
+		obj2 := metabasetest.RandObjectStream()
+		objX := metabasetest.RandObjectStream()
+
+		obj2.ProjectID, obj2.BucketName = obj1.ProjectID, obj1.BucketName
+		objX.ProjectID = obj1.ProjectID
+
+		newProjectID := testrand.UUID()
+
+		t.Run("invalid options", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.TransferBucketObjects{
+				Opts: metabase.TransferBucketObjects{
+					Bucket:       metabase.BucketLocation{ProjectID: uuid.UUID{1}, BucketName: ""},
+					NewProjectID: newProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.TransferBucketObjects{
+				Opts: metabase.TransferBucketObjects{
+					Bucket: obj1.Location().Bucket(),
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.TransferBucketObjects{
+				Opts: metabase.TransferBucketObjects{
+					Bucket:       obj1.Location().Bucket(),
+					NewProjectID: obj1.ProjectID,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewProjectID is the same as ProjectID",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("transfer in batches", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 0)
+			metabasetest.CreateObject(ctx, t, db, obj2, 0)
+			metabasetest.CreateObject(ctx, t, db, objX, 0)
+			now := time.Now()
+
+			metabasetest.TransferBucketObjects{
+				Opts: metabase.TransferBucketObjects{
+					Bucket:       obj1.Location().Bucket(),
+					NewProjectID: newProjectID,
+					BatchSize:    1,
+				},
+				Moved: 2,
+			}.Check(ctx, t, db)
+
+			moved1, moved2 := obj1, obj2
+			moved1.ProjectID, moved2.ProjectID = newProjectID, newProjectID
+
+			var objects []metabase.RawObject
+			for _, stream := range []metabase.ObjectStream{moved1, moved2, objX} {
+				objects = append(objects, metabase.RawObject{
+					ObjectStream: stream,
+					CreatedAt:    now,
+					Status:       metabase.Committed,
+					Encryption:   metabasetest.DefaultEncryption,
+				})
+			}
+			metabasetest.Verify{Objects: objects}.Check(ctx, t, db)
+		})
+
+		t.Run("conflicting object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			conflicting := obj1
+			conflicting.ProjectID = newProjectID
+			conflicting.StreamID = testrand.UUID()
+
+			metabasetest.CreateObject(ctx, t, db, obj1, 0)
+			metabasetest.CreateObject(ctx, t, db, conflicting, 0)
+
+			metabasetest.TransferBucketObjects{
+				Opts: metabase.TransferBucketObjects{
+					Bucket:       obj1.Location().Bucket(),
+					NewProjectID: newProjectID,
+				},
+				ErrClass: &metabase.ErrObjectAlreadyExists,
+			}.Check(ctx, t, db)
+		})
+	})
+}
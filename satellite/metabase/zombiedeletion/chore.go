@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/storj/private/jitter"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -27,6 +28,7 @@ type Config struct {
 	Enabled     bool          `help:"set if zombie object cleanup is enabled or not" default:"true"`
 	ListLimit   int           `help:"how many objects to query in a batch" default:"100"`
 	InactiveFor time.Duration `help:"after what time object will be deleted if there where no new upload activity" default:"24h"`
+	StartJitter float64       `help:"fraction of the interval by which the first run is randomly delayed, negative uses chore-jitter.fraction" default:"-1"`
 }
 
 // Chore implements the zombie objects cleanup chore.
@@ -37,8 +39,9 @@ type Chore struct {
 	config   Config
 	metabase *metabase.DB
 
-	nowFn func() time.Time
-	Loop  *sync2.Cycle
+	nowFn      func() time.Time
+	startDelay *jitter.Delay
+	Loop       *sync2.Cycle
 }
 
 // NewChore creates a new instance of the zombiedeletion chore.
@@ -48,8 +51,9 @@ func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
 		config:   config,
 		metabase: metabase,
 
-		nowFn: time.Now,
-		Loop:  sync2.NewCycle(config.Interval),
+		nowFn:      time.Now,
+		startDelay: jitter.NewDelay(config.Interval, config.StartJitter),
+		Loop:       sync2.NewCycle(config.Interval),
 	}
 }
 
@@ -61,11 +65,16 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		return nil
 	}
 
+	if !chore.startDelay.Wait(ctx) {
+		return ctx.Err()
+	}
+
 	return chore.Loop.Run(ctx, chore.deleteZombieObjects)
 }
 
 // Close stops the zombiedeletion chore.
 func (chore *Chore) Close() error {
+	chore.startDelay.Close()
 	chore.Loop.Close()
 	return nil
 }
@@ -0,0 +1,161 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+)
+
+// Access log operations, named like the S3 requests they correspond to.
+const (
+	accessLogGet    = "GET"
+	accessLogHead   = "HEAD"
+	accessLogPut    = "PUT"
+	accessLogDelete = "DELETE"
+)
+
+// bucketLoggingDB is the source of the access logging configuration of buckets.
+type bucketLoggingDB interface {
+	// GetMinimalBucket returns existing bucket with minimal number of fields.
+	GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (buckets.Bucket, error)
+}
+
+// bucketLoggingCache caches the access logging configuration of buckets, which rarely
+// changes, to avoid querying it on every object access.
+type bucketLoggingCache struct {
+	buckets bucketLoggingDB
+	// cache is nil when caching is disabled.
+	cache *lrucache.ExpiringLRU
+}
+
+// newBucketLoggingCache returns a cache in front of buckets. Zero expiration disables caching.
+func newBucketLoggingCache(buckets bucketLoggingDB, capacity int, expiration time.Duration) *bucketLoggingCache {
+	cache := &bucketLoggingCache{buckets: buckets}
+	if expiration > 0 {
+		cache.cache = lrucache.New(lrucache.Options{
+			Capacity:   capacity,
+			Expiration: expiration,
+		})
+	}
+	return cache
+}
+
+// GetLogging returns the access logging configuration of the bucket.
+// A bucket, which doesn't exist, has access logging disabled.
+func (cache *bucketLoggingCache) GetLogging(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.Logging, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	get := func() (interface{}, error) {
+		bucket, err := cache.buckets.GetMinimalBucket(ctx, bucketName, projectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return buckets.Logging{}, nil
+			}
+			return nil, err
+		}
+		return bucket.Logging, nil
+	}
+
+	if cache.cache == nil {
+		logging, err := get()
+		if err != nil {
+			return buckets.Logging{}, err
+		}
+		return logging.(buckets.Logging), nil
+	}
+
+	logging, err := cache.cache.Get(bucketLoggingCacheKey(bucketName, projectID), get)
+	if err != nil {
+		return buckets.Logging{}, err
+	}
+	return logging.(buckets.Logging), nil
+}
+
+// Invalidate removes the cached access logging configuration of the bucket.
+// It's safe to call on a nil cache, e.g. of an endpoint built for tests.
+func (cache *bucketLoggingCache) Invalidate(bucketName []byte, projectID uuid.UUID) {
+	if cache == nil || cache.cache == nil {
+		return
+	}
+	cache.cache.Delete(bucketLoggingCacheKey(bucketName, projectID))
+}
+
+// bucketLoggingSources returns the buckets of the project, which log their accesses
+// into the target bucket, so their cached configuration can be invalidated, when the
+// target is renamed, moved or deleted. Failures are only logged, since the cached
+// configuration expires anyway.
+func (endpoint *Endpoint) bucketLoggingSources(ctx context.Context, projectID uuid.UUID, targetBucket []byte) [][]byte {
+	if !endpoint.config.BucketLogging.Enabled {
+		return nil
+	}
+
+	sources, err := endpoint.buckets.ListBucketLoggingSources(ctx, projectID, targetBucket)
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to list bucket logging sources", zap.ByteString("bucketName", targetBucket), zap.Error(err))
+		return nil
+	}
+	return sources
+}
+
+// invalidateBucketLogging removes the cached access logging configuration of the buckets.
+func (endpoint *Endpoint) invalidateBucketLogging(projectID uuid.UUID, bucketNames ...[]byte) {
+	for _, bucketName := range bucketNames {
+		endpoint.bucketLogging.Invalidate(bucketName, projectID)
+	}
+}
+
+func bucketLoggingCacheKey(bucketName []byte, projectID uuid.UUID) string {
+	return projectID.String() + "/" + string(bucketName)
+}
+
+// logAccess enqueues an access log record of the object access, when the bucket has
+// access logging enabled. Failures are only logged, they don't fail the access.
+func (endpoint *Endpoint) logAccess(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, operation string, objectKey []byte) {
+	defer mon.Task()(&ctx)(nil)
+
+	if !endpoint.config.BucketLogging.Enabled {
+		return
+	}
+
+	logging, err := endpoint.bucketLogging.GetLogging(ctx, bucketName, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to get bucket logging configuration", zap.ByteString("bucketName", bucketName), zap.Error(err))
+		return
+	}
+	if !logging.Enabled() {
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to create access log record id", zap.Error(err))
+		return
+	}
+
+	err = endpoint.buckets.EnqueueAccessLog(ctx, buckets.AccessLogRecord{
+		ID:           id,
+		ProjectID:    keyInfo.ProjectID,
+		BucketName:   bucketName,
+		TargetBucket: logging.TargetBucket,
+		TargetPrefix: logging.TargetPrefix,
+		Operation:    operation,
+		ObjectKey:    objectKey,
+		APIKeyID:     keyInfo.ID,
+		CreatedAt:    endpoint.clock(),
+	})
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to enqueue access log record", zap.ByteString("bucketName", bucketName), zap.Error(err))
+		return
+	}
+	mon.Meter("bucket_access_log_enqueued").Mark(1)
+}
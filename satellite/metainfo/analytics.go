@@ -0,0 +1,51 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"storj.io/common/pb"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// BucketAnalytics receives the bucket lifecycle events for product analytics,
+// which is implemented by analytics.Service.
+type BucketAnalytics interface {
+	// TrackBucketCreated reports a bucket created in the project.
+	TrackBucketCreated(projectID uuid.UUID, userAgent string, partnerID uuid.UUID)
+	// TrackBucketDeleted reports a bucket deleted from the project.
+	TrackBucketDeleted(projectID uuid.UUID, userAgent string, partnerID uuid.UUID)
+}
+
+// TestingSetAnalytics allows tests to replace the receiver of the bucket lifecycle events.
+func (endpoint *Endpoint) TestingSetAnalytics(analytics BucketAnalytics) {
+	endpoint.analytics = analytics
+}
+
+// trackBucketCreated reports the bucket creation to the analytics.
+// It's best-effort, a failure must not fail the request.
+func (endpoint *Endpoint) trackBucketCreated(header *pb.RequestHeader, keyInfo *console.APIKeyInfo) {
+	if endpoint.analytics == nil {
+		return
+	}
+	endpoint.analytics.TrackBucketCreated(keyInfo.ProjectID, analyticsUserAgent(header, keyInfo), keyInfo.PartnerID)
+}
+
+// trackBucketDeleted reports the bucket deletion to the analytics.
+// It's best-effort, a failure must not fail the request.
+func (endpoint *Endpoint) trackBucketDeleted(header *pb.RequestHeader, keyInfo *console.APIKeyInfo) {
+	if endpoint.analytics == nil {
+		return
+	}
+	endpoint.analytics.TrackBucketDeleted(keyInfo.ProjectID, analyticsUserAgent(header, keyInfo), keyInfo.PartnerID)
+}
+
+// analyticsUserAgent returns the user agent of the API key, or the one of the request
+// when the key has none, the same way as the bucket attribution prefers them.
+func analyticsUserAgent(header *pb.RequestHeader, keyInfo *console.APIKeyInfo) string {
+	if keyInfo.UserAgent != nil {
+		return string(keyInfo.UserAgent)
+	}
+	return string(header.GetUserAgent())
+}
@@ -4,6 +4,7 @@
 package metainfo
 
 import (
+	"bytes"
 	"context"
 	"sync"
 
@@ -24,8 +25,11 @@ import (
 const MaxUserAgentLength = 500
 
 // ensureAttribution ensures that the bucketName has the partner information specified by keyInfo partner ID or the header user agent.
+// When neither resolves to a partner, the configured default partner is attributed.
 // PartnerID from keyInfo is a value associated with registered user and prevails over header user agent.
 //
+// It returns ErrAttributionConflict, when the bucket is already attributed to a different partner or user agent.
+//
 // Assumes that the user has permissions sufficient for authenticating.
 func (endpoint *Endpoint) ensureAttribution(ctx context.Context, header *pb.RequestHeader, keyInfo *console.APIKeyInfo, bucketName []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -33,7 +37,7 @@ func (endpoint *Endpoint) ensureAttribution(ctx context.Context, header *pb.Requ
 	if header == nil {
 		return rpcstatus.Error(rpcstatus.InvalidArgument, "header is nil")
 	}
-	if len(header.UserAgent) == 0 && keyInfo.PartnerID.IsZero() && keyInfo.UserAgent == nil {
+	if len(header.UserAgent) == 0 && keyInfo.PartnerID.IsZero() && keyInfo.UserAgent == nil && endpoint.defaultPartnerID.IsZero() {
 		return nil
 	}
 
@@ -53,7 +57,7 @@ func (endpoint *Endpoint) ensureAttribution(ctx context.Context, header *pb.Requ
 	if partnerID.IsZero() && userAgent == nil {
 		// otherwise, use header (partner tool) as attribution
 		userAgent = header.UserAgent
-		if userAgent == nil {
+		if userAgent == nil && endpoint.defaultPartnerID.IsZero() {
 			return nil
 		}
 	}
@@ -63,13 +67,31 @@ func (endpoint *Endpoint) ensureAttribution(ctx context.Context, header *pb.Requ
 		return err
 	}
 
+	defaultPartner := partnerID.IsZero() && !endpoint.defaultPartnerID.IsZero() && !endpoint.hasPartner(ctx, userAgent)
+	if defaultPartner {
+		partnerID = endpoint.defaultPartnerID
+	}
+
 	err = endpoint.tryUpdateBucketAttribution(ctx, header, keyInfo.ProjectID, bucketName, partnerID, userAgent)
 	if errs2.IsRPC(err, rpcstatus.NotFound) || errs2.IsRPC(err, rpcstatus.AlreadyExists) {
 		return nil
 	}
+	if defaultPartner && ErrAttributionConflict.Has(err) {
+		// the default partner never replaces an existing attribution
+		return nil
+	}
 	return err
 }
 
+// hasPartner returns whether the user agent belongs to a known partner.
+func (endpoint *Endpoint) hasPartner(ctx context.Context, userAgent []byte) bool {
+	if len(userAgent) == 0 {
+		return false
+	}
+	_, err := endpoint.partners.ByUserAgent(ctx, string(userAgent))
+	return err == nil
+}
+
 // TrimUserAgent returns userAgentBytes that consist of only the product portion of the user agent, and is bounded by
 // the maxUserAgentLength.
 func TrimUserAgent(userAgent []byte) ([]byte, error) {
@@ -119,20 +141,20 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 	}
 
 	// check if attribution is set for given bucket
-	_, err = endpoint.attributions.Get(ctx, projectID, bucketName)
+	info, err := endpoint.attributions.Get(ctx, projectID, bucketName)
 	if err == nil {
 		// bucket has already an attribution, no need to update
-		return nil
+		return checkAttributionConflict(bucketName, info.PartnerID, info.UserAgent, partnerID, userAgent)
 	}
 	if !attribution.ErrBucketNotAttributed.Has(err) {
 		// try only to set the attribution, when it's missing
-		endpoint.log.Error("error while getting attribution from DB", zap.Error(err))
+		endpoint.logger(ctx).Error("error while getting attribution from DB", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
 	empty, err := endpoint.isBucketEmpty(ctx, projectID, bucketName)
 	if err != nil {
-		endpoint.log.Error("internal", zap.Error(err))
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
 	}
 	if !empty {
@@ -145,10 +167,13 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 		if storj.ErrBucketNotFound.Has(err) {
 			return rpcstatus.Errorf(rpcstatus.NotFound, "bucket %q does not exist", bucketName)
 		}
-		endpoint.log.Error("error while getting bucket", zap.ByteString("bucketName", bucketName), zap.Error(err))
+		endpoint.logger(ctx).Error("error while getting bucket", zap.ByteString("bucketName", bucketName), zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, "unable to set bucket attribution")
 	}
 	if !bucket.PartnerID.IsZero() || bucket.UserAgent != nil {
+		if err := checkAttributionConflict(bucketName, bucket.PartnerID, bucket.UserAgent, partnerID, userAgent); err != nil {
+			return err
+		}
 		return rpcstatus.Errorf(rpcstatus.AlreadyExists, "bucket %q already has attribution, PartnerID %q cannot be attributed", bucketName, partnerID)
 	}
 
@@ -157,7 +182,7 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 	bucket.UserAgent = userAgent
 	_, err = endpoint.buckets.UpdateBucket(ctx, bucket)
 	if err != nil {
-		endpoint.log.Error("error while updating bucket", zap.ByteString("bucketName", bucketName), zap.Error(err))
+		endpoint.logger(ctx).Error("error while updating bucket", zap.ByteString("bucketName", bucketName), zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, "unable to set bucket attribution")
 	}
 
@@ -169,13 +194,22 @@ func (endpoint *Endpoint) tryUpdateBucketAttribution(ctx context.Context, header
 		UserAgent:  userAgent,
 	})
 	if err != nil {
-		endpoint.log.Error("error while inserting attribution to DB", zap.Error(err))
+		endpoint.logger(ctx).Error("error while inserting attribution to DB", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
 	return nil
 }
 
+// checkAttributionConflict returns ErrAttributionConflict, when the existing attribution of the bucket
+// differs from the requested one.
+func checkAttributionConflict(bucketName []byte, existingPartnerID uuid.UUID, existingUserAgent []byte, partnerID uuid.UUID, userAgent []byte) error {
+	if existingPartnerID == partnerID && bytes.Equal(existingUserAgent, userAgent) {
+		return nil
+	}
+	return ErrAttributionConflict.New("bucket %q is attributed to %q, %q cannot be attributed", bucketName, existingUserAgent, userAgent)
+}
+
 // maxAttributionCacheSize determines how many buckets attributionCheckCache remembers.
 const maxAttributionCacheSize = 10
 
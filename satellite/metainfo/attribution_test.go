@@ -5,6 +5,7 @@ package metainfo_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strconv"
@@ -13,14 +14,21 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"storj.io/common/errs2"
 	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/rewards"
 	"storj.io/uplink"
 )
 
@@ -370,3 +378,124 @@ func TestBucketAttributionConcurrentUpload(t *testing.T) {
 		require.Equal(t, []byte(config.UserAgent), attributionInfo.UserAgent)
 	})
 }
+
+func TestDeleteBucketKeepsAttribution(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		_, err := satellite.DB.Attribution().Insert(ctx, &attribution.Info{
+			ProjectID:  projectID,
+			BucketName: []byte("bucket"),
+			UserAgent:  []byte("Zenko"),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+		require.NoError(t, planet.Uplinks[0].DeleteBucket(ctx, satellite, "bucket"))
+
+		// the attribution is kept for billing, also when the bucket is created again
+		info, err := satellite.DB.Attribution().Get(ctx, projectID, []byte("bucket"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Zenko"), info.UserAgent)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket"))
+
+		info, err = satellite.DB.Attribution().Get(ctx, projectID, []byte("bucket"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("Zenko"), info.UserAgent)
+	})
+}
+
+func TestCreateExistingBucketAttribution(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		createBucket := func(userAgent string) error {
+			_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw(), UserAgent: []byte(userAgent)},
+				Name:   []byte("bucket"),
+			})
+			return err
+		}
+
+		require.NoError(t, createBucket("Zenko"))
+
+		t.Run("matching attribution", func(t *testing.T) {
+			err := createBucket("Zenko")
+			require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists), err)
+		})
+
+		t.Run("conflicting attribution", func(t *testing.T) {
+			err := createBucket("Minio")
+			require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition), err)
+
+			info, err := planet.Satellites[0].DB.Attribution().Get(ctx, planet.Uplinks[0].Projects[0].ID, []byte("bucket"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("Zenko"), info.UserAgent)
+		})
+	})
+}
+
+func TestDefaultPartnerAttribution(t *testing.T) {
+	type expected struct {
+		attributed bool
+		partnerID  uuid.UUID
+		userAgent  []byte
+	}
+
+	check := func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, userAgent string, expected expected) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		bucketName := []byte(testrand.BucketName())
+
+		_, err := sat.API.Metainfo.Endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+			Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw(), UserAgent: []byte(userAgent)},
+			Name:   bucketName,
+		})
+		require.NoError(t, err)
+
+		info, err := sat.DB.Attribution().Get(ctx, planet.Uplinks[0].Projects[0].ID, bucketName)
+		if !expected.attributed {
+			require.True(t, attribution.ErrBucketNotAttributed.Has(err), err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, expected.partnerID, info.PartnerID)
+		require.Equal(t, expected.userAgent, info.UserAgent)
+	}
+
+	defaultPartner, err := rewards.DefaultPartnersDB.ByName(context.Background(), "Blocknify")
+	require.NoError(t, err)
+
+	t.Run("configured default", func(t *testing.T) {
+		testplanet.Run(t, testplanet.Config{
+			SatelliteCount: 1, UplinkCount: 1,
+			Reconfigure: testplanet.Reconfigure{
+				Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Metainfo.DefaultPartner = defaultPartner.Name
+				},
+			},
+		}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			// a known partner takes precedence over the default
+			check(t, ctx, planet, "Zenko/1.0", expected{attributed: true, userAgent: []byte("Zenko/1.0")})
+			check(t, ctx, planet, "unknown-tool/1.0", expected{attributed: true, partnerID: defaultPartner.UUID, userAgent: []byte("unknown-tool/1.0")})
+			check(t, ctx, planet, "", expected{attributed: true, partnerID: defaultPartner.UUID})
+		})
+	})
+
+	t.Run("no default", func(t *testing.T) {
+		testplanet.Run(t, testplanet.Config{
+			SatelliteCount: 1, UplinkCount: 1,
+		}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			check(t, ctx, planet, "Zenko/1.0", expected{attributed: true, userAgent: []byte("Zenko/1.0")})
+			check(t, ctx, planet, "unknown-tool/1.0", expected{attributed: true, userAgent: []byte("unknown-tool/1.0")})
+			check(t, ctx, planet, "", expected{})
+		})
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+)
+
+// bucketAuditDB stores the audit records of bucket mutations.
+type bucketAuditDB interface {
+	// InsertAuditRecord stores the audit record of a bucket mutation.
+	InsertAuditRecord(ctx context.Context, record buckets.AuditRecord) (err error)
+}
+
+// bucketAuditLog stores the audit records of bucket mutations in the background,
+// so the requests never wait for it. Records are dropped when the queue is full.
+type bucketAuditLog struct {
+	log     *zap.Logger
+	db      bucketAuditDB
+	records chan buckets.AuditRecord
+}
+
+// newBucketAuditLog returns an audit log, which queues at most queueSize records.
+func newBucketAuditLog(log *zap.Logger, db bucketAuditDB, queueSize int) *bucketAuditLog {
+	return &bucketAuditLog{
+		log:     log,
+		db:      db,
+		records: make(chan buckets.AuditRecord, queueSize),
+	}
+}
+
+// Enqueue queues the record without blocking. It returns false, when the queue is full.
+func (audit *bucketAuditLog) Enqueue(record buckets.AuditRecord) bool {
+	select {
+	case audit.records <- record:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run stores the queued records until the context is canceled.
+func (audit *bucketAuditLog) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case record := <-audit.records:
+			if err := audit.db.InsertAuditRecord(ctx, record); err != nil {
+				audit.log.Warn("unable to store bucket audit record",
+					zap.Stringer("Project ID", record.ProjectID),
+					zap.ByteString("bucketName", record.BucketName),
+					zap.String("action", record.Action),
+					zap.Error(err))
+				mon.Meter("bucket_audit_record_failed").Mark(1)
+				continue
+			}
+			mon.Meter("bucket_audit_record_stored").Mark(1)
+		}
+	}
+}
+
+// auditBucket records the bucket mutation made with the API key in the audit log.
+// It never blocks and failures don't fail the request.
+func (endpoint *Endpoint) auditBucket(keyInfo *console.APIKeyInfo, bucketName []byte, action string) {
+	if !endpoint.config.BucketAudit.Enabled {
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		endpoint.log.Warn("unable to create bucket audit record id", zap.Error(err))
+		return
+	}
+
+	ok := endpoint.bucketAudit.Enqueue(buckets.AuditRecord{
+		ID:         id,
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: append([]byte(nil), bucketName...),
+		Action:     action,
+		APIKeyID:   keyInfo.ID,
+		APIKeyHead: keyInfo.Head,
+		CreatedAt:  endpoint.clock(),
+	})
+	if !ok {
+		endpoint.log.Warn("bucket audit queue is full, dropping record",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.ByteString("bucketName", bucketName),
+			zap.String("action", action))
+		mon.Meter("bucket_audit_record_dropped").Mark(1)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/buckets"
+)
+
+type bucketAuditDBFunc func(ctx context.Context, record buckets.AuditRecord) error
+
+func (fn bucketAuditDBFunc) InsertAuditRecord(ctx context.Context, record buckets.AuditRecord) error {
+	return fn(ctx, record)
+}
+
+func TestBucketAuditLog(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var mu sync.Mutex
+	var stored []buckets.AuditRecord
+	fail := true
+	audit := newBucketAuditLog(zaptest.NewLogger(t), bucketAuditDBFunc(func(ctx context.Context, record buckets.AuditRecord) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			fail = false
+			return errors.New("failure")
+		}
+		stored = append(stored, record)
+		return nil
+	}), 2)
+
+	records := []buckets.AuditRecord{
+		{ID: testrand.UUID(), Action: buckets.AuditActionCreate},
+		{ID: testrand.UUID(), Action: buckets.AuditActionCreate},
+		{ID: testrand.UUID(), Action: buckets.AuditActionDelete},
+	}
+
+	// the queue doesn't block, when it's full
+	require.True(t, audit.Enqueue(records[0]))
+	require.True(t, audit.Enqueue(records[1]))
+	require.False(t, audit.Enqueue(records[2]))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error {
+		_ = audit.Run(runCtx)
+		return nil
+	})
+	defer cancel()
+
+	// a failed record is dropped, the following ones are still stored
+	require.Eventually(t, func() bool {
+		return len(audit.records) == 0
+	}, testcontext.DefaultTimeout, time.Millisecond)
+	require.True(t, audit.Enqueue(records[2]))
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(stored) == 2
+	}, testcontext.DefaultTimeout, time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, records[1:], stored)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+)
+
+// bucketCreationLimiter limits how many buckets a project can create within a window,
+// with a token bucket per project, which holds at most limit tokens and refills
+// limit tokens per window.
+type bucketCreationLimiter struct {
+	limit  int
+	window time.Duration
+	// limiters is nil when the limit is disabled.
+	limiters *lrucache.ExpiringLRU
+}
+
+// newBucketCreationLimiter returns a limiter for the configuration. Zero limit disables it.
+func newBucketCreationLimiter(config BucketCreationLimitConfig) *bucketCreationLimiter {
+	limiter := &bucketCreationLimiter{
+		limit:  config.Limit,
+		window: config.Window,
+	}
+	if config.Limit > 0 && config.Window > 0 {
+		limiter.limiters = lrucache.New(lrucache.Options{
+			Capacity: config.CacheCapacity,
+			// the token bucket of a project is full again after a window
+			Expiration: config.Window,
+		})
+	}
+	return limiter
+}
+
+// Allow takes a token of the project at now. When there is none left, it returns
+// false and how long to wait until the project can create a bucket again.
+func (limiter *bucketCreationLimiter) Allow(projectID uuid.UUID, now time.Time) (retryAfter time.Duration, ok bool) {
+	if limiter.limiters == nil {
+		return 0, true
+	}
+
+	projectLimiter, err := limiter.limiters.Get(projectID.String(), func() (interface{}, error) {
+		return rate.NewLimiter(rate.Every(limiter.window/time.Duration(limiter.limit)), limiter.limit), nil
+	})
+	if err != nil {
+		// the constructor never fails
+		return 0, true
+	}
+
+	reservation := projectLimiter.(*rate.Limiter).ReserveN(now, 1)
+	if !reservation.OK() {
+		return limiter.window, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+)
+
+func TestBucketCreationLimiter(t *testing.T) {
+	projectA, projectB := testrand.UUID(), testrand.UUID()
+	now := time.Now()
+
+	t.Run("disabled", func(t *testing.T) {
+		limiter := newBucketCreationLimiter(BucketCreationLimitConfig{Window: time.Minute, CacheCapacity: 10})
+		for i := 0; i < 100; i++ {
+			_, ok := limiter.Allow(projectA, now)
+			require.True(t, ok)
+		}
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		limiter := newBucketCreationLimiter(BucketCreationLimitConfig{Limit: 3, Window: time.Minute, CacheCapacity: 10})
+		for i := 0; i < 3; i++ {
+			_, ok := limiter.Allow(projectA, now)
+			require.True(t, ok)
+		}
+
+		retryAfter, ok := limiter.Allow(projectA, now)
+		require.False(t, ok)
+		require.Equal(t, 20*time.Second, retryAfter)
+
+		// the throttled attempt doesn't take a token
+		retryAfter, ok = limiter.Allow(projectA, now.Add(10*time.Second))
+		require.False(t, ok)
+		require.Equal(t, 10*time.Second, retryAfter)
+
+		// the other projects aren't affected
+		_, ok = limiter.Allow(projectB, now)
+		require.True(t, ok)
+
+		_, ok = limiter.Allow(projectA, now.Add(20*time.Second))
+		require.True(t, ok)
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// errDeleteDeadline is returned when deleting bucket objects stopped because the
+// request deadline is within the configured safety margin.
+var errDeleteDeadline = errors.New("request deadline is too close to continue deleting objects")
+
+// BucketDeleteIncompleteError is the cause of the DeadlineExceeded error returned when
+// deleting the objects of a bucket stopped before the request deadline. Repeating the
+// request resumes the deletion.
+type BucketDeleteIncompleteError struct {
+	DeletedObjectsCount int64
+}
+
+// Error implements the error interface.
+func (err *BucketDeleteIncompleteError) Error() string {
+	return fmt.Sprintf("bucket deletion stopped before the request deadline after deleting %d objects, retry to resume", err.DeletedObjectsCount)
+}
+
+// BucketPiecesNotDeletedError is the cause of the Aborted error returned when deleting
+// the objects of a bucket stopped, because the pieces of more segments than tolerated
+// failed to be deleted from the storage nodes. Repeating the request resumes the deletion.
+type BucketPiecesNotDeletedError struct {
+	DeletedObjectsCount int64
+	FailedSegments      int64
+	MaxFailures         int64
+}
+
+// Error implements the error interface.
+func (err *BucketPiecesNotDeletedError) Error() string {
+	return fmt.Sprintf("bucket deletion stopped after deleting %d objects, because the pieces of %d segments failed to be deleted (at most %d tolerated), retry to resume",
+		err.DeletedObjectsCount, err.FailedSegments, err.MaxFailures)
+}
+
+// errTooManyPieceDeletionFailures is returned when the pieces of more segments than
+// tolerated failed to be deleted.
+var errTooManyPieceDeletionFailures = errors.New("too many segments failed piece deletion")
+
+// bucketObjectsDeleter deletes the objects of a bucket in batches.
+type bucketObjectsDeleter interface {
+	DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (deletedObjectCount int64, err error)
+}
+
+// deleteBucketObjectsWithDeadline deletes the objects of a bucket, stopping with errDeleteDeadline
+// between batches once the context deadline is closer than margin. Pieces of objects deleted after
+// that point aren't deleted, they are left for garbage collection.
+func deleteBucketObjectsWithDeadline(ctx context.Context, deleter bucketObjectsDeleter, opts metabase.DeleteBucketObjects, margin time.Duration) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deadline, ok := ctx.Deadline()
+	if !ok || margin <= 0 {
+		deleted, err := deleter.DeleteBucketObjects(ctx, opts)
+		return deleted, Error.Wrap(err)
+	}
+
+	nearDeadline := func() bool {
+		return time.Until(deadline) < margin
+	}
+	if nearDeadline() {
+		return 0, errDeleteDeadline
+	}
+
+	deletePieces, progress := opts.DeletePieces, opts.Progress
+	opts.DeletePieces = func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+		if nearDeadline() {
+			return errDeleteDeadline
+		}
+		if deletePieces == nil {
+			return nil
+		}
+		return deletePieces(ctx, segments)
+	}
+	opts.Progress = func(ctx context.Context, deletedObjectCount int64) error {
+		if progress != nil {
+			if err := progress(ctx, deletedObjectCount); err != nil {
+				return err
+			}
+		}
+		if nearDeadline() {
+			return errDeleteDeadline
+		}
+		return nil
+	}
+
+	deleted, err := deleter.DeleteBucketObjects(ctx, opts)
+	if errors.Is(err, errDeleteDeadline) {
+		return deleted, errDeleteDeadline
+	}
+	return deleted, Error.Wrap(err)
+}
+
+// concurrentPiecesDeleter is a bucketObjectsDeleter, which calls DeletePieces for up
+// to concurrency batches at once, instead of waiting for the pieces of every batch
+// to be deleted before deleting the next batch from the database.
+type concurrentPiecesDeleter struct {
+	deleter     bucketObjectsDeleter
+	concurrency int
+}
+
+// DeleteBucketObjects deletes the objects of a bucket, calling DeletePieces concurrently.
+// The first error returned by DeletePieces stops the deletion and is returned. It waits
+// for all the started DeletePieces calls, before returning.
+func (deleter concurrentPiecesDeleter) DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if deleter.concurrency <= 1 || opts.DeletePieces == nil {
+		return deleter.deleter.DeleteBucketObjects(ctx, opts)
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	limiter := make(chan struct{}, deleter.concurrency)
+
+	deletePieces := opts.DeletePieces
+	opts.DeletePieces = func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+		select {
+		case limiter <- struct{}{}:
+		case <-groupCtx.Done():
+			if err := group.Wait(); err != nil {
+				return err
+			}
+			return groupCtx.Err()
+		}
+
+		// segments are reused between the calls
+		segments = append([]metabase.DeletedSegmentInfo(nil), segments...)
+		group.Go(func() error {
+			defer func() { <-limiter }()
+			return deletePieces(groupCtx, segments)
+		})
+		return nil
+	}
+
+	deleted, err = deleter.deleter.DeleteBucketObjects(ctx, opts)
+	if waitErr := group.Wait(); waitErr != nil {
+		return deleted, waitErr
+	}
+	return deleted, err
+}
@@ -0,0 +1,328 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
+)
+
+// batchingDeleter imitates metabase.DB.DeleteBucketObjects, deleting batchSize objects per batch.
+type batchingDeleter struct {
+	objects    int64
+	batchSize  int64
+	batchDelay time.Duration
+}
+
+func (deleter *batchingDeleter) DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (deleted int64, err error) {
+	for deleted < deleter.objects {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+		time.Sleep(deleter.batchDelay)
+		deleted += deleter.batchSize
+
+		if opts.DeletePieces != nil {
+			if err := opts.DeletePieces(ctx, []metabase.DeletedSegmentInfo{{}}); err != nil {
+				return deleted, err
+			}
+		}
+		if opts.Progress != nil {
+			if err := opts.Progress(ctx, deleted); err != nil {
+				return deleted, err
+			}
+		}
+	}
+	return deleted, nil
+}
+
+func TestDeleteBucketObjectsWithDeadline(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	deleter := &batchingDeleter{objects: 100000, batchSize: 10, batchDelay: 5 * time.Millisecond}
+	const margin = 100 * time.Millisecond
+
+	t.Run("stops before deadline", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+		defer cancel()
+		deadline, _ := timeoutCtx.Deadline()
+
+		var piecesCalls, progressCalls int
+		var lastProgress int64
+		deleted, err := deleteBucketObjectsWithDeadline(timeoutCtx, deleter, metabase.DeleteBucketObjects{
+			DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+				require.GreaterOrEqual(t, time.Until(deadline), margin, "pieces deleted within the margin")
+				piecesCalls++
+				return nil
+			},
+			Progress: func(ctx context.Context, deletedObjectCount int64) error {
+				progressCalls++
+				lastProgress = deletedObjectCount
+				return nil
+			},
+		}, margin)
+		require.True(t, errors.Is(err, errDeleteDeadline))
+		require.NoError(t, timeoutCtx.Err(), "deletion should stop before the deadline")
+
+		require.Positive(t, deleted)
+		require.Less(t, deleted, deleter.objects)
+		require.Positive(t, piecesCalls)
+		// the last batch may be removed from the database without deleting its pieces
+		require.GreaterOrEqual(t, deleted, lastProgress)
+		require.LessOrEqual(t, deleted-lastProgress, deleter.batchSize)
+		require.Equal(t, piecesCalls, progressCalls)
+	})
+
+	t.Run("already within margin", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, margin/2)
+		defer cancel()
+
+		deleted, err := deleteBucketObjectsWithDeadline(timeoutCtx, deleter, metabase.DeleteBucketObjects{
+			DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+				t.Fatal("pieces deleted within the margin")
+				return nil
+			},
+		}, margin)
+		require.True(t, errors.Is(err, errDeleteDeadline))
+		require.Zero(t, deleted)
+	})
+
+	t.Run("no deadline", func(t *testing.T) {
+		small := &batchingDeleter{objects: 100, batchSize: 10}
+
+		deleted, err := deleteBucketObjectsWithDeadline(ctx, small, metabase.DeleteBucketObjects{}, margin)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, deleted)
+	})
+}
+
+func TestConcurrentPiecesDeleter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Run("deletes all pieces", func(t *testing.T) {
+		deleter := concurrentPiecesDeleter{
+			deleter:     &batchingDeleter{objects: 1000, batchSize: 10},
+			concurrency: 4,
+		}
+
+		var calls, running, maxRunning int64
+		deleted, err := deleter.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+				current := atomic.AddInt64(&running, 1)
+				defer atomic.AddInt64(&running, -1)
+				for {
+					max := atomic.LoadInt64(&maxRunning)
+					if current <= max || atomic.CompareAndSwapInt64(&maxRunning, max, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+				atomic.AddInt64(&calls, 1)
+				return nil
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, deleted)
+		require.EqualValues(t, 100, atomic.LoadInt64(&calls))
+		require.LessOrEqual(t, atomic.LoadInt64(&maxRunning), int64(4))
+	})
+
+	t.Run("worker error aborts", func(t *testing.T) {
+		inner := &batchingDeleter{objects: 100000, batchSize: 10}
+		deleter := concurrentPiecesDeleter{deleter: inner, concurrency: 4}
+
+		errFailed := errors.New("failed to delete pieces")
+		var calls, progress int64
+		deleted, err := deleter.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+			DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+				if atomic.AddInt64(&calls, 1) == 10 {
+					return errFailed
+				}
+				time.Sleep(time.Millisecond)
+				return nil
+			},
+			Progress: func(ctx context.Context, deletedObjectCount int64) error {
+				atomic.StoreInt64(&progress, deletedObjectCount)
+				return nil
+			},
+		})
+		require.True(t, errors.Is(err, errFailed))
+		require.Less(t, deleted, inner.objects)
+		// the deleted count includes the batch removed from the database,
+		// whose pieces weren't deleted anymore because of the failure
+		require.EqualValues(t, (atomic.LoadInt64(&calls)+1)*inner.batchSize, deleted)
+		require.GreaterOrEqual(t, deleted, atomic.LoadInt64(&progress))
+	})
+
+	t.Run("deadline error aborts", func(t *testing.T) {
+		inner := &batchingDeleter{objects: 100000, batchSize: 10, batchDelay: time.Millisecond}
+		deleter := concurrentPiecesDeleter{deleter: inner, concurrency: 4}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+		defer cancel()
+
+		deleted, err := deleteBucketObjectsWithDeadline(timeoutCtx, deleter, metabase.DeleteBucketObjects{
+			DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+				time.Sleep(time.Millisecond)
+				return nil
+			},
+		}, 100*time.Millisecond)
+		require.True(t, errors.Is(err, errDeleteDeadline))
+		require.NoError(t, timeoutCtx.Err(), "deletion should stop before the deadline")
+		require.Positive(t, deleted)
+		require.Less(t, deleted, inner.objects)
+	})
+}
+
+func BenchmarkConcurrentPiecesDeleter(b *testing.B) {
+	ctx := context.Background()
+
+	for _, concurrency := range []int{1, 4, 16} {
+		concurrency := concurrency
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				deleter := concurrentPiecesDeleter{
+					deleter:     &batchingDeleter{objects: 1000, batchSize: 10},
+					concurrency: concurrency,
+				}
+				_, err := deleter.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+					DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+						// imitates the round trip to the storage nodes
+						time.Sleep(100 * time.Microsecond)
+						return nil
+					},
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// segmentObjectStore deletes the objects of a bucket like fakeBucketObjectStore,
+// but first calls DeletePieces for each of the segments, like metabase.DB does.
+type segmentObjectStore struct {
+	*fakeBucketObjectStore
+	segments []metabase.DeletedSegmentInfo
+}
+
+func (store *segmentObjectStore) DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (int64, error) {
+	for _, segment := range store.segments {
+		if err := opts.DeletePieces(ctx, []metabase.DeletedSegmentInfo{segment}); err != nil {
+			return 0, err
+		}
+	}
+	return store.fakeBucketObjectStore.DeleteBucketObjects(ctx, opts)
+}
+
+// failingPiecesDeleter fails to delete the pieces stored on the failing nodes.
+type failingPiecesDeleter struct {
+	failing map[storj.NodeID]bool
+}
+
+func (deleter *failingPiecesDeleter) Delete(ctx context.Context, requests []piecedeletion.Request, successThreshold float64) error {
+	for _, request := range requests {
+		if deleter.failing[request.Node.ID] {
+			return errors.New("node is offline")
+		}
+	}
+	return nil
+}
+
+func TestDeleteBucketPieceDeletionModes(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	failing := map[storj.NodeID]bool{}
+	var segments []metabase.DeletedSegmentInfo
+	for i := 0; i < 10; i++ {
+		node := testrand.NodeID()
+		if i%3 == 0 {
+			failing[node] = true
+		}
+		segments = append(segments, metabase.DeletedSegmentInfo{
+			RootPieceID:   testrand.PieceID(),
+			Pieces:        metabase.Pieces{{Number: 0, StorageNode: node}},
+			EncryptedSize: 100,
+		})
+	}
+
+	deleteBucket := func(t *testing.T, config BucketPieceDeletionConfig) (freedBytes int64, err error) {
+		store := newFakeBucketStore()
+		objects := newFakeBucketObjectStore()
+		endpoint := &Endpoint{
+			log:           zaptest.NewLogger(t),
+			bucketStore:   store,
+			bucketObjects: &segmentObjectStore{fakeBucketObjectStore: objects, segments: segments},
+			deletePieces:  &failingPiecesDeleter{failing: failing},
+			config:        Config{BucketPieceDeletion: config},
+		}
+
+		_, err = store.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "bucket"}, uuid.UUID{}, buckets.CreateSettings{})
+		require.NoError(t, err)
+		objects.objects[metabase.BucketLocation{ProjectID: projectID, BucketName: "bucket"}] = int64(len(segments))
+
+		_, _, freedBytes, err = endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("bucket"), false, nil)
+		exists, existsErr := store.HasBucket(ctx, []byte("bucket"), projectID)
+		require.NoError(t, existsErr)
+		require.Equal(t, err != nil, exists)
+		return freedBytes, err
+	}
+
+	t.Run("best effort", func(t *testing.T) {
+		counter := mon.Counter("bucket_delete_failed_piece_deletions")
+		before := counter.Current()
+
+		freedBytes, err := deleteBucket(t, BucketPieceDeletionConfig{Mode: PieceDeletionBestEffort})
+		require.NoError(t, err)
+		// only the bytes of the deleted pieces are freed
+		require.EqualValues(t, 6*100, freedBytes)
+		require.EqualValues(t, len(failing), counter.Current()-before)
+	})
+
+	t.Run("strict within threshold", func(t *testing.T) {
+		_, err := deleteBucket(t, BucketPieceDeletionConfig{Mode: PieceDeletionStrict, MaxFailures: int64(len(failing))})
+		require.NoError(t, err)
+	})
+
+	t.Run("strict beyond threshold", func(t *testing.T) {
+		_, err := deleteBucket(t, BucketPieceDeletionConfig{Mode: PieceDeletionStrict, MaxFailures: 1})
+		require.True(t, errs2.IsRPC(err, rpcstatus.Aborted))
+
+		var piecesErr *BucketPiecesNotDeletedError
+		require.True(t, errors.As(err, &piecesErr))
+		require.EqualValues(t, 2, piecesErr.FailedSegments)
+		require.EqualValues(t, 1, piecesErr.MaxFailures)
+	})
+}
+
+func TestPieceDeletionMode(t *testing.T) {
+	for _, mode := range []PieceDeletionMode{PieceDeletionBestEffort, PieceDeletionStrict} {
+		var parsed PieceDeletionMode
+		require.NoError(t, parsed.Set(mode.String()))
+		require.Equal(t, mode, parsed)
+	}
+
+	var mode PieceDeletionMode
+	require.Error(t, mode.Set("lenient"))
+}
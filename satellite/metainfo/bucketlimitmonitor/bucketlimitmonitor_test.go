@@ -0,0 +1,56 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlimitmonitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestBucketLimitMonitor(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ProjectLimits.MaxBuckets = 4
+				config.BucketLimitMonitor.Threshold = 0.5
+				config.BucketLimitMonitor.ListLimit = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.BucketLimitMonitor.Chore
+
+		chore.Loop.Pause()
+
+		nearLimit, err := chore.CountNearLimit(ctx)
+		require.NoError(t, err)
+		require.Zero(t, nearLimit)
+
+		// half of the default limit reaches the threshold
+		for _, name := range []string{"first", "second"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, name))
+		}
+
+		nearLimit, err = chore.CountNearLimit(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, nearLimit)
+
+		// a project specific limit takes precedence over the default
+		err = sat.DB.Console().Projects().UpdateBucketLimit(ctx, planet.Uplinks[1].Projects[0].ID, 2)
+		require.NoError(t, err)
+
+		require.NoError(t, planet.Uplinks[1].CreateBucket(ctx, sat, "only"))
+
+		nearLimit, err = chore.CountNearLimit(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, nearLimit)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlimitmonitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+var (
+	// Error defines the bucketlimitmonitor chore errors class.
+	Error = errs.Class("bucket limit monitor chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the bucket limit monitor.
+type Config struct {
+	Interval  time.Duration `help:"the time between each check of the projects bucket counts" releaseDefault:"1h" devDefault:"10s"`
+	Threshold float64       `help:"the ratio of the bucket limit from which a project is reported as near its limit" default:"0.9"`
+	ListLimit int           `help:"how many projects to query in a batch" default:"100"`
+}
+
+// Chore implements the bucket limit monitor chore.
+//
+// architecture: Chore
+type Chore struct {
+	log               *zap.Logger
+	config            Config
+	defaultMaxBuckets int
+	buckets           buckets.DB
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketlimitmonitor chore.
+func NewChore(log *zap.Logger, config Config, defaultMaxBuckets int, buckets buckets.DB) *Chore {
+	return &Chore{
+		log:               log,
+		config:            config,
+		defaultMaxBuckets: defaultMaxBuckets,
+		buckets:           buckets,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketlimitmonitor loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		nearLimit, err := chore.CountNearLimit(ctx)
+		if err != nil {
+			chore.log.Error("error counting projects near their bucket limit", zap.Error(err))
+			return nil
+		}
+		mon.IntVal("projects_near_bucket_limit").Observe(nearLimit)
+		return nil
+	})
+}
+
+// Close stops the bucketlimitmonitor chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// CountNearLimit returns the number of projects, whose bucket count is at or above
+// the configured threshold of their bucket limit.
+func (chore *Chore) CountNearLimit(ctx context.Context) (nearLimit int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cursor uuid.UUID
+	for {
+		counts, err := chore.buckets.ListProjectBucketCounts(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+
+		for _, count := range counts {
+			maxBuckets := chore.defaultMaxBuckets
+			if count.MaxBuckets != nil {
+				maxBuckets = *count.MaxBuckets
+			}
+			if maxBuckets <= 0 {
+				continue
+			}
+			if float64(count.Count) >= chore.config.Threshold*float64(maxBuckets) {
+				nearLimit++
+			}
+		}
+
+		if len(counts) < chore.config.ListLimit {
+			return nearLimit, nil
+		}
+		cursor = counts[len(counts)-1].ProjectID
+	}
+}
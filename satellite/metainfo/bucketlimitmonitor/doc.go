@@ -0,0 +1,11 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketlimitmonitor contains the functions needed to run the bucket limit monitor chore.
+
+The bucketlimitmonitor chore will periodically go through the projects in
+batches, compare their bucket counts to their bucket limits and report how
+many projects are close to their limit.
+*/
+package bucketlimitmonitor
@@ -0,0 +1,65 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+)
+
+// projectStorageUsage is the source of the project storage usage and limits,
+// which is implemented by accounting.Service.
+type projectStorageUsage interface {
+	// GetProjectStorageLimit returns the storage limit of the project.
+	GetProjectStorageLimit(ctx context.Context, projectID uuid.UUID) (memory.Size, error)
+	// GetProjectStorageTotals returns the storage currently used by the project.
+	GetProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, error)
+}
+
+// bucketLimitsDB is the source of the project bucket limits.
+type bucketLimitsDB interface {
+	// GetMaxBuckets returns the maximum number of buckets of the project, nil means the default limit.
+	GetMaxBuckets(ctx context.Context, projectID uuid.UUID) (*int, error)
+}
+
+// bucketLimitsCache caches project bucket limits, which rarely change,
+// to avoid querying them on every bucket creation.
+type bucketLimitsCache struct {
+	limits bucketLimitsDB
+	// cache is nil when caching is disabled.
+	cache *lrucache.ExpiringLRU
+}
+
+// newBucketLimitsCache returns a cache in front of limits. Zero expiration disables caching.
+func newBucketLimitsCache(limits bucketLimitsDB, capacity int, expiration time.Duration) *bucketLimitsCache {
+	cache := &bucketLimitsCache{limits: limits}
+	if expiration > 0 {
+		cache.cache = lrucache.New(lrucache.Options{
+			Capacity:   capacity,
+			Expiration: expiration,
+		})
+	}
+	return cache
+}
+
+// GetMaxBuckets returns the maximum number of buckets of the project, nil means the default limit.
+func (cache *bucketLimitsCache) GetMaxBuckets(ctx context.Context, projectID uuid.UUID) (_ *int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cache.cache == nil {
+		return cache.limits.GetMaxBuckets(ctx, projectID)
+	}
+
+	maxBuckets, err := cache.cache.Get(projectID.String(), func() (interface{}, error) {
+		return cache.limits.GetMaxBuckets(ctx, projectID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return maxBuckets.(*int), nil
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+type countingBucketLimits struct {
+	limits map[uuid.UUID]int
+	calls  int
+}
+
+func (limits *countingBucketLimits) GetMaxBuckets(ctx context.Context, projectID uuid.UUID) (*int, error) {
+	limits.calls++
+	if limit, ok := limits.limits[projectID]; ok {
+		return &limit, nil
+	}
+	return nil, nil
+}
+
+func TestBucketLimitsCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectA, projectB := testrand.UUID(), testrand.UUID()
+
+	t.Run("disabled", func(t *testing.T) {
+		limits := &countingBucketLimits{limits: map[uuid.UUID]int{projectA: 5}}
+		cache := newBucketLimitsCache(limits, 10, 0)
+
+		for i := 0; i < 3; i++ {
+			maxBuckets, err := cache.GetMaxBuckets(ctx, projectA)
+			require.NoError(t, err)
+			require.Equal(t, 5, *maxBuckets)
+		}
+		require.Equal(t, 3, limits.calls)
+	})
+
+	t.Run("within ttl", func(t *testing.T) {
+		limits := &countingBucketLimits{limits: map[uuid.UUID]int{projectA: 5}}
+		cache := newBucketLimitsCache(limits, 10, time.Hour)
+
+		for i := 0; i < 3; i++ {
+			maxBuckets, err := cache.GetMaxBuckets(ctx, projectA)
+			require.NoError(t, err)
+			require.Equal(t, 5, *maxBuckets)
+
+			maxBuckets, err = cache.GetMaxBuckets(ctx, projectB)
+			require.NoError(t, err)
+			require.Nil(t, maxBuckets)
+		}
+		require.Equal(t, 2, limits.calls)
+
+		// changes aren't visible until the entry expires
+		limits.limits[projectA] = 10
+		maxBuckets, err := cache.GetMaxBuckets(ctx, projectA)
+		require.NoError(t, err)
+		require.Equal(t, 5, *maxBuckets)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		limits := &countingBucketLimits{limits: map[uuid.UUID]int{projectA: 5}}
+		cache := newBucketLimitsCache(limits, 10, time.Millisecond)
+
+		_, err := cache.GetMaxBuckets(ctx, projectA)
+		require.NoError(t, err)
+
+		limits.limits[projectA] = 10
+		time.Sleep(5 * time.Millisecond)
+
+		maxBuckets, err := cache.GetMaxBuckets(ctx, projectA)
+		require.NoError(t, err)
+		require.Equal(t, 10, *maxBuckets)
+		require.Equal(t, 2, limits.calls)
+	})
+}
+
+func TestProjectMaxBuckets(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectA, projectB := testrand.UUID(), testrand.UUID()
+
+	config := Config{}
+	config.ProjectLimits.MaxBuckets = 100
+	endpoint := &Endpoint{
+		bucketLimits: newBucketLimitsCache(&countingBucketLimits{limits: map[uuid.UUID]int{projectA: 3}}, 10, 0),
+		config:       config,
+	}
+
+	maxBuckets, err := endpoint.projectMaxBuckets(ctx, projectA)
+	require.NoError(t, err)
+	require.Equal(t, 3, maxBuckets)
+
+	maxBuckets, err = endpoint.projectMaxBuckets(ctx, projectB)
+	require.NoError(t, err)
+	require.Equal(t, 100, maxBuckets)
+}
+
+type failingBucketLimits struct {
+	err error
+}
+
+func (limits *failingBucketLimits) GetMaxBuckets(ctx context.Context, projectID uuid.UUID) (*int, error) {
+	return nil, limits.err
+}
+
+type failingCountBuckets struct {
+	buckets.DB
+	err error
+}
+
+func (db *failingCountBuckets) CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error) {
+	return 0, db.err
+}
+
+func TestCheckBucketLimitFailures(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	sensitive := errs.New("dial tcp db.internal:5432: password authentication failed for user \"satellite\"")
+
+	for _, tt := range []struct {
+		name     string
+		limits   bucketLimitsDB
+		db       bucketStore
+		expected string
+	}{
+		{
+			name:     "max buckets",
+			limits:   &failingBucketLimits{err: sensitive},
+			expected: "unable to get project bucket limit",
+		},
+		{
+			name:     "count buckets",
+			limits:   &countingBucketLimits{},
+			db:       &failingCountBuckets{err: sensitive},
+			expected: "unable to count project buckets",
+		},
+	} {
+		core, logs := observer.New(zap.ErrorLevel)
+		endpoint := &Endpoint{
+			log:          zap.New(core),
+			bucketLimits: newBucketLimitsCache(tt.limits, 10, 0),
+			bucketStore:  tt.db,
+		}
+
+		err := endpoint.checkBucketLimit(ctx, projectID)
+		require.Equal(t, rpcstatus.Internal, rpcstatus.Code(err), tt.name)
+		require.NotContains(t, err.Error(), "db.internal", tt.name)
+		require.NotContains(t, err.Error(), "password", tt.name)
+
+		entries := logs.FilterMessage(tt.expected).All()
+		require.Len(t, entries, 1, tt.name)
+		require.Equal(t, projectID.String(), entries[0].ContextMap()["Project ID"], tt.name)
+	}
+}
+
+type staticCountBuckets struct {
+	buckets.DB
+	count int
+}
+
+func (db *staticCountBuckets) CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error) {
+	return db.count, nil
+}
+
+type staticStorageUsage struct {
+	limit memory.Size
+	used  int64
+	calls int
+}
+
+func (usage *staticStorageUsage) GetProjectStorageLimit(ctx context.Context, projectID uuid.UUID) (memory.Size, error) {
+	usage.calls++
+	return usage.limit, nil
+}
+
+func (usage *staticStorageUsage) GetProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	return usage.used, nil
+}
+
+func TestCheckBucketStorageLimit(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+
+	newEndpoint := func(bucketCount int, usage *staticStorageUsage, enabled bool) *Endpoint {
+		config := Config{}
+		config.ProjectLimits.MaxBuckets = 10
+		config.ProjectLimits.CreateBucketStorageCheck = enabled
+		return &Endpoint{
+			log:          zaptest.NewLogger(t),
+			bucketLimits: newBucketLimitsCache(&countingBucketLimits{}, 10, 0),
+			bucketStore:  &staticCountBuckets{count: bucketCount},
+			storageUsage: usage,
+			config:       config,
+		}
+	}
+
+	t.Run("under limit", func(t *testing.T) {
+		endpoint := newEndpoint(1, &staticStorageUsage{limit: memory.GB, used: memory.MB.Int64()}, true)
+		require.NoError(t, endpoint.checkBucketLimit(ctx, projectID))
+	})
+
+	t.Run("over limit", func(t *testing.T) {
+		endpoint := newEndpoint(1, &staticStorageUsage{limit: memory.GB, used: memory.GB.Int64()}, true)
+		err := endpoint.checkBucketLimit(ctx, projectID)
+		require.Equal(t, rpcstatus.ResourceExhausted, rpcstatus.Code(err))
+		require.Contains(t, err.Error(), "storage limit")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		endpoint := newEndpoint(1, &staticStorageUsage{limit: memory.GB, used: memory.GB.Int64()}, false)
+		require.NoError(t, endpoint.checkBucketLimit(ctx, projectID))
+	})
+
+	t.Run("bucket count checked first", func(t *testing.T) {
+		usage := &staticStorageUsage{limit: memory.GB, used: memory.GB.Int64()}
+		endpoint := newEndpoint(10, usage, true)
+		err := endpoint.checkBucketLimit(ctx, projectID)
+		require.Equal(t, rpcstatus.ResourceExhausted, rpcstatus.Code(err))
+		require.Contains(t, err.Error(), "number of allocated buckets")
+		require.Zero(t, usage.calls)
+	})
+}
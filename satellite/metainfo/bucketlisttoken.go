@@ -0,0 +1,74 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"storj.io/common/signing"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+// bucketListToken is the position, where a bucket listing continues. It's returned
+// to the clients signed, so they can't depend on or tamper with the cursor format.
+type bucketListToken struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Prefix    []byte    `json:"prefix,omitempty"`
+
+	OrderBy         buckets.BucketOrder `json:"order_by"`
+	Cursor          []byte              `json:"cursor"`
+	CursorCreatedAt time.Time           `json:"cursor_created_at"`
+
+	ModifiedSince time.Time `json:"modified_since"`
+
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+// signedBucketListToken is the encoded form of bucketListToken.
+type signedBucketListToken struct {
+	Token     []byte `json:"token"`
+	Signature []byte `json:"signature"`
+}
+
+// encodeBucketListToken encodes and signs the token.
+func encodeBucketListToken(ctx context.Context, signer signing.Signer, token bucketListToken) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	signature, err := signer.SignHMACSHA256(ctx, data)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	encoded, err := json.Marshal(signedBucketListToken{
+		Token:     data,
+		Signature: signature,
+	})
+	return encoded, Error.Wrap(err)
+}
+
+// decodeBucketListToken verifies the signature of the token and decodes it.
+func decodeBucketListToken(ctx context.Context, signer signing.Signer, encoded []byte) (_ bucketListToken, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var signed signedBucketListToken
+	if err := json.Unmarshal(encoded, &signed); err != nil {
+		return bucketListToken{}, ErrInvalidContinuationToken.New("malformed")
+	}
+	if err := signer.VerifyHMACSHA256(ctx, signed.Token, signed.Signature); err != nil {
+		return bucketListToken{}, ErrInvalidContinuationToken.New("invalid signature")
+	}
+
+	var token bucketListToken
+	if err := json.Unmarshal(signed.Token, &token); err != nil {
+		return bucketListToken{}, ErrInvalidContinuationToken.New("malformed")
+	}
+	return token, nil
+}
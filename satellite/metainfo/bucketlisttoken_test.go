@@ -0,0 +1,65 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestBucketListToken(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	signer := signing.SignerFromFullIdentity(testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion()))
+	other := signing.SignerFromFullIdentity(testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()))
+
+	token := bucketListToken{
+		ProjectID:       testrand.UUID(),
+		Prefix:          []byte("bucket-"),
+		OrderBy:         buckets.OrderByCreatedAt,
+		Cursor:          []byte("bucket-b"),
+		CursorCreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		ModifiedSince:   time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond),
+		IssuedAt:        time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	encoded, err := encodeBucketListToken(ctx, signer, token)
+	require.NoError(t, err)
+
+	decoded, err := decodeBucketListToken(ctx, signer, encoded)
+	require.NoError(t, err)
+	require.Equal(t, token, decoded)
+
+	// a token of another satellite
+	_, err = decodeBucketListToken(ctx, other, encoded)
+	require.True(t, ErrInvalidContinuationToken.Has(err))
+
+	// a tampered cursor
+	var signed signedBucketListToken
+	require.NoError(t, json.Unmarshal(encoded, &signed))
+	var tampered bucketListToken
+	require.NoError(t, json.Unmarshal(signed.Token, &tampered))
+	tampered.Cursor = []byte("bucket-z")
+	signed.Token, err = json.Marshal(tampered)
+	require.NoError(t, err)
+	tamperedEncoded, err := json.Marshal(signed)
+	require.NoError(t, err)
+
+	_, err = decodeBucketListToken(ctx, signer, tamperedEncoded)
+	require.True(t, ErrInvalidContinuationToken.Has(err))
+
+	// garbage
+	_, err = decodeBucketListToken(ctx, signer, []byte("bucket-b"))
+	require.True(t, ErrInvalidContinuationToken.Has(err))
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/buckets"
+)
+
+// BenchmarkBucketResponse compares building the response of GetBucketMetadata
+// with building the one of GetBucketInfo, once the bucket has been read. GetBucketInfo
+// additionally reads the redundancy scheme of the project, which isn't measured here.
+func BenchmarkBucketResponse(b *testing.B) {
+	bucket := buckets.Bucket{
+		Name:      []byte("bucket"),
+		CreatedAt: time.Now(),
+		Placement: storj.EU,
+		DefaultEncryptionParameters: storj.EncryptionParameters{
+			CipherSuite: storj.EncAESGCM,
+			BlockSize:   29 * 256 * memory.B.Int32(),
+		},
+	}
+	rs := &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_RS,
+		MinReq:           29,
+		RepairThreshold:  35,
+		SuccessThreshold: 80,
+		Total:            110,
+		ErasureShareSize: 256,
+	}
+	regions := PlacementRegions{storj.EU: "eu-central-1"}
+
+	b.Run("GetBucketMetadata", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = convertBucketToMetadata(bucket, regions)
+		}
+	})
+
+	b.Run("GetBucketInfo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := convertBucketToProto(bucket, rs, 64*memory.MiB); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
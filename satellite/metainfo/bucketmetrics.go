@@ -0,0 +1,69 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+)
+
+// Bucket operations and their phases used as metric tags.
+const (
+	bucketOpCreate = "create"
+	bucketOpDelete = "delete"
+	bucketOpList   = "list"
+
+	bucketPhaseAuth            = "auth"
+	bucketPhaseLimitCheck      = "limit_check"
+	bucketPhaseDB              = "db"
+	bucketPhaseProtoConversion = "proto_conversion"
+)
+
+// bucketOutcome maps an error to a low-cardinality outcome tag.
+func bucketOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case storj.ErrBucketNotFound.Has(err) || rpcstatus.Code(err) == rpcstatus.NotFound:
+		return "not_found"
+	case rpcstatus.Code(err) == rpcstatus.ResourceExhausted:
+		return "resource_exhausted"
+	default:
+		return "error"
+	}
+}
+
+// measureBucketPhase starts timing a phase of a bucket operation. The returned
+// function records the duration tagged by operation, phase and outcome.
+func measureBucketPhase(op, phase string) func(err error) {
+	start := time.Now()
+	return func(err error) {
+		mon.DurationVal("bucket_op_phase_duration",
+			monkit.NewSeriesTag("op", op),
+			monkit.NewSeriesTag("phase", phase),
+			monkit.NewSeriesTag("outcome", bucketOutcome(err)),
+		).Observe(time.Since(start))
+	}
+}
+
+// markBucketOutcome counts the outcome of a bucket operation.
+func markBucketOutcome(op string, err error) {
+	mon.Meter("bucket_op_outcome",
+		monkit.NewSeriesTag("op", op),
+		monkit.NewSeriesTag("outcome", bucketOutcome(err)),
+	).Mark(1)
+}
+
+// markBucketEmptyCheck counts the result of checking whether a bucket is empty.
+func markBucketEmptyCheck(empty bool) {
+	result := "not_empty"
+	if empty {
+		result = "empty"
+	}
+	mon.Meter("bucket_empty_check", monkit.NewSeriesTag("result", result)).Mark(1)
+}
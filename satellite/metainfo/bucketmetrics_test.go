@@ -0,0 +1,23 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+)
+
+func TestBucketOutcome(t *testing.T) {
+	require.Equal(t, "success", bucketOutcome(nil))
+	require.Equal(t, "not_found", bucketOutcome(storj.ErrBucketNotFound.New("bucket")))
+	require.Equal(t, "not_found", bucketOutcome(rpcstatus.Error(rpcstatus.NotFound, "bucket not found")))
+	require.Equal(t, "resource_exhausted", bucketOutcome(rpcstatus.Error(rpcstatus.ResourceExhausted, "limit exceeded")))
+	require.Equal(t, "error", bucketOutcome(rpcstatus.Error(rpcstatus.Internal, "internal")))
+	require.Equal(t, "error", bucketOutcome(errors.New("failure")))
+}
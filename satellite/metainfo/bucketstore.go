@@ -0,0 +1,93 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/piecedeletion"
+)
+
+// bucketStore is the part of buckets.Service, which the endpoint uses to manage
+// the lifecycle of buckets. Tests can replace it with a fake.
+type bucketStore interface {
+	// GetMinimalBucket returns existing bucket with minimal number of fields.
+	GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bucket buckets.Bucket, err error)
+	// HasBucket returns if a bucket exists.
+	HasBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (exists bool, err error)
+	// CreateBucketBy creates a new bucket with the settings, recording the user, who created it.
+	CreateBucketBy(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (_ storj.Bucket, err error)
+	// CountBuckets returns the number of buckets a project currently has.
+	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// CountBucketsUpTo returns the number of buckets a project currently has, counting at most limit buckets.
+	CountBucketsUpTo(ctx context.Context, projectID uuid.UUID, limit int) (int, error)
+	// ListBuckets returns all buckets for a project.
+	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error)
+	// DeleteBucket deletes a bucket.
+	DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+}
+
+// caseInsensitiveStore is a bucketStore, which also stores the normalized names
+// of the buckets and matches them.
+type caseInsensitiveStore interface {
+	bucketStore
+	// CreateCaseInsensitiveBucket creates a new bucket like CreateBucketBy, which is also matched by its normalized name.
+	CreateCaseInsensitiveBucket(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (_ storj.Bucket, err error)
+	// GetCaseInsensitiveBucketNames returns the names of the buckets, which bucketNames refer to.
+	GetCaseInsensitiveBucketNames(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (_ [][]byte, err error)
+}
+
+// caseInsensitiveBucketStore creates the buckets, so that names, which differ only in
+// case, refer to the same bucket. New buckets keep the name they were created with and
+// are matched by their normalized name. The endpoint resolves the names of requests to
+// the names of the buckets, before they're passed to the store, see resolveBucketNames.
+//
+// The buckets, which were created before, have no normalized name. They're matched
+// by their exact name, and the ones with a lowercase name also by any case of it.
+type caseInsensitiveBucketStore struct {
+	caseInsensitiveStore
+}
+
+// CreateBucketBy creates a new bucket with the settings, recording the user, who created it.
+func (store caseInsensitiveBucketStore) CreateBucketBy(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (_ storj.Bucket, err error) {
+	return store.caseInsensitiveStore.CreateCaseInsensitiveBucket(ctx, bucket, createdBy, settings)
+}
+
+// bucketObjectStore is the part of metabase.DB, which the endpoint uses for the
+// objects of a bucket as a whole. Tests can replace it with a fake.
+type bucketObjectStore interface {
+	bucketObjectsDeleter
+	// BucketEmpty returns true if bucket does not contain objects.
+	BucketEmpty(ctx context.Context, opts metabase.BucketEmpty) (empty bool, err error)
+	// BucketsEmpty returns for each of the buckets whether it contains no objects in a single query.
+	BucketsEmpty(ctx context.Context, opts metabase.BucketsEmpty) (empty map[string]bool, err error)
+	// BucketStatsBatch returns the number of committed objects and their total size
+	// for each of the buckets in a single query.
+	BucketStatsBatch(ctx context.Context, opts metabase.BucketStatsBatch) (stats map[string]metabase.BucketStatsResult, err error)
+	// ProjectBucketsStats returns the number of committed objects in the buckets of
+	// a project and their total size in a single query.
+	ProjectBucketsStats(ctx context.Context, opts metabase.ProjectBucketsStats) (result metabase.BucketStatsResult, err error)
+	// CountBucketObjects returns the number of objects in the bucket, or only of its pending ones.
+	CountBucketObjects(ctx context.Context, opts metabase.CountBucketObjects) (count int64, err error)
+	// BucketHasObjectsCreatedAfter returns true if the bucket contains committed objects
+	// created after opts.CreatedAfter.
+	BucketHasObjectsCreatedAfter(ctx context.Context, opts metabase.BucketHasObjectsCreatedAfter) (has bool, err error)
+	// MoveBucketObjects moves the objects of a bucket to its new name.
+	MoveBucketObjects(ctx context.Context, opts metabase.MoveBucketObjects) (movedObjectCount int64, err error)
+	// TransferBucketObjects moves the objects of a bucket to another project.
+	TransferBucketObjects(ctx context.Context, opts metabase.TransferBucketObjects) (movedObjectCount int64, err error)
+}
+
+// piecesDeleter is the part of piecedeletion.Service, which the endpoint uses to
+// delete the pieces of deleted segments. Tests can replace it with a fake.
+type piecesDeleter interface {
+	// Delete deletes the pieces of the requests, it returns an error when less
+	// than successThreshold of them were deleted.
+	Delete(ctx context.Context, requests []piecedeletion.Request, successThreshold float64) error
+}
@@ -0,0 +1,513 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/errs2"
+	"storj.io/common/macaroon"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// fakeBucketStore is an in-memory bucketStore for unit tests.
+type fakeBucketStore struct {
+	mu      sync.Mutex
+	buckets map[metabase.BucketLocation]storj.Bucket
+	// normalized maps the normalized names of the case-insensitive buckets to their names.
+	normalized map[metabase.BucketLocation]string
+	// err is returned by all methods, when set.
+	err error
+}
+
+func newFakeBucketStore() *fakeBucketStore {
+	return &fakeBucketStore{
+		buckets:    map[metabase.BucketLocation]storj.Bucket{},
+		normalized: map[metabase.BucketLocation]string{},
+	}
+}
+
+func (store *fakeBucketStore) GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (buckets.Bucket, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return buckets.Bucket{}, store.err
+	}
+
+	bucket, ok := store.buckets[metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}]
+	if !ok {
+		return buckets.Bucket{}, storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return buckets.Bucket{Name: []byte(bucket.Name), CreatedAt: bucket.Created}, nil
+}
+
+func (store *fakeBucketStore) HasBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return false, store.err
+	}
+
+	_, ok := store.buckets[metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}]
+	return ok, nil
+}
+
+func (store *fakeBucketStore) CreateBucketBy(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (storj.Bucket, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return storj.Bucket{}, store.err
+	}
+
+	location := metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: bucket.Name}
+	if _, ok := store.buckets[location]; ok {
+		return storj.Bucket{}, buckets.ErrBucketAlreadyExists.New("%s", bucket.Name)
+	}
+	store.buckets[location] = bucket
+	return bucket, nil
+}
+
+func (store *fakeBucketStore) CreateCaseInsensitiveBucket(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (storj.Bucket, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return storj.Bucket{}, store.err
+	}
+
+	location := metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: bucket.Name}
+	normalized := metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: string(buckets.NormalizeName([]byte(bucket.Name)))}
+	_, exists := store.buckets[location]
+	_, taken := store.buckets[normalized]
+	_, matched := store.normalized[normalized]
+	if exists || taken || matched {
+		return storj.Bucket{}, buckets.ErrBucketAlreadyExists.New("%s", bucket.Name)
+	}
+	store.buckets[location] = bucket
+	store.normalized[normalized] = bucket.Name
+	return bucket, nil
+}
+
+func (store *fakeBucketStore) GetCaseInsensitiveBucketNames(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) ([][]byte, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return nil, store.err
+	}
+
+	names := make([][]byte, len(bucketNames))
+	for i, bucketName := range bucketNames {
+		normalized := metabase.BucketLocation{ProjectID: projectID, BucketName: string(buckets.NormalizeName(bucketName))}
+		if _, ok := store.buckets[metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}]; ok {
+			names[i] = bucketName
+		} else if name, ok := store.normalized[normalized]; ok {
+			names[i] = []byte(name)
+		} else if _, ok := store.buckets[normalized]; ok {
+			names[i] = []byte(normalized.BucketName)
+		} else {
+			names[i] = bucketName
+		}
+	}
+	return names, nil
+}
+
+func (store *fakeBucketStore) CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return 0, store.err
+	}
+
+	count := 0
+	for location := range store.buckets {
+		if location.ProjectID == projectID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (store *fakeBucketStore) CountBucketsUpTo(ctx context.Context, projectID uuid.UUID, limit int) (int, error) {
+	count, err := store.CountBuckets(ctx, projectID)
+	if count > limit {
+		count = limit
+	}
+	return count, err
+}
+
+func (store *fakeBucketStore) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (storj.BucketList, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return storj.BucketList{}, store.err
+	}
+
+	var list storj.BucketList
+	for location, bucket := range store.buckets {
+		if location.ProjectID != projectID || !strings.HasPrefix(bucket.Name, listOpts.Prefix) {
+			continue
+		}
+		if !allowedBuckets.All {
+			if _, ok := allowedBuckets.Buckets[bucket.Name]; !ok {
+				continue
+			}
+		}
+		if bucket.Name < listOpts.Cursor || (bucket.Name == listOpts.Cursor && listOpts.Direction == storj.After) {
+			continue
+		}
+		list.Items = append(list.Items, bucket)
+	}
+	sort.Slice(list.Items, func(i, k int) bool { return list.Items[i].Name < list.Items[k].Name })
+
+	if listOpts.Limit > 0 && len(list.Items) > listOpts.Limit {
+		list.Items, list.More = list.Items[:listOpts.Limit], true
+	}
+	return list, nil
+}
+
+func (store *fakeBucketStore) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return store.err
+	}
+
+	location := metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}
+	if _, ok := store.buckets[location]; !ok {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	delete(store.buckets, location)
+	delete(store.normalized, metabase.BucketLocation{ProjectID: projectID, BucketName: string(buckets.NormalizeName(bucketName))})
+	return nil
+}
+
+// fakeBucketObjectStore is an in-memory bucketObjectStore for unit tests,
+// which only keeps the number of objects in each bucket.
+type fakeBucketObjectStore struct {
+	mu      sync.Mutex
+	objects map[metabase.BucketLocation]int64
+	// err is returned by all methods, when set.
+	err error
+}
+
+func newFakeBucketObjectStore() *fakeBucketObjectStore {
+	return &fakeBucketObjectStore{objects: map[metabase.BucketLocation]int64{}}
+}
+
+func (store *fakeBucketObjectStore) BucketEmpty(ctx context.Context, opts metabase.BucketEmpty) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return false, store.err
+	}
+
+	return store.objects[metabase.BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName}] == 0, nil
+}
+
+func (store *fakeBucketObjectStore) BucketStatsBatch(ctx context.Context, opts metabase.BucketStatsBatch) (map[string]metabase.BucketStatsResult, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return nil, store.err
+	}
+
+	stats := make(map[string]metabase.BucketStatsResult, len(opts.BucketNames))
+	for _, name := range opts.BucketNames {
+		stats[name] = metabase.BucketStatsResult{
+			ObjectCount: store.objects[metabase.BucketLocation{ProjectID: opts.ProjectID, BucketName: name}],
+		}
+	}
+	return stats, nil
+}
+
+func (store *fakeBucketObjectStore) BucketsEmpty(ctx context.Context, opts metabase.BucketsEmpty) (map[string]bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return nil, store.err
+	}
+
+	empty := make(map[string]bool, len(opts.BucketNames))
+	for _, name := range opts.BucketNames {
+		empty[name] = store.objects[metabase.BucketLocation{ProjectID: opts.ProjectID, BucketName: name}] == 0
+	}
+	return empty, nil
+}
+
+func (store *fakeBucketObjectStore) ProjectBucketsStats(ctx context.Context, opts metabase.ProjectBucketsStats) (metabase.BucketStatsResult, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return metabase.BucketStatsResult{}, store.err
+	}
+
+	var result metabase.BucketStatsResult
+	for location, count := range store.objects {
+		if location.ProjectID == opts.ProjectID {
+			result.ObjectCount += count
+		}
+	}
+	return result, nil
+}
+
+// CountBucketObjects returns the number of objects in the bucket, the fake has no pending objects.
+func (store *fakeBucketObjectStore) CountBucketObjects(ctx context.Context, opts metabase.CountBucketObjects) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return 0, store.err
+	}
+
+	if opts.Pending {
+		return 0, nil
+	}
+	return store.objects[opts.Bucket], nil
+}
+
+// BucketHasObjectsCreatedAfter returns false, the fake doesn't keep when objects were created.
+func (store *fakeBucketObjectStore) BucketHasObjectsCreatedAfter(ctx context.Context, opts metabase.BucketHasObjectsCreatedAfter) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return false, store.err
+}
+
+func (store *fakeBucketObjectStore) MoveBucketObjects(ctx context.Context, opts metabase.MoveBucketObjects) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return 0, store.err
+	}
+
+	moved := store.objects[opts.Bucket]
+	delete(store.objects, opts.Bucket)
+	store.objects[metabase.BucketLocation{ProjectID: opts.Bucket.ProjectID, BucketName: opts.NewBucketName}] += moved
+	return moved, nil
+}
+
+func (store *fakeBucketObjectStore) TransferBucketObjects(ctx context.Context, opts metabase.TransferBucketObjects) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return 0, store.err
+	}
+
+	moved := store.objects[opts.Bucket]
+	delete(store.objects, opts.Bucket)
+	store.objects[metabase.BucketLocation{ProjectID: opts.NewProjectID, BucketName: opts.Bucket.BucketName}] += moved
+	return moved, nil
+}
+
+func (store *fakeBucketObjectStore) DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (int64, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if store.err != nil {
+		return 0, store.err
+	}
+
+	deleted := store.objects[opts.Bucket]
+	delete(store.objects, opts.Bucket)
+	return deleted, nil
+}
+
+func TestCaseInsensitiveBucketStore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	sensitive := newFakeBucketStore()
+	store := newFakeBucketStore()
+	insensitive := caseInsensitiveBucketStore{caseInsensitiveStore: store}
+	endpoint := &Endpoint{log: zaptest.NewLogger(t), bucketStore: insensitive}
+
+	// names, which differ only in case, are different buckets by default
+	_, err := sensitive.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "bucketA"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.NoError(t, err)
+	_, err = sensitive.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "bucketa"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.NoError(t, err)
+
+	// and the same bucket when matching case-insensitively, which keeps its name
+	created, err := insensitive.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "bucketA"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.NoError(t, err)
+	require.Equal(t, "bucketA", created.Name)
+	_, err = insensitive.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "bucketa"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.True(t, buckets.ErrBucketAlreadyExists.Has(err))
+
+	// buckets created before are matched by their exact name, and lowercase ones by any case
+	_, err = store.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "legacy"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.NoError(t, err)
+	_, err = store.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "mixeD"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.NoError(t, err)
+	_, err = insensitive.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: "Legacy"}, uuid.UUID{}, buckets.CreateSettings{})
+	require.True(t, buckets.ErrBucketAlreadyExists.Has(err))
+
+	// the names of a request are resolved at once, names of no bucket are unchanged
+	names, err := endpoint.resolveBucketNames(ctx, [][]byte{
+		[]byte("BUCKETA"), []byte("LEGACY"), []byte("mixeD"), []byte("mixed"), []byte("missing"),
+	}, projectID)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{
+		[]byte("bucketA"), []byte("legacy"), []byte("mixeD"), []byte("mixed"), []byte("missing"),
+	}, names)
+
+	// the names are unchanged, unless bucket names match case-insensitively
+	names, err = (&Endpoint{bucketStore: store}).resolveBucketNames(ctx, [][]byte{[]byte("BUCKETA")}, projectID)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("BUCKETA")}, names)
+
+	store.err = errs.New("failure")
+	_, err = endpoint.resolveBucketName(ctx, []byte("bucketA"), projectID)
+	require.True(t, errs2.IsRPC(err, rpcstatus.Internal))
+}
+
+func TestDeleteBucketWithFakes(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	store := newFakeBucketStore()
+	objects := newFakeBucketObjectStore()
+	endpoint := &Endpoint{
+		log:           zaptest.NewLogger(t),
+		bucketStore:   store,
+		bucketObjects: objects,
+	}
+
+	for _, name := range []string{"empty", "full"} {
+		_, err := store.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: name}, uuid.UUID{}, buckets.CreateSettings{})
+		require.NoError(t, err)
+	}
+	objects.objects[metabase.BucketLocation{ProjectID: projectID, BucketName: "full"}] = 3
+
+	require.NoError(t, endpoint.deleteBucket(ctx, []byte("empty"), projectID))
+
+	err := endpoint.deleteBucket(ctx, []byte("full"), projectID)
+	require.True(t, ErrBucketNotEmpty.Has(err))
+
+	// the objects are counted through the object store as well
+	var notEmpty *BucketNotEmptyError
+	require.ErrorAs(t, endpoint.bucketNotEmpty(ctx, projectID, []byte("full")), &notEmpty)
+	require.EqualValues(t, 3, notEmpty.ObjectCount)
+
+	count, err := store.CountBuckets(ctx, projectID)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// the metabase failing keeps the bucket
+	objects.err = metabase.ErrInvalidRequest.New("failure")
+	err = endpoint.deleteBucket(ctx, []byte("full"), projectID)
+	require.Error(t, err)
+	exists, err := store.HasBucket(ctx, []byte("full"), projectID)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+// interruptedBucketStore fails deleting the buckets as if the request was
+// interrupted, until interrupt is cleared.
+type interruptedBucketStore struct {
+	*fakeBucketStore
+	interrupt bool
+}
+
+func (store *interruptedBucketStore) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	if store.interrupt {
+		return context.Canceled
+	}
+	return store.fakeBucketStore.DeleteBucket(ctx, bucketName, projectID)
+}
+
+// committingObjectStore reports the progress of deleting the objects of a bucket and
+// commits an object to the bucket after each of the first commits deletions, as if
+// an upload was finished concurrently.
+type committingObjectStore struct {
+	*fakeBucketObjectStore
+	commits int
+}
+
+func (store *committingObjectStore) DeleteBucketObjects(ctx context.Context, opts metabase.DeleteBucketObjects) (int64, error) {
+	deleted, err := store.fakeBucketObjectStore.DeleteBucketObjects(ctx, opts)
+	if err == nil && opts.Progress != nil {
+		err = opts.Progress(ctx, deleted)
+	}
+	if err == nil && store.commits > 0 {
+		store.commits--
+		store.mu.Lock()
+		store.objects[opts.Bucket]++
+		store.mu.Unlock()
+	}
+	return deleted, err
+}
+
+func TestDeleteBucketNotEmptyResumes(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	store := &interruptedBucketStore{fakeBucketStore: newFakeBucketStore()}
+	objects := &committingObjectStore{fakeBucketObjectStore: newFakeBucketObjectStore()}
+	endpoint := &Endpoint{
+		log:           zaptest.NewLogger(t),
+		bucketStore:   store,
+		bucketObjects: objects,
+	}
+
+	createBucket := func(name string, objectCount int64) {
+		_, err := store.CreateBucketBy(ctx, storj.Bucket{ProjectID: projectID, Name: name}, uuid.UUID{}, buckets.CreateSettings{})
+		require.NoError(t, err)
+		objects.objects[metabase.BucketLocation{ProjectID: projectID, BucketName: name}] = objectCount
+	}
+	bucketExists := func(name string) bool {
+		exists, err := store.HasBucket(ctx, []byte(name), projectID)
+		require.NoError(t, err)
+		return exists
+	}
+
+	// the request is interrupted after deleting the objects, but before deleting the bucket
+	createBucket("interrupted", 5)
+	store.interrupt = true
+	_, deleted, _, err := endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("interrupted"), false, nil)
+	require.Error(t, err)
+	require.EqualValues(t, 5, deleted)
+	require.True(t, bucketExists("interrupted"))
+
+	// the retry deletes the bucket without reporting it's being used
+	store.interrupt = false
+	_, deleted, _, err = endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("interrupted"), false, nil)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+	require.False(t, bucketExists("interrupted"))
+
+	// a retry, which finds the bucket already deleted, succeeds as well
+	_, _, _, err = endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("interrupted"), false, nil)
+	require.NoError(t, err)
+
+	// objects committed while deleting are deleted in the next round
+	createBucket("uploading", 3)
+	objects.commits = deleteAllRounds - 1
+	var progress []int64
+	_, deleted, _, err = endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("uploading"), false, func(ctx context.Context, deleted int64) error {
+		progress = append(progress, deleted)
+		return nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3+deleteAllRounds-1, deleted)
+	require.Equal(t, []int64{3, 4, 5}, progress)
+	require.False(t, bucketExists("uploading"))
+
+	// objects, which keep being committed, mean the bucket is genuinely in use
+	createBucket("busy", 3)
+	objects.commits = deleteAllRounds
+	_, _, _, err = endpoint.deleteBucketNotEmpty(ctx, projectID, []byte("busy"), false, nil)
+	require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+	require.True(t, bucketExists("busy"))
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketsweeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+)
+
+func TestBucketSweeper(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketSoftDelete.Enabled = true
+				config.Metainfo.BucketSoftDelete.RetentionWindow = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		chore := sat.Core.BucketSweeper.Chore
+
+		chore.Loop.Pause()
+
+		for _, name := range []string{"deleted", "kept"} {
+			err := planet.Uplinks[0].Upload(ctx, sat, name, "object", testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		_, err := sat.API.Metainfo.Endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Name:      []byte("deleted"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+
+		// buckets within the retention window are kept
+		chore.Loop.TriggerWait()
+
+		count, err := sat.API.Buckets.Service.CountBuckets(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		count, err = sat.API.Buckets.Service.CountBuckets(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		exists, err := sat.API.Buckets.Service.HasBucket(ctx, []byte("kept"), projectID)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, "kept", objects[0].BucketName)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketsweeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
+)
+
+var (
+	// Error defines the bucketsweeper chore errors class.
+	Error = errs.Class("bucket sweeper chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the soft-deleted buckets sweeper.
+type Config struct {
+	Interval  time.Duration `help:"the time between each attempt to go through the db and remove soft-deleted buckets" releaseDefault:"1h" devDefault:"10s"`
+	ListLimit int           `help:"how many buckets to query in a batch" default:"100"`
+}
+
+// Chore implements the soft-deleted buckets sweeper chore.
+//
+// architecture: Chore
+type Chore struct {
+	log        *zap.Logger
+	config     Config
+	softDelete metainfo.BucketSoftDeleteConfig
+	buckets    buckets.DB
+	metabase   *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the bucketsweeper chore.
+func NewChore(log *zap.Logger, config Config, softDelete metainfo.BucketSoftDeleteConfig, buckets buckets.DB, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:        log,
+		config:     config,
+		softDelete: softDelete,
+		buckets:    buckets,
+		metabase:   metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the bucketsweeper loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.softDelete.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.sweep)
+}
+
+// Close stops the bucketsweeper chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// sweep removes buckets, which were soft-deleted before the retention window, with their objects.
+func (chore *Chore) sweep(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	chore.log.Debug("removing soft-deleted buckets")
+
+	deletedBefore := chore.nowFn().Add(-chore.softDelete.RetentionWindow)
+
+	for {
+		locations, err := chore.buckets.ListSoftDeletedBuckets(ctx, deletedBefore, chore.config.ListLimit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, location := range locations {
+			// objects are deleted first, so a bucket is never removed while it still has objects.
+			deletedObjects, err := chore.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket:    location,
+				BatchSize: chore.config.ListLimit,
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			deleted, err := chore.buckets.DeleteSoftDeletedBucket(ctx, location, deletedBefore)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if deleted {
+				mon.Meter("soft_deleted_buckets_removed").Mark(1)
+				chore.log.Debug("removed soft-deleted bucket",
+					zap.Stringer("Project ID", location.ProjectID),
+					zap.String("Bucket", location.BucketName),
+					zap.Int64("Deleted Objects", deletedObjects))
+			}
+		}
+
+		if len(locations) < chore.config.ListLimit {
+			return nil
+		}
+	}
+}
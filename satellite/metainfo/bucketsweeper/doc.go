@@ -0,0 +1,11 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package bucketsweeper contains the functions needed to run the soft-deleted buckets sweeper chore.
+
+The bucketsweeper chore will periodically query the buckets db for buckets,
+which were soft-deleted longer than the retention window ago, and delete
+them together with their objects.
+*/
+package bucketsweeper
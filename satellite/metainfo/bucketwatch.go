@@ -0,0 +1,154 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+const (
+	// BucketWatchEventExisting is the event type of the buckets of the initial snapshot.
+	BucketWatchEventExisting = "bucket.existing"
+	// BucketWatchEventSynced is the event type sent once the initial snapshot is complete.
+	BucketWatchEventSynced = "snapshot.done"
+)
+
+// BucketWatchEvent is a message sent by WatchBuckets.
+type BucketWatchEvent struct {
+	// Event is BucketWatchEventExisting, BucketWatchEventSynced,
+	// BucketWebhookEventCreated or BucketWebhookEventDeleted.
+	Event string
+	// Name is the name of the bucket, it's empty for BucketWatchEventSynced.
+	Name []byte
+	// Timestamp is when the bucket was created or deleted, or for the buckets
+	// of the snapshot, when they were created.
+	Timestamp time.Time
+}
+
+// bucketWatchers sends the created and deleted buckets to the WatchBuckets streams
+// of their project, which are served by this API instance. Sending never blocks, a stream whose queue is full is closed,
+// so its client can watch again and resync from a new snapshot.
+type bucketWatchers struct {
+	queueSize int
+
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[*bucketWatcher]struct{}
+}
+
+// bucketWatcher is the queue of the events of a single WatchBuckets stream.
+type bucketWatcher struct {
+	allowed macaroon.AllowedBuckets
+	events  chan BucketWatchEvent
+
+	// overflowed is closed, when an event was dropped because events was full.
+	overflowed   chan struct{}
+	overflowOnce sync.Once
+}
+
+// newBucketWatchers returns bucket watchers, which queue at most queueSize events for each stream.
+func newBucketWatchers(queueSize int) *bucketWatchers {
+	return &bucketWatchers{
+		queueSize: queueSize,
+		watchers:  map[uuid.UUID]map[*bucketWatcher]struct{}{},
+	}
+}
+
+// Watch starts queueing the events of the allowed buckets of the project.
+// The returned function stops it and must be called once the stream ends.
+func (watchers *bucketWatchers) Watch(projectID uuid.UUID, allowed macaroon.AllowedBuckets) (*bucketWatcher, func()) {
+	watcher := &bucketWatcher{
+		allowed:    allowed,
+		events:     make(chan BucketWatchEvent, watchers.queueSize),
+		overflowed: make(chan struct{}),
+	}
+
+	watchers.mu.Lock()
+	defer watchers.mu.Unlock()
+
+	project, ok := watchers.watchers[projectID]
+	if !ok {
+		project = map[*bucketWatcher]struct{}{}
+		watchers.watchers[projectID] = project
+	}
+	project[watcher] = struct{}{}
+
+	return watcher, func() {
+		watchers.mu.Lock()
+		defer watchers.mu.Unlock()
+
+		delete(project, watcher)
+		if len(project) == 0 {
+			delete(watchers.watchers, projectID)
+		}
+	}
+}
+
+// Publish queues the event for the streams watching the project, which are allowed to see the bucket.
+func (watchers *bucketWatchers) Publish(projectID uuid.UUID, event BucketWatchEvent) {
+	watchers.mu.Lock()
+	defer watchers.mu.Unlock()
+
+	for watcher := range watchers.watchers[projectID] {
+		if !buckets.IsAllowed(watcher.allowed, event.Name) {
+			continue
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			watcher.overflowOnce.Do(func() { close(watcher.overflowed) })
+		}
+	}
+}
+
+// diffWatchedBuckets returns the events of the buckets, which were deleted since
+// they were sent to the client, followed by the buckets, which were created.
+func diffWatchedBuckets(known map[string]struct{}, current []storj.Bucket, now time.Time) []BucketWatchEvent {
+	exists := make(map[string]struct{}, len(current))
+	for _, bucket := range current {
+		exists[bucket.Name] = struct{}{}
+	}
+
+	var deleted []string
+	for name := range known {
+		if _, ok := exists[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+	sort.Strings(deleted)
+
+	events := make([]BucketWatchEvent, 0, len(deleted))
+	for _, name := range deleted {
+		events = append(events, BucketWatchEvent{
+			Event:     BucketWebhookEventDeleted,
+			Name:      []byte(name),
+			Timestamp: now,
+		})
+	}
+	for _, bucket := range current {
+		if _, ok := known[bucket.Name]; !ok {
+			events = append(events, BucketWatchEvent{
+				Event:     BucketWebhookEventCreated,
+				Name:      []byte(bucket.Name),
+				Timestamp: bucket.Created,
+			})
+		}
+	}
+	return events
+}
+
+// notifyBucketWatchers sends the bucket event to the WatchBuckets streams of the project.
+func (endpoint *Endpoint) notifyBucketWatchers(projectID uuid.UUID, bucketName []byte, event string) {
+	endpoint.bucketWatchers.Publish(projectID, BucketWatchEvent{
+		Event:     event,
+		Name:      bucketName,
+		Timestamp: endpoint.clock(),
+	})
+}
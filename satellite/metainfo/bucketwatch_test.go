@@ -0,0 +1,72 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+)
+
+func TestBucketWatchers(t *testing.T) {
+	watchers := newBucketWatchers(2)
+	projectID, otherProjectID := testrand.UUID(), testrand.UUID()
+
+	all, stopAll := watchers.Watch(projectID, macaroon.AllowedBuckets{All: true})
+	scoped, stopScoped := watchers.Watch(projectID, macaroon.AllowedBuckets{
+		Buckets: map[string]struct{}{"logs-*": {}},
+	})
+
+	watchers.Publish(projectID, BucketWatchEvent{Event: BucketWebhookEventCreated, Name: []byte("logs-a")})
+	watchers.Publish(projectID, BucketWatchEvent{Event: BucketWebhookEventCreated, Name: []byte("photos")})
+	watchers.Publish(otherProjectID, BucketWatchEvent{Event: BucketWebhookEventCreated, Name: []byte("logs-b")})
+
+	require.Len(t, all.events, 2)
+	require.Len(t, scoped.events, 1)
+	require.Equal(t, []byte("logs-a"), (<-scoped.events).Name)
+
+	// the third event doesn't fit the queue of the stream watching all the buckets
+	watchers.Publish(projectID, BucketWatchEvent{Event: BucketWebhookEventDeleted, Name: []byte("logs-a")})
+	select {
+	case <-all.overflowed:
+	default:
+		t.Fatal("expected the queue to overflow")
+	}
+	select {
+	case <-scoped.overflowed:
+		t.Fatal("unexpected overflow")
+	default:
+	}
+
+	// stopped streams aren't sent any events
+	stopAll()
+	stopScoped()
+	watchers.Publish(projectID, BucketWatchEvent{Event: BucketWebhookEventCreated, Name: []byte("logs-c")})
+	require.Len(t, scoped.events, 1)
+	require.Empty(t, watchers.watchers)
+}
+
+func TestDiffWatchedBuckets(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-time.Minute)
+
+	known := map[string]struct{}{"kept": {}, "removed-b": {}, "removed-a": {}}
+	events := diffWatchedBuckets(known, []storj.Bucket{
+		{Name: "kept", Created: created},
+		{Name: "added", Created: created},
+	}, now)
+
+	require.Equal(t, []BucketWatchEvent{
+		{Event: BucketWebhookEventDeleted, Name: []byte("removed-a"), Timestamp: now},
+		{Event: BucketWebhookEventDeleted, Name: []byte("removed-b"), Timestamp: now},
+		{Event: BucketWebhookEventCreated, Name: []byte("added"), Timestamp: created},
+	}, events)
+
+	require.Empty(t, diffWatchedBuckets(map[string]struct{}{"kept": {}}, []storj.Bucket{{Name: "kept"}}, now))
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+)
+
+const (
+	// BucketWebhookEventCreated is the event type of a created bucket.
+	BucketWebhookEventCreated = "bucket.created"
+	// BucketWebhookEventDeleted is the event type of a deleted bucket.
+	BucketWebhookEventDeleted = "bucket.deleted"
+
+	// BucketWebhookSignatureHeader is the header with the hex encoded HMAC-SHA256
+	// signature of the payload, keyed with the configured secret.
+	BucketWebhookSignatureHeader = "X-Storj-Signature"
+)
+
+// BucketWebhookEvent is the JSON payload posted to the bucket webhook.
+type BucketWebhookEvent struct {
+	ProjectID  uuid.UUID `json:"project_id"`
+	BucketName string    `json:"bucket_name"`
+	Event      string    `json:"event"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// SignBucketWebhookPayload returns the signature of the payload, as sent in
+// BucketWebhookSignatureHeader, so that receivers can verify it.
+func SignBucketWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// bucketWebhook posts the bucket events to the configured URL in the background,
+// so the requests never wait for it. Events, which are dropped because the queue
+// is full or which all attempts to deliver fail, are logged to the dead-letter log.
+type bucketWebhook struct {
+	log        *zap.Logger
+	deadLetter *zap.Logger
+	config     BucketWebhookConfig
+	client     *http.Client
+	events     chan BucketWebhookEvent
+}
+
+// newBucketWebhook returns a webhook, which queues at most config.QueueSize events.
+func newBucketWebhook(log *zap.Logger, config BucketWebhookConfig) *bucketWebhook {
+	return &bucketWebhook{
+		log:        log,
+		deadLetter: log.Named("dead-letter"),
+		config:     config,
+		client:     &http.Client{Timeout: config.Timeout},
+		events:     make(chan BucketWebhookEvent, config.QueueSize),
+	}
+}
+
+// Enqueue queues the event without blocking. It returns false, when the queue is full.
+func (webhook *bucketWebhook) Enqueue(event BucketWebhookEvent) bool {
+	select {
+	case webhook.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run delivers the queued events until the context is canceled.
+func (webhook *bucketWebhook) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event := <-webhook.events:
+			webhook.deliver(ctx, event)
+		}
+	}
+}
+
+// deliver posts the event, retrying with an exponential backoff up to
+// config.MaxAttempts times.
+func (webhook *bucketWebhook) deliver(ctx context.Context, event BucketWebhookEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		webhook.log.Error("unable to encode bucket webhook event", zap.Error(err))
+		return
+	}
+
+	delay := webhook.config.RetryInterval
+	for attempt := 1; ; attempt++ {
+		err = webhook.post(ctx, payload)
+		if err == nil {
+			mon.Meter("bucket_webhook_delivered").Mark(1)
+			return
+		}
+		webhook.log.Debug("unable to deliver bucket webhook event",
+			zap.Int("attempt", attempt),
+			zap.String("event", event.Event),
+			zap.Error(err))
+
+		if attempt >= webhook.config.MaxAttempts || !sync2.Sleep(ctx, delay) {
+			break
+		}
+		delay *= 2
+	}
+
+	mon.Meter("bucket_webhook_failed").Mark(1)
+	webhook.deadLetter.Error("undelivered bucket webhook event",
+		zap.Stringer("Project ID", event.ProjectID),
+		zap.String("bucketName", event.BucketName),
+		zap.String("event", event.Event),
+		zap.Time("timestamp", event.Timestamp),
+		zap.ByteString("payload", payload),
+		zap.Error(err))
+}
+
+// post sends the signed payload once.
+func (webhook *bucketWebhook) post(ctx context.Context, payload []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(BucketWebhookSignatureHeader, SignBucketWebhookPayload(webhook.config.Secret, payload))
+
+	resp, err := webhook.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+// notifyBucketWebhook queues the bucket event for the webhook.
+// It never blocks and failures don't fail the request.
+func (endpoint *Endpoint) notifyBucketWebhook(projectID uuid.UUID, bucketName []byte, event string) {
+	if endpoint.config.BucketWebhook.URL == "" {
+		return
+	}
+
+	ok := endpoint.bucketWebhook.Enqueue(BucketWebhookEvent{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+		Event:      event,
+		Timestamp:  endpoint.clock(),
+	})
+	if !ok {
+		// the dead-letter log is where the undelivered events are looked for
+		endpoint.bucketWebhook.deadLetter.Error("bucket webhook queue is full, dropping event",
+			zap.Stringer("Project ID", projectID),
+			zap.ByteString("bucketName", bucketName),
+			zap.String("event", event))
+		mon.Meter("bucket_webhook_dropped").Mark(1)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+// fakeWebhookReceiver records the requests posted to it and fails the first failures of them.
+type fakeWebhookReceiver struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	payloads [][]byte
+	headers  []http.Header
+}
+
+func (receiver *fakeWebhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	receiver.attempts++
+	if receiver.attempts <= receiver.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	receiver.payloads = append(receiver.payloads, body)
+	receiver.headers = append(receiver.headers, r.Header.Clone())
+}
+
+func (receiver *fakeWebhookReceiver) Attempts() int {
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	return receiver.attempts
+}
+
+func TestBucketWebhook(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	receiver := &fakeWebhookReceiver{failures: 2}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	webhook := newBucketWebhook(zap.NewNop(), BucketWebhookConfig{
+		URL:           server.URL,
+		Secret:        "secret",
+		Timeout:       time.Second,
+		MaxAttempts:   3,
+		RetryInterval: time.Millisecond,
+		QueueSize:     1,
+	})
+
+	event := BucketWebhookEvent{
+		ProjectID:  testrand.UUID(),
+		BucketName: "bucket",
+		Event:      BucketWebhookEventCreated,
+		Timestamp:  time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	webhook.deliver(ctx, event)
+	require.Equal(t, 3, receiver.Attempts())
+	require.Len(t, receiver.payloads, 1)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(receiver.payloads[0], &payload))
+	require.Equal(t, map[string]interface{}{
+		"project_id":  event.ProjectID.String(),
+		"bucket_name": "bucket",
+		"event":       "bucket.created",
+		"timestamp":   "2022-01-02T03:04:05Z",
+	}, payload)
+
+	header := receiver.headers[0]
+	require.Equal(t, "application/json", header.Get("Content-Type"))
+	require.Equal(t, SignBucketWebhookPayload("secret", receiver.payloads[0]), header.Get(BucketWebhookSignatureHeader))
+	require.NotEqual(t, SignBucketWebhookPayload("other", receiver.payloads[0]), header.Get(BucketWebhookSignatureHeader))
+}
+
+func TestBucketWebhookDeadLetter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	receiver := &fakeWebhookReceiver{failures: 100}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	core, logs := observer.New(zap.ErrorLevel)
+	webhook := newBucketWebhook(zap.New(core), BucketWebhookConfig{
+		URL:           server.URL,
+		Secret:        "secret",
+		Timeout:       time.Second,
+		MaxAttempts:   2,
+		RetryInterval: time.Millisecond,
+		QueueSize:     1,
+	})
+
+	webhook.deliver(ctx, BucketWebhookEvent{
+		ProjectID:  testrand.UUID(),
+		BucketName: "bucket",
+		Event:      BucketWebhookEventDeleted,
+		Timestamp:  time.Now(),
+	})
+	require.Equal(t, 2, receiver.Attempts())
+
+	entries := logs.FilterMessage("undelivered bucket webhook event").All()
+	require.Len(t, entries, 1)
+	require.Equal(t, "dead-letter", entries[0].LoggerName)
+	require.Equal(t, "bucket", entries[0].ContextMap()["bucketName"])
+	require.Equal(t, BucketWebhookEventDeleted, entries[0].ContextMap()["event"])
+}
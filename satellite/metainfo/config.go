@@ -5,6 +5,7 @@ package metainfo
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/vivint/infectious"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/uplink/private/eestream"
@@ -103,6 +105,54 @@ func (rs *RSConfig) RedundancyStrategy() (eestream.RedundancyStrategy, error) {
 	return eestream.NewRedundancyStrategy(erasureScheme, rs.Repair, rs.Success)
 }
 
+// PlacementRegions maps placement constraints to human readable region names.
+//
+// Can be used as a flag.
+type PlacementRegions map[storj.PlacementConstraint]string
+
+// Type implements pflag.Value.
+func (PlacementRegions) Type() string { return "metainfo.PlacementRegions" }
+
+// String is required for pflag.Value.
+func (regions *PlacementRegions) String() string {
+	placements := make([]int, 0, len(*regions))
+	for placement := range *regions {
+		placements = append(placements, int(placement))
+	}
+	sort.Ints(placements)
+
+	values := make([]string, 0, len(placements))
+	for _, placement := range placements {
+		values = append(values, fmt.Sprintf("%d:%s", placement, (*regions)[storj.PlacementConstraint(placement)]))
+	}
+	return strings.Join(values, ",")
+}
+
+// Set sets the value from a string in the format placement:region,placement:region.
+func (regions *PlacementRegions) Set(s string) error {
+	parsed := PlacementRegions{}
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		info := strings.SplitN(value, ":", 2)
+		if len(info) != 2 || info[1] == "" {
+			return Error.New("Invalid placement region (expect format placement:region, got %s)", value)
+		}
+
+		placement, err := strconv.ParseUint(info[0], 10, 16)
+		if err != nil {
+			return Error.New("Invalid placement in placement region %s: %w", value, err)
+		}
+		parsed[storj.PlacementConstraint(placement)] = info[1]
+	}
+
+	*regions = parsed
+	return nil
+}
+
 // RateLimiterConfig is a configuration struct for endpoint rate limiting.
 type RateLimiterConfig struct {
 	Enabled         bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
@@ -115,6 +165,100 @@ type RateLimiterConfig struct {
 type ProjectLimitConfig struct {
 	MaxBuckets           int  `help:"max bucket count for a project." default:"100" testDefault:"10"`
 	ValidateSegmentLimit bool `help:"whether segment limit validation is enabled." default:"true"`
+
+	CreateBucketStorageCheck bool `help:"whether bucket creation is rejected when the project already exceeds its storage limit." default:"false"`
+
+	CacheCapacity   int           `help:"number of project bucket limits to cache." default:"10000" testDefault:"100"`
+	CacheExpiration time.Duration `help:"how long to cache the project bucket limits, 0 disables caching." default:"0s"`
+}
+
+// BucketSoftDeleteConfig is a configuration struct for soft-deleting buckets.
+type BucketSoftDeleteConfig struct {
+	Enabled         bool          `help:"mark deleted buckets as deleted instead of removing them, so they can be restored" default:"false"`
+	RetentionWindow time.Duration `help:"how long a soft-deleted bucket can be restored before it's removed" default:"168h"`
+}
+
+// BucketLoggingConfig is a configuration struct for the access logging of buckets.
+type BucketLoggingConfig struct {
+	Enabled         bool          `help:"enqueue access log records for the object accesses of buckets with access logging configured" default:"false"`
+	CacheCapacity   int           `help:"number of buckets whose access logging configuration is cached" default:"10000"`
+	CacheExpiration time.Duration `help:"how long the access logging configuration of a bucket is cached, 0 disables caching" default:"1m"`
+}
+
+// BucketCreationLimitConfig is a configuration struct for limiting the rate of bucket creation per project.
+type BucketCreationLimitConfig struct {
+	Limit         int           `help:"number of buckets a project can create within the window, 0 disables the limit" default:"0"`
+	Window        time.Duration `help:"window of the bucket creation limit" default:"1m"`
+	CacheCapacity int           `help:"number of projects whose bucket creation limiter is kept" default:"10000" testDefault:"100"`
+}
+
+// BucketAuditConfig is a configuration struct for the audit log of bucket mutations.
+type BucketAuditConfig struct {
+	Enabled   bool `help:"record which API key created or deleted a bucket in the bucket audit log" default:"false"`
+	QueueSize int  `help:"number of bucket audit records waiting to be stored, records are dropped when it's full" default:"1000"`
+}
+
+// BucketWebhookConfig is a configuration struct for the webhook notified about created and deleted buckets.
+type BucketWebhookConfig struct {
+	URL           string        `help:"URL to post the created and deleted bucket events to, empty disables the webhook" default:""`
+	Secret        string        `help:"secret to sign the webhook payloads with HMAC-SHA256" default:""`
+	Timeout       time.Duration `help:"timeout of a single webhook request" default:"10s"`
+	MaxAttempts   int           `help:"how many times an event is posted before it's written to the dead-letter log" default:"5"`
+	RetryInterval time.Duration `help:"delay before the first retry of an event, it doubles with each retry" default:"1s" testDefault:"10ms"`
+	QueueSize     int           `help:"number of events waiting to be posted, events are dropped when it's full" default:"1000"`
+}
+
+// BucketWatchConfig is a configuration struct for the streams of WatchBuckets.
+type BucketWatchConfig struct {
+	QueueSize      int           `help:"number of bucket events queued for each WatchBuckets stream, a stream whose client falls further behind is closed" default:"100"`
+	ResyncInterval time.Duration `help:"how often each WatchBuckets stream lists the buckets of its project again, to send the buckets created and deleted through other API instances, zero disables it" default:"1m"`
+}
+
+// PieceDeletionMode is how deleting the objects of a bucket handles failures to
+// delete the pieces of the deleted segments from the storage nodes.
+type PieceDeletionMode int
+
+const (
+	// PieceDeletionBestEffort logs the failures and leaves the pieces to garbage collection.
+	PieceDeletionBestEffort PieceDeletionMode = iota
+	// PieceDeletionStrict stops deleting the objects once the pieces of more segments
+	// than tolerated failed to be deleted.
+	PieceDeletionStrict
+)
+
+// String implements flag.Value interface.
+func (mode PieceDeletionMode) String() string {
+	switch mode {
+	case PieceDeletionBestEffort:
+		return "best-effort"
+	case PieceDeletionStrict:
+		return "strict"
+	default:
+		return fmt.Sprintf("PieceDeletionMode(%d)", int(mode))
+	}
+}
+
+// Set implements flag.Value interface.
+func (mode *PieceDeletionMode) Set(s string) error {
+	switch strings.ToLower(s) {
+	case "best-effort":
+		*mode = PieceDeletionBestEffort
+	case "strict":
+		*mode = PieceDeletionStrict
+	default:
+		return Error.New("invalid piece deletion mode: %q", s)
+	}
+	return nil
+}
+
+// Type implements pflag.Value.
+func (PieceDeletionMode) Type() string { return "metainfo.PieceDeletionMode" }
+
+// BucketPieceDeletionConfig is a configuration struct for deleting the pieces of the
+// objects deleted together with their bucket.
+type BucketPieceDeletionConfig struct {
+	Mode        PieceDeletionMode `help:"how failures to delete the pieces of the objects of a deleted bucket are handled: best-effort logs them and leaves the pieces to garbage collection, strict stops the deletion once more than max-failures segments failed" default:"best-effort"`
+	MaxFailures int64             `help:"number of segments whose pieces may fail to be deleted before the strict mode stops deleting the objects of a bucket" default:"0"`
 }
 
 // Config is a configuration struct that is everything you need to start a metainfo.
@@ -130,6 +274,13 @@ type Config struct {
 	MaxCommitInterval           time.Duration        `default:"48h" testDefault:"1h" help:"maximum time allowed to pass between creating and committing a segment"`
 	MinPartSize                 memory.Size          `default:"5MiB" testDefault:"0" help:"minimum allowed part size (last part has no minimum size limit)"`
 	MaxNumberOfParts            int                  `default:"10000" help:"maximum number of parts object can contain"`
+	MaxBatchDeleteBuckets       int                  `default:"100" help:"maximum number of buckets that can be deleted in a single batch request (0 disables batch deletes)"`
+	MaxHasBuckets               int                  `default:"100" help:"maximum number of bucket names that can be checked in a single HasBuckets request"`
+	S3CompatibleNames           bool                 `default:"false" help:"validate bucket names using the S3 bucket naming rules instead of the Storj rules"`
+	CaseInsensitiveNames        bool                 `default:"false" help:"match bucket names case-insensitively, new buckets keep their name and are also matched by its lowercase form"`
+	ReservedBucketPrefixes      []string             `default:"storj-,sys-" help:"bucket name prefixes reserved for internal use, which new buckets can't be created or renamed with"`
+	BucketCostCenters           []string             `default:"" help:"cost centers, which the usage of buckets can be allocated to in the usage reports, empty disables cost centers"`
+	PlacementRegions            PlacementRegions     `default:"" help:"human readable region names of placement constraints, in the format placement:region,placement:region"`
 	Overlay                     bool                 `default:"true" help:"toggle flag if overlay is enabled"`
 	RS                          RSConfig             `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
 	SegmentLoop                 segmentloop.Config   `help:"segment loop configuration"`
@@ -138,4 +289,52 @@ type Config struct {
 	PieceDeletion               piecedeletion.Config `help:"piece deletion configuration"`
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy bool `help:"enable code for server-side copy" default:"true"`
+
+	BucketSoftDelete    BucketSoftDeleteConfig    `help:"bucket soft-delete configuration"`
+	BucketLogging       BucketLoggingConfig       `help:"bucket access logging configuration"`
+	BucketCreationLimit BucketCreationLimitConfig `help:"bucket creation rate limit configuration"`
+	BucketAudit         BucketAuditConfig         `help:"bucket audit log configuration"`
+	BucketWebhook       BucketWebhookConfig       `help:"bucket webhook configuration"`
+	BucketWatch         BucketWatchConfig         `help:"bucket watch configuration"`
+	BucketPieceDeletion BucketPieceDeletionConfig `help:"configuration of deleting the pieces of the objects of deleted buckets"`
+
+	DeleteDeadlineMargin     time.Duration `help:"stop deleting the objects of a bucket when the request deadline is closer than this, so the client can retry and resume" default:"5s"`
+	DeleteObjectsConcurrency int           `help:"number of batches of deleted bucket objects whose pieces are deleted concurrently, 1 deletes them one batch at a time" default:"1"`
+	MaxDeleteAllObjects      int64         `help:"maximum number of objects a bucket may contain to be deleted together with its objects, larger buckets must be emptied in pages first, 0 means unlimited" default:"0"`
+
+	// TestingAllowSkipPieceDeletion must never be set in production, where the pieces of deleted objects must be deleted.
+	TestingAllowSkipPieceDeletion bool `hidden:"true" help:"allow bucket delete requests to skip deleting the pieces of the deleted objects, only for tests with ephemeral storage nodes" default:"false"`
+
+	DefaultPartner string `help:"name of the partner attributed to buckets, whose API key and user agent don't resolve to a partner, empty leaves them without a partner" default:""`
+
+	ListBucketsTokenExpiration time.Duration `help:"how long the continuation tokens of bucket listings stay valid" default:"24h"`
+	// TODO remove this flag once clients have migrated to bucket listing continuation tokens
+	ListBucketsLegacyCursor bool `help:"accept bucket names as the cursor of bucket listings, besides continuation tokens (deprecated)" default:"true"`
+
+	// TODO remove this flag once clients relying on Read permission for listing buckets have migrated
+	ListBucketsReadFallback bool `help:"accept Read permission, when List permission is missing, for listing buckets (deprecated)" default:"true"`
+
+	// HideBucketExistence makes GetBucket and DeleteBucket return the same NotFound
+	// error when the API key isn't authorized for the bucket, as when the bucket
+	// doesn't exist, so that the response doesn't reveal whether the bucket exists.
+	HideBucketExistence bool `help:"return NotFound instead of PermissionDenied from bucket endpoints, so that unauthorized callers can't learn whether a bucket exists" default:"false"`
+
+	// BucketReadsFromReplica routes the bucket reads of GetBucket, GetBucketInfo,
+	// GetBucketLocation, GetBucketTagging and ListBuckets to the satellite
+	// database read replica. Those only return bucket metadata to the client.
+	// Bucket reads which precede a write, e.g. in CreateBucket, DeleteBucket or
+	// object uploads, always go to the primary.
+	BucketReadsFromReplica bool `help:"serve read-only bucket requests from the satellite database read replica, when one is configured" default:"false"`
+
+	// ListBucketsDetailedLimit caps the page size of ListBucketsDetailed. Each of its items
+	// carries the whole bucket, so pages are a lot heavier than the name-only pages
+	// of ListBuckets, which are limited only by the database default of 10000.
+	ListBucketsDetailedLimit int `help:"maximum number of buckets returned by a single detailed bucket listing" default:"100"`
+
+	// ApproximateBucketCountThreshold bounds the buckets counted by CountBuckets requests,
+	// which accept an approximate count, as counting all the buckets of a project with
+	// a lot of them is slow.
+	ApproximateBucketCountThreshold int `help:"number of buckets, above which CountBuckets returns an approximate count, when the request accepts one" default:"10000"`
+
+	IdempotencyKeyTTL time.Duration `help:"how long the results of bucket requests with an idempotency key are kept to replay retries, 0 disables idempotency keys" default:"1h"`
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/storj/satellite/metainfo"
 )
 
@@ -96,3 +97,63 @@ func TestRSConfigValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestPlacementRegions(t *testing.T) {
+	tests := []struct {
+		description     string
+		configString    string
+		expectedRegions metainfo.PlacementRegions
+		expectedString  string
+		expectError     bool
+	}{
+		{
+			description:     "empty",
+			configString:    "",
+			expectedRegions: metainfo.PlacementRegions{},
+			expectedString:  "",
+		},
+		{
+			description:  "valid regions",
+			configString: "1:eu-central-1, 0:global",
+			expectedRegions: metainfo.PlacementRegions{
+				storj.EveryCountry: "global",
+				storj.EU:           "eu-central-1",
+			},
+			expectedString: "0:global,1:eu-central-1",
+		},
+		{
+			description:  "missing region",
+			configString: "1:",
+			expectError:  true,
+		},
+		{
+			description:  "missing separator",
+			configString: "1",
+			expectError:  true,
+		},
+		{
+			description:  "invalid placement",
+			configString: "eu:eu-central-1",
+			expectError:  true,
+		},
+		{
+			description:  "placement out of range",
+			configString: "70000:eu-central-1",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Log(tt.description)
+
+		var regions metainfo.PlacementRegions
+		err := regions.Set(tt.configString)
+		if tt.expectError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRegions, regions)
+			require.Equal(t, tt.expectedString, regions.String())
+		}
+	}
+}
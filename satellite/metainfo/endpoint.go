@@ -11,6 +11,7 @@ import (
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/encryption"
 	"storj.io/common/lrucache"
@@ -19,6 +20,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/buckets"
@@ -47,6 +49,14 @@ var (
 	ErrNodeAlreadyExists = errs.Class("metainfo: node already exists")
 	// ErrBucketNotEmpty is returned when bucket is required to be empty for an operation.
 	ErrBucketNotEmpty = errs.Class("bucket not empty")
+	// ErrBucketNameMissing is returned when a stored bucket has no name, which means its data is corrupted.
+	ErrBucketNameMissing = errs.Class("metainfo: bucket name missing")
+	// ErrAttributionConflict is returned when a bucket is already attributed to a different partner or user agent.
+	ErrAttributionConflict = errs.Class("metainfo: attribution conflict")
+	// ErrInvalidRedundancyScheme is returned when a redundancy scheme can't be used for the buckets.
+	ErrInvalidRedundancyScheme = errs.Class("metainfo: invalid redundancy scheme")
+	// ErrInvalidContinuationToken is returned when a bucket listing continuation token is tampered with or expired.
+	ErrInvalidContinuationToken = errs.Class("metainfo: invalid continuation token")
 )
 
 // APIKeys is api keys store methods used by endpoint.
@@ -64,29 +74,41 @@ type Endpoint struct {
 
 	log                  *zap.Logger
 	buckets              *buckets.Service
+	bucketStore          bucketStore
 	metabase             *metabase.DB
-	deletePieces         *piecedeletion.Service
+	bucketObjects        bucketObjectStore
+	deletePieces         piecesDeleter
 	orders               *orders.Service
 	overlay              *overlay.Service
 	attributions         attribution.DB
 	partners             *rewards.PartnersService
+	defaultPartnerID     uuid.UUID
+	analytics            BucketAnalytics
 	pointerVerification  *pointerverification.Service
 	projectUsage         *accounting.Service
+	storageUsage         projectStorageUsage
 	projects             console.Projects
 	apiKeys              APIKeys
 	satellite            signing.Signer
 	limiterCache         *lrucache.ExpiringLRU
+	bucketLimits         *bucketLimitsCache
+	bucketLogging        *bucketLoggingCache
+	bucketCreation       *bucketCreationLimiter
+	bucketAudit          *bucketAuditLog
+	bucketWebhook        *bucketWebhook
+	bucketWatchers       *bucketWatchers
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
 	revocations          revocation.DB
 	defaultRS            *pb.RedundancyScheme
 	config               Config
 	versionCollector     *versionCollector
+	clock                func() time.Time
 }
 
 // NewEndpoint creates new metainfo endpoint instance.
 func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase.DB,
 	deletePieces *piecedeletion.Service, orders *orders.Service, cache *overlay.Service,
-	attributions attribution.DB, partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
+	attributions attribution.DB, partners *rewards.PartnersService, analytics BucketAnalytics, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
 	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
@@ -107,33 +129,82 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		Total:            int32(config.RS.Total),
 		ErasureShareSize: config.RS.ErasureShareSize.Int32(),
 	}
+	if err := validateRedundancyScheme(defaultRSScheme); err != nil {
+		return nil, err
+	}
+
+	var defaultPartnerID uuid.UUID
+	if config.DefaultPartner != "" {
+		partner, err := partners.ByName(context.Background(), config.DefaultPartner)
+		if err != nil {
+			return nil, Error.New("invalid default partner %q: %w", config.DefaultPartner, err)
+		}
+		defaultPartnerID = partner.UUID
+	}
+
+	var store bucketStore = buckets
+	if config.CaseInsensitiveNames {
+		store = caseInsensitiveBucketStore{caseInsensitiveStore: buckets}
+	}
 
 	return &Endpoint{
 		log:                 log,
 		buckets:             buckets,
+		bucketStore:         store,
 		metabase:            metabaseDB,
+		bucketObjects:       metabaseDB,
 		deletePieces:        deletePieces,
 		orders:              orders,
 		overlay:             cache,
 		attributions:        attributions,
 		partners:            partners,
+		defaultPartnerID:    defaultPartnerID,
+		analytics:           analytics,
 		pointerVerification: pointerverification.NewService(peerIdentities),
 		apiKeys:             apiKeys,
 		projectUsage:        projectUsage,
+		storageUsage:        projectUsage,
 		projects:            projects,
 		satellite:           satellite,
 		limiterCache: lrucache.New(lrucache.Options{
 			Capacity:   config.RateLimiter.CacheCapacity,
 			Expiration: config.RateLimiter.CacheExpiration,
 		}),
+		bucketLimits:         newBucketLimitsCache(projects, config.ProjectLimits.CacheCapacity, config.ProjectLimits.CacheExpiration),
+		bucketLogging:        newBucketLoggingCache(buckets, config.BucketLogging.CacheCapacity, config.BucketLogging.CacheExpiration),
+		bucketCreation:       newBucketCreationLimiter(config.BucketCreationLimit),
+		bucketAudit:          newBucketAuditLog(log.Named("bucket-audit"), buckets, config.BucketAudit.QueueSize),
+		bucketWebhook:        newBucketWebhook(log.Named("bucket-webhook"), config.BucketWebhook),
+		bucketWatchers:       newBucketWatchers(config.BucketWatch.QueueSize),
 		encInlineSegmentSize: encInlineSegmentSize,
 		revocations:          revocations,
 		defaultRS:            defaultRSScheme,
 		config:               config,
 		versionCollector:     newVersionCollector(log),
+		clock:                time.Now,
 	}, nil
 }
 
+// TestingSetNow allows tests to have the bucket methods act as if the current time is whatever they want.
+func (endpoint *Endpoint) TestingSetNow(clock func() time.Time) {
+	endpoint.clock = clock
+}
+
+// Run runs the background work of the endpoint, which is storing the bucket audit
+// records and posting the bucket webhook events.
+func (endpoint *Endpoint) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, ctx := errgroup.WithContext(ctx)
+	if endpoint.config.BucketAudit.Enabled {
+		group.Go(func() error { return endpoint.bucketAudit.Run(ctx) })
+	}
+	if endpoint.config.BucketWebhook.URL != "" {
+		group.Go(func() error { return endpoint.bucketWebhook.Run(ctx) })
+	}
+	return group.Wait()
+}
+
 // Close closes resources.
 func (endpoint *Endpoint) Close() error { return nil }
 
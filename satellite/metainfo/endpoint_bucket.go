@@ -4,20 +4,27 @@
 package metainfo
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/errs2"
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/dbreplica"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -27,286 +34,3453 @@ func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetReques
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	ctx, _, err = withTraceID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := endpoint.getBucket(ctx, &BucketGetRequest{
+		Header: req.Header,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BucketGetResponse{
+		Bucket: info.Bucket,
+	}, nil
+}
+
+// BucketGetRequest is a request for GetBucketInfo.
+type BucketGetRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+
+	// IncludeStats requests the number of objects in the bucket and their
+	// total size. It requires an additional metabase query.
+	IncludeStats bool
+
+	// Raw requests the redundancy scheme and encryption parameters stored with
+	// the bucket instead of the satellite defaults, the ones which aren't stored
+	// are left unset.
+	Raw bool
+
+	// TraceID correlates the logs and traces of the request, it's generated when
+	// it isn't set. See TraceIDMetadataKey.
+	TraceID string
+}
+
+// BucketGetResponse is a response for GetBucketInfo.
+type BucketGetResponse struct {
+	Bucket *pb.Bucket
+
+	// ObjectCount and TotalSize are set only when IncludeStats was requested.
+	ObjectCount int64
+	TotalSize   int64
+
+	ObjectLockEnabled bool
+	DefaultRetention  buckets.DefaultRetention
+
+	// DefaultObjectTTL is how long objects uploaded without an expiration are kept,
+	// zero means that they don't expire.
+	DefaultObjectTTL time.Duration
+
+	// CreatedBy is the user, who created the bucket, zero means that it isn't known.
+	CreatedBy uuid.UUID
+
+	// Frozen is whether uploads to the bucket are rejected.
+	Frozen bool
+
+	// LastModified is when the bucket was last changed.
+	LastModified time.Time
+
+	// CostCenter is the cost center, which the usage of the bucket is allocated to.
+	CostCenter string
+
+	// TraceID is the trace id of the request.
+	TraceID string
+}
+
+// GetBucketInfo returns a bucket together with the optionally requested information.
+func (endpoint *Endpoint) GetBucketInfo(ctx context.Context, req *BucketGetRequest) (resp *BucketGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, traceID, err := withTraceID(ctx, req.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = endpoint.getBucket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.TraceID = traceID
+	return resp, nil
+}
+
+func (endpoint *Endpoint) getBucket(ctx context.Context, req *BucketGetRequest) (resp *BucketGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, endpoint.hideBucketExistence(err, req.Name)
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	// override RS to fit satellite settings
+	convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	if req.Raw {
+		stored, err := endpoint.buckets.GetBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		convBucket.DefaultRedundancyScheme, convBucket.DefaultEncryptionParameters = convertStoredBucketParameters(stored)
+	}
+
+	resp = &BucketGetResponse{
+		Bucket:            convBucket,
+		ObjectLockEnabled: bucket.ObjectLockEnabled,
+		DefaultRetention:  bucket.DefaultRetention,
+		DefaultObjectTTL:  bucket.DefaultObjectTTL,
+		CreatedBy:         bucket.CreatedBy,
+		Frozen:            bucket.Frozen,
+		LastModified:      bucket.LastModified,
+		CostCenter:        bucket.CostCenter,
+	}
+
+	if req.IncludeStats {
+		stats, err := endpoint.bucketStats(ctx, keyInfo.ProjectID, []string{string(req.Name)})
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		resp.ObjectCount = stats[0].ObjectCount
+		resp.TotalSize = stats[0].TotalSize
+	}
+
+	return resp, nil
+}
+
+// BucketLocationRequest is a request for GetBucketLocation.
+type BucketLocationRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// BucketLocationResponse is a response for GetBucketLocation.
+type BucketLocationResponse struct {
+	Placement storj.PlacementConstraint
+	// Region is the region name configured for the placement, it's empty when not configured.
+	Region string
+}
+
+// GetBucketLocation returns the placement and region of a bucket.
+func (endpoint *Endpoint) GetBucketLocation(ctx context.Context, req *BucketLocationRequest) (resp *BucketLocationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketLocationResponse{
+		Placement: bucket.Placement,
+		Region:    endpoint.config.PlacementRegions[bucket.Placement],
+	}, nil
+}
+
+// BucketMetadataRequest is a request for GetBucketMetadata.
+type BucketMetadataRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// BucketMetadataResponse is a response for GetBucketMetadata.
+type BucketMetadataResponse struct {
+	Name      []byte
+	CreatedAt time.Time
+	Placement storj.PlacementConstraint
+	// Region is the region name configured for the placement, it's empty when not configured.
+	Region string
+}
+
+// GetBucketMetadata returns the name, creation time and placement of a bucket. Unlike
+// GetBucketInfo it doesn't resolve the redundancy scheme and encryption parameters,
+// which makes it cheap enough for hot paths, like generating presigned URLs.
+func (endpoint *Endpoint) GetBucketMetadata(ctx context.Context, req *BucketMetadataRequest) (resp *BucketMetadataResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return convertBucketToMetadata(bucket, endpoint.config.PlacementRegions), nil
+}
+
+// BucketQuotaRequest is a request for GetBucketQuota.
+type BucketQuotaRequest struct {
+	Header *pb.RequestHeader
+}
+
+// BucketQuotaResponse is a response for GetBucketQuota.
+type BucketQuotaResponse struct {
+	// MaxBuckets is the number of buckets the project can have.
+	MaxBuckets int
+	// BucketCount is the number of buckets the project has, including soft-deleted buckets.
+	BucketCount int
+}
+
+// GetBucketQuota returns how many buckets the project can have and how many it has,
+// so clients can show the remaining quota. MaxBuckets is the same limit CreateBucket
+// enforces, the limit of the project, when it has one, or the default limit.
+func (endpoint *Endpoint) GetBucketQuota(ctx context.Context, req *BucketQuotaRequest) (resp *BucketQuotaResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:   macaroon.ActionRead,
+		Time: endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	maxBuckets, err := endpoint.projectMaxBuckets(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to get project bucket limit", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get bucket quota")
+	}
+	bucketCount, err := endpoint.bucketStore.CountBuckets(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to count project buckets", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to get bucket quota")
+	}
+
+	return &BucketQuotaResponse{
+		MaxBuckets:  maxBuckets,
+		BucketCount: bucketCount,
+	}, nil
+}
+
+// convertBucketToMetadata returns the metadata of the bucket, with the region of its placement.
+func convertBucketToMetadata(bucket buckets.Bucket, regions PlacementRegions) *BucketMetadataResponse {
+	return &BucketMetadataResponse{
+		Name:      bucket.Name,
+		CreatedAt: bucket.CreatedAt,
+		Placement: bucket.Placement,
+		Region:    regions[bucket.Placement],
+	}
+}
+
+// HasBucketsRequest is a request for HasBuckets.
+type HasBucketsRequest struct {
+	Header *pb.RequestHeader
+	Names  [][]byte
+}
+
+// HasBucketsResponse is a response for HasBuckets.
+type HasBucketsResponse struct {
+	// Exists reports for each requested name whether the bucket exists.
+	Exists []bool
+}
+
+// HasBuckets checks whether multiple buckets exist using a single query.
+// Buckets the API key isn't allowed to access are reported as missing.
+func (endpoint *Endpoint) HasBuckets(ctx context.Context, req *HasBucketsRequest) (resp *HasBucketsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	if len(req.Names) > endpoint.config.MaxHasBuckets {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "number of buckets (%d) exceeds the limit (%d)", len(req.Names), endpoint.config.MaxHasBuckets)
+	}
+
+	action := macaroon.Action{
+		Op:   macaroon.ActionRead,
+		Time: time.Now(),
+	}
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, action)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := endpoint.resolveBucketNames(ctx, req.Names, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := endpoint.buckets.HasBuckets(ctx, names, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	for i, name := range req.Names {
+		if !buckets.IsAllowed(allowedBuckets, name) {
+			exists[i] = false
+		}
+	}
+
+	return &HasBucketsResponse{Exists: exists}, nil
+}
+
+// ValidateBucketNameRequest is a request for ValidateBucketName.
+type ValidateBucketNameRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// ValidateBucketNameResponse is a response for ValidateBucketName.
+type ValidateBucketNameResponse struct {
+	// Valid is whether the name follows the bucket naming rules.
+	Valid bool
+	// Code and Reason tell which rule an invalid name breaks.
+	Code   BucketNameErrorCode
+	Reason string
+
+	// TakenChecked is whether Taken reports if a bucket with the name already
+	// exists in the project. It's only checked for callers with Read permission
+	// for the bucket.
+	TakenChecked bool
+	Taken        bool
+}
+
+// ValidateBucketName checks whether a bucket can be created with the name, without
+// creating it, so that clients can validate the name as the user types it. It only
+// requires valid API credentials. Whether the name is already taken is only checked
+// for callers with Read permission for the bucket, so that the response doesn't
+// reveal the existence of other buckets.
+func (endpoint *Endpoint) ValidateBucketName(ctx context.Context, req *ValidateBucketNameRequest) (resp *ValidateBucketNameResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	key, keyInfo, err := endpoint.validateBasic(ctx, req.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err == nil {
+		err = endpoint.validateBucketNotReserved(req.Name)
+	}
+	if err != nil {
+		var nameErr *BucketNameError
+		if errors.As(err, &nameErr) {
+			return &ValidateBucketNameResponse{Code: nameErr.Code, Reason: nameErr.Message}, nil
+		}
+		return &ValidateBucketNameResponse{Reason: err.Error()}, nil
+	}
+
+	resp = &ValidateBucketNameResponse{Valid: true}
+
+	err = key.Check(ctx, keyInfo.Secret, macaroon.Action{
 		Op:     macaroon.ActionRead,
 		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	}, endpoint.revocations)
+	if err != nil {
+		return resp, nil
+	}
+
+	name, err := endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Taken, err = endpoint.bucketStore.HasBucket(ctx, name, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	resp.TakenChecked = true
+	return resp, nil
+}
+
+// BucketTaggingRequest is a request for GetBucketTagging and DeleteBucketTagging.
+type BucketTaggingRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// SetBucketTaggingRequest is a request for SetBucketTagging.
+type SetBucketTaggingRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+	// Tags replace the current tag set of the bucket.
+	Tags map[string]string
+}
+
+// BucketTaggingResponse is a response for the bucket tagging requests.
+type BucketTaggingResponse struct {
+	Tags map[string]string
+}
+
+// SetBucketTagging replaces the tags of a bucket.
+func (endpoint *Endpoint) SetBucketTagging(ctx context.Context, req *SetBucketTaggingRequest) (resp *BucketTaggingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
 		Time:   time.Now(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	bucket, err := endpoint.buckets.GetMinimalBucket(ctx, req.GetName(), keyInfo.ProjectID)
-	if err != nil {
-		if storj.ErrBucketNotFound.Has(err) {
-			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
-		}
-		endpoint.log.Error("internal", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := buckets.ValidateTags(req.Tags); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if err := endpoint.updateBucketTags(ctx, req.Name, keyInfo.ProjectID, req.Tags); err != nil {
+		return nil, err
+	}
+
+	return &BucketTaggingResponse{Tags: req.Tags}, nil
+}
+
+// GetBucketTagging returns the tags of a bucket.
+func (endpoint *Endpoint) GetBucketTagging(ctx context.Context, req *BucketTaggingRequest) (resp *BucketTaggingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketTaggingResponse{Tags: bucket.Tags}, nil
+}
+
+// DeleteBucketTagging removes all tags of a bucket.
+func (endpoint *Endpoint) DeleteBucketTagging(ctx context.Context, req *BucketTaggingRequest) (resp *BucketTaggingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.updateBucketTags(ctx, req.Name, keyInfo.ProjectID, nil); err != nil {
+		return nil, err
+	}
+
+	return &BucketTaggingResponse{}, nil
+}
+
+func (endpoint *Endpoint) updateBucketTags(ctx context.Context, bucketName []byte, projectID uuid.UUID, tags map[string]string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.buckets.UpdateBucketTags(ctx, bucketName, projectID, tags)
+	switch {
+	case err == nil:
+		return nil
+	case storj.ErrBucketNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case buckets.ErrInvalidTags.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// BucketCORSRequest is a request for GetBucketCORS and DeleteBucketCORS.
+type BucketCORSRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// SetBucketCORSRequest is a request for SetBucketCORS.
+type SetBucketCORSRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+	// Rules replace the current CORS rules of the bucket.
+	Rules []buckets.CORSRule
+}
+
+// BucketCORSResponse is a response for the bucket CORS requests.
+type BucketCORSResponse struct {
+	Rules []buckets.CORSRule
+}
+
+// SetBucketCORS replaces the CORS rules of a bucket.
+func (endpoint *Endpoint) SetBucketCORS(ctx context.Context, req *SetBucketCORSRequest) (resp *BucketCORSResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Rules) == 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "at least one CORS rule is required")
+	}
+	if err := buckets.ValidateCORS(req.Rules); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if err := endpoint.updateBucketCORS(ctx, req.Name, keyInfo.ProjectID, req.Rules); err != nil {
+		return nil, err
+	}
+
+	return &BucketCORSResponse{Rules: req.Rules}, nil
+}
+
+// GetBucketCORS returns the CORS rules of a bucket.
+func (endpoint *Endpoint) GetBucketCORS(ctx context.Context, req *BucketCORSRequest) (resp *BucketCORSResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketCORSResponse{Rules: bucket.CORS}, nil
+}
+
+// DeleteBucketCORS removes the CORS rules of a bucket.
+func (endpoint *Endpoint) DeleteBucketCORS(ctx context.Context, req *BucketCORSRequest) (resp *BucketCORSResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.updateBucketCORS(ctx, req.Name, keyInfo.ProjectID, nil); err != nil {
+		return nil, err
+	}
+
+	return &BucketCORSResponse{}, nil
+}
+
+func (endpoint *Endpoint) updateBucketCORS(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []buckets.CORSRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = endpoint.buckets.UpdateBucketCORS(ctx, bucketName, projectID, rules)
+	switch {
+	case err == nil:
+		return nil
+	case storj.ErrBucketNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case buckets.ErrInvalidCORS.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// SetBucketTTLRequest is a request for SetBucketTTL.
+type SetBucketTTLRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+	// TTL is how long objects uploaded to the bucket without an expiration are kept.
+	TTL time.Duration
+}
+
+// SetBucketTTLResponse is a response for SetBucketTTL.
+type SetBucketTTLResponse struct {
+	TTL time.Duration
+}
+
+// SetBucketTTL sets the default object TTL of a bucket. Objects uploaded afterwards
+// without an expiration expire after the TTL, existing objects aren't changed.
+func (endpoint *Endpoint) SetBucketTTL(ctx context.Context, req *SetBucketTTLRequest) (resp *SetBucketTTLResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.buckets.UpdateBucketDefaultObjectTTL(ctx, req.Name, keyInfo.ProjectID, req.TTL)
+	switch {
+	case err == nil:
+		return &SetBucketTTLResponse{TTL: req.TTL}, nil
+	case storj.ErrBucketNotFound.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case buckets.ErrDefaultObjectTTL.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// SetBucketCostCenterRequest is a request for SetBucketCostCenter.
+type SetBucketCostCenterRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+	// CostCenter must be one of Config.BucketCostCenters, empty removes the cost center.
+	CostCenter string
+}
+
+// SetBucketCostCenterResponse is a response for SetBucketCostCenter.
+type SetBucketCostCenterResponse struct {
+	CostCenter string
+}
+
+// SetBucketCostCenter sets the cost center, which the usage of a bucket is allocated
+// to in the usage reports.
+func (endpoint *Endpoint) SetBucketCostCenter(ctx context.Context, req *SetBucketCostCenterRequest) (resp *SetBucketCostCenterResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateCostCenter(req.CostCenter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.buckets.UpdateBucketCostCenter(ctx, req.Name, keyInfo.ProjectID, req.CostCenter)
+	switch {
+	case err == nil:
+		return &SetBucketCostCenterResponse{CostCenter: req.CostCenter}, nil
+	case storj.ErrBucketNotFound.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// BucketLoggingRequest is a request for GetBucketLogging.
+type BucketLoggingRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// SetBucketLoggingRequest is a request for SetBucketLogging.
+type SetBucketLoggingRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+	// Logging replaces the access logging configuration of the bucket,
+	// a configuration without a target bucket disables access logging.
+	Logging buckets.Logging
+}
+
+// BucketLoggingResponse is a response for the bucket logging requests.
+type BucketLoggingResponse struct {
+	Logging buckets.Logging
+}
+
+// SetBucketLogging replaces the access logging configuration of a bucket. The accesses of
+// the objects of the bucket are then logged into the target bucket of the same project.
+func (endpoint *Endpoint) SetBucketLogging(ctx context.Context, req *SetBucketLoggingRequest) (resp *BucketLoggingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := endpoint.clock()
+	permissions := []verifyPermission{{
+		action: macaroon.Action{
+			Op:     macaroon.ActionWrite,
+			Bucket: req.Name,
+			Time:   now,
+		},
+	}}
+	if req.Logging.Enabled() {
+		// the access logs are written into the target bucket
+		permissions = append(permissions, verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Logging.TargetBucket,
+				Time:   now,
+			},
+		})
+	}
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header, permissions...)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Logging.Enabled() {
+		req.Logging.TargetBucket, err = endpoint.resolveBucketName(ctx, req.Logging.TargetBucket, keyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = endpoint.buckets.UpdateBucketLogging(ctx, req.Name, keyInfo.ProjectID, req.Logging)
+	switch {
+	case err == nil:
+		endpoint.bucketLogging.Invalidate(req.Name, keyInfo.ProjectID)
+		return &BucketLoggingResponse{Logging: req.Logging}, nil
+	case storj.ErrBucketNotFound.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case buckets.ErrInvalidLogging.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
+
+// GetBucketLogging returns the access logging configuration of a bucket.
+func (endpoint *Endpoint) GetBucketLogging(ctx context.Context, req *BucketLoggingRequest) (resp *BucketLoggingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(endpoint.bucketReadContext(ctx), req.Name, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketLoggingResponse{Logging: bucket.Logging}, nil
+}
+
+// CreateBucket creates a new bucket.
+func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (resp *pb.BucketCreateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, _, err = withTraceID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := endpoint.createBucket(ctx, &BucketCreateRequest{
+		BucketCreateRequest: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BucketCreateResponse{
+		Bucket: created.Bucket,
+	}, nil
+}
+
+// BucketCreateRequest is a request for CreateBucketWithOptions, extending
+// pb.BucketCreateRequest with options that aren't part of the protocol yet.
+type BucketCreateRequest struct {
+	*pb.BucketCreateRequest
+
+	// ObjectLockEnabled enables object lock for the bucket. It can't be disabled later.
+	ObjectLockEnabled bool
+	// DefaultRetention is the retention applied to objects when object lock is enabled.
+	DefaultRetention buckets.DefaultRetention
+
+	// CostCenter is the cost center, which the usage of the bucket is allocated to,
+	// it must be one of Config.BucketCostCenters.
+	CostCenter string
+
+	// IdempotencyKey makes a retry of the request with the same key replay the
+	// response of the first successful attempt instead of creating the bucket again.
+	IdempotencyKey []byte
+
+	// TraceID correlates the logs and traces of the request, it's generated when
+	// it isn't set. See TraceIDMetadataKey.
+	TraceID string
+
+	// template is the bucket, whose placement, tags, CORS rules and default object
+	// TTL the bucket is created with, see CreateBucketFrom.
+	template *buckets.Bucket
+}
+
+// BucketCreateResponse is a response for CreateBucketWithOptions.
+type BucketCreateResponse struct {
+	Bucket *pb.Bucket
+
+	ObjectLockEnabled bool
+	DefaultRetention  buckets.DefaultRetention
+
+	// TraceID is the trace id of the request.
+	TraceID string
+}
+
+// CreateBucketWithOptions creates a new bucket with the options that aren't part of pb.BucketCreateRequest.
+func (endpoint *Endpoint) CreateBucketWithOptions(ctx context.Context, req *BucketCreateRequest) (resp *BucketCreateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, traceID, err := withTraceID(ctx, req.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = endpoint.createBucket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.TraceID = traceID
+	return resp, nil
+}
+
+func (endpoint *Endpoint) createBucket(ctx context.Context, req *BucketCreateRequest) (resp *BucketCreateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { markBucketOutcome(bucketOpCreate, err) }()
+
+	now := endpoint.clock()
+
+	var canRead bool
+
+	authDone := measureBucketPhase(bucketOpCreate, bucketPhaseAuth)
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+	)
+	authDone(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.idempotencyEnabled(req.IdempotencyKey) {
+		replayed, err := endpoint.replayIdempotent(ctx, keyInfo.ProjectID, req.IdempotencyKey, bucketOpCreate, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if replayed != nil {
+			resp, err := decodeCreateResponse(replayed)
+			if err != nil {
+				endpoint.logger(ctx).Error("internal", zap.Error(err))
+				return nil, rpcstatus.Error(rpcstatus.Internal, "unable to replay idempotent request")
+			}
+			return resp, nil
+		}
+
+		defer func() {
+			if err != nil {
+				return
+			}
+			encoded, encodeErr := encodeCreateResponse(resp)
+			if encodeErr != nil {
+				endpoint.logger(ctx).Warn("unable to encode idempotency key result", zap.Error(encodeErr))
+				return
+			}
+			endpoint.storeIdempotent(ctx, keyInfo.ProjectID, req.IdempotencyKey, bucketOpCreate, req.Name, encoded)
+		}()
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	err = endpoint.validateBucketNotReserved(req.Name)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	err = buckets.ValidateObjectLock(req.ObjectLockEnabled, req.DefaultRetention)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.validateCostCenter(req.CostCenter)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DefaultSegmentSize < 0 || req.DefaultSegmentSize > endpoint.config.MaxSegmentSize.Int64() {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "default segment size must be between 0 and %d, got %d",
+			endpoint.config.MaxSegmentSize.Int64(), req.DefaultSegmentSize)
+	}
+
+	// the creation rate is limited before any bucket is looked up, so a client creating
+	// buckets in a loop is throttled without querying the database
+	if retryAfter, ok := endpoint.bucketCreation.Allow(keyInfo.ProjectID, now); !ok {
+		endpoint.logger(ctx).Warn("too many buckets created by project",
+			zap.Stringer("projectID", keyInfo.ProjectID),
+			zap.Int("limit", endpoint.config.BucketCreationLimit.Limit),
+			zap.Duration("window", endpoint.config.BucketCreationLimit.Window))
+		mon.Event("metainfo_bucket_creation_limit_exceeded")
+
+		retryAfter = (retryAfter + time.Second - 1).Truncate(time.Second)
+		return nil, rpcstatus.Errorf(rpcstatus.ResourceExhausted, "too many buckets created, retry after %s", retryAfter)
+	}
+
+	// the name may refer to an existing bucket, when bucket names match case-insensitively
+	existingName, err := endpoint.resolveBucketName(ctx, req.GetName(), keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// checks if bucket exists before updates it or makes a new entry
+	exists, err := endpoint.bucketStore.HasBucket(ctx, existingName, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	} else if exists {
+		// When the bucket exists, try to set the attribution.
+		if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, existingName); err != nil {
+			if ErrAttributionConflict.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "bucket already exists and is attributed to a different partner or user agent")
+			}
+			return nil, err
+		}
+		return nil, endpoint.bucketAlreadyExists(ctx, existingName, keyInfo.ProjectID, canRead)
+	}
+
+	// the old name of a bucket being renamed stays taken until its objects are moved
+	_, err = endpoint.buckets.GetBucketRename(ctx, keyInfo.ProjectID, req.GetName())
+	if err == nil {
+		return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket is being renamed")
+	} else if !buckets.ErrBucketRenameNotFound.Has(err) {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	// the name of a bucket being transferred to another project stays taken until its objects are moved
+	_, err = endpoint.buckets.GetBucketTransfer(ctx, keyInfo.ProjectID, req.GetName())
+	if err == nil {
+		return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket is being transferred to another project")
+	} else if !buckets.ErrBucketTransferNotFound.Has(err) {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	limitCheckDone := measureBucketPhase(bucketOpCreate, bucketPhaseLimitCheck)
+	err = endpoint.checkBucketLimit(ctx, keyInfo.ProjectID)
+	limitCheckDone(err)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketReq, err := convertProtoToBucket(req.BucketCreateRequest, keyInfo.ProjectID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	// the configuration is stored with the bucket, so it never exists without it
+	settings := buckets.CreateSettings{
+		ObjectLockEnabled: req.ObjectLockEnabled,
+		DefaultRetention:  req.DefaultRetention,
+		CostCenter:        req.CostCenter,
+	}
+	if req.template != nil {
+		bucketReq.Placement = req.template.Placement
+		settings.Tags = req.template.Tags
+		settings.CORS = req.template.CORS
+		settings.DefaultObjectTTL = req.template.DefaultObjectTTL
+	}
+
+	dbDone := measureBucketPhase(bucketOpCreate, bucketPhaseDB)
+	bucket, err := endpoint.bucketStore.CreateBucketBy(ctx, bucketReq, keyInfo.CreatedBy, settings)
+	dbDone(err)
+	if err != nil {
+		if buckets.ErrBucketAlreadyExists.Has(err) {
+			// HasBucket doesn't report soft-deleted buckets, but their names remain taken.
+			return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists or was deleted and can still be restored")
+		}
+		endpoint.logger(ctx).Error("error while creating bucket", zap.String("bucketName", bucketReq.Name), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+
+	// Once we have created the bucket, we can try setting the attribution.
+	// The attribution of a deleted bucket is kept, so a conflict doesn't fail the creation.
+	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.GetName()); err != nil && !ErrAttributionConflict.Has(err) {
+		return nil, err
+	}
+
+	conversionDone := measureBucketPhase(bucketOpCreate, bucketPhaseProtoConversion)
+	rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
+	if err != nil {
+		conversionDone(err)
+		endpoint.logger(ctx).Error("unable to get project redundancy scheme", zap.Stringer("Project ID", keyInfo.ProjectID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+
+	// override RS to fit satellite settings
+	convBucket, err := convertBucketToProto(buckets.Bucket{
+		Name:                        []byte(bucket.Name),
+		CreatedAt:                   bucket.Created,
+		DefaultEncryptionParameters: bucket.DefaultEncryptionParameters,
+		DefaultSegmentSize:          memory.Size(bucket.DefaultSegmentsSize),
+	}, rs, endpoint.config.MaxSegmentSize)
+	conversionDone(err)
+	if err != nil {
+		endpoint.logger(ctx).Error("error while converting bucket to proto", zap.String("bucketName", bucket.Name), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+
+	endpoint.trackBucketCreated(req.Header, keyInfo)
+	endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionCreate)
+	endpoint.notifyBucketWebhook(keyInfo.ProjectID, req.Name, BucketWebhookEventCreated)
+	endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventCreated)
+
+	return &BucketCreateResponse{
+		Bucket:            convBucket,
+		ObjectLockEnabled: req.ObjectLockEnabled,
+		DefaultRetention:  req.DefaultRetention,
+	}, nil
+}
+
+// BucketCreateFromRequest is a request for CreateBucketFrom.
+type BucketCreateFromRequest struct {
+	Header *pb.RequestHeader
+	// Source is the bucket, whose configuration is copied.
+	Source []byte
+	// Name is the name of the created bucket.
+	Name []byte
+}
+
+// CreateBucketFrom creates a new bucket with the configuration of the source bucket:
+// its placement, default encryption parameters, default segment size, object lock,
+// cost center, tags, CORS rules and default object TTL. The objects aren't copied, and neither is
+// the access logging configuration, which would log into the target of the source.
+// It requires Read permission for the source and Write permission for the new bucket.
+func (endpoint *Endpoint) CreateBucketFrom(ctx context.Context, req *BucketCreateFromRequest) (resp *BucketCreateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Source,
+		Time:   endpoint.clock(),
+	})
+	if err != nil {
+		return nil, endpoint.hideBucketExistence(err, req.Source)
+	}
+
+	err = endpoint.validateBucket(ctx, req.Source)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	req.Source, err = endpoint.resolveBucketName(ctx, req.Source, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the source is read from the primary, the replica may not have it yet
+	source, err := endpoint.bucketStore.GetMinimalBucket(ctx, req.Source, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	createReq := &pb.BucketCreateRequest{
+		Header:             req.Header,
+		Name:               req.Name,
+		DefaultSegmentSize: source.DefaultSegmentSize.Int64(),
+	}
+	if !source.DefaultEncryptionParameters.IsZero() {
+		createReq.DefaultEncryptionParameters = &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(source.DefaultEncryptionParameters.CipherSuite),
+			BlockSize:   int64(source.DefaultEncryptionParameters.BlockSize),
+		}
+	}
+
+	return endpoint.createBucket(ctx, &BucketCreateRequest{
+		BucketCreateRequest: createReq,
+		ObjectLockEnabled:   source.ObjectLockEnabled,
+		DefaultRetention:    source.DefaultRetention,
+		CostCenter:          source.CostCenter,
+		template:            &source,
+	})
+}
+
+// checkBucketLimit returns a ResourceExhausted error when the project can't have more buckets.
+func (endpoint *Endpoint) checkBucketLimit(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// check if project has exceeded its allocated bucket limit
+	maxBuckets, err := endpoint.projectMaxBuckets(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to get project bucket limit", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+	bucketCount, err := endpoint.bucketStore.CountBuckets(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to count project buckets", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+	if bucketCount >= maxBuckets {
+		endpoint.logger(ctx).Warn("bucket limit exceeded for project",
+			zap.Stringer("projectID", projectID),
+			zap.Int("bucket count", bucketCount),
+			zap.Int("bucket limit", maxBuckets))
+
+		mon.Event("metainfo_bucket_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", maxBuckets))
+	}
+
+	if endpoint.config.ProjectLimits.CreateBucketStorageCheck {
+		return endpoint.checkBucketStorageLimit(ctx, projectID)
+	}
+	return nil
+}
+
+// projectMaxBuckets returns the maximum number of buckets of the project,
+// which is the limit of the project, when it has one, or the default limit.
+func (endpoint *Endpoint) projectMaxBuckets(ctx context.Context, projectID uuid.UUID) (_ int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	maxBuckets, err := endpoint.bucketLimits.GetMaxBuckets(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if maxBuckets == nil {
+		return endpoint.config.ProjectLimits.MaxBuckets, nil
+	}
+	return *maxBuckets, nil
+}
+
+// checkBucketStorageLimit returns a ResourceExhausted error when the project
+// already uses all of its storage, so a new bucket couldn't hold any data.
+func (endpoint *Endpoint) checkBucketStorageLimit(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageLimit, err := endpoint.storageUsage.GetProjectStorageLimit(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to get project storage limit", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+	storageUsed, err := endpoint.storageUsage.GetProjectStorageTotals(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to get project storage usage", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	}
+	if storageUsed >= storageLimit.Int64() {
+		endpoint.logger(ctx).Warn("storage limit exceeded for project on bucket creation",
+			zap.Stringer("projectID", projectID),
+			zap.Int64("storage used", storageUsed),
+			zap.Stringer("storage limit", storageLimit))
+
+		mon.Event("metainfo_bucket_storage_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("project storage limit (%s) exceeded, no new buckets can be created", storageLimit))
+	}
+	return nil
+}
+
+// BucketAlreadyExistsError is the cause of the AlreadyExists error returned by
+// CreateBucket, it carries the existing bucket when the caller may read it.
+type BucketAlreadyExistsError struct {
+	Bucket *pb.Bucket
+}
+
+// Error implements the error interface.
+func (err *BucketAlreadyExistsError) Error() string { return "bucket already exists" }
+
+// bucketAlreadyExists returns the AlreadyExists error for an existing bucket,
+// including the bucket details only when canRead is set.
+func (endpoint *Endpoint) bucketAlreadyExists(ctx context.Context, name []byte, projectID uuid.UUID, canRead bool) error {
+	existsErr := &BucketAlreadyExistsError{}
+	if !canRead {
+		return rpcstatus.Wrap(rpcstatus.AlreadyExists, existsErr)
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, name, projectID)
+	if err != nil {
+		// the bucket may have been deleted concurrently, the details are best effort
+		endpoint.logger(ctx).Warn("unable to load existing bucket", zap.ByteString("bucketName", name), zap.Error(err))
+		return rpcstatus.Wrap(rpcstatus.AlreadyExists, existsErr)
+	}
+
+	rs, err := endpoint.projectRedundancyScheme(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to get project redundancy scheme", zap.Stringer("Project ID", projectID), zap.Error(err))
+		return rpcstatus.Wrap(rpcstatus.AlreadyExists, existsErr)
+	}
+
+	existsErr.Bucket, err = convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to convert existing bucket", zap.ByteString("bucketName", name), zap.Error(err))
+	}
+	return rpcstatus.Wrap(rpcstatus.AlreadyExists, existsErr)
+}
+
+// DeleteBucket deletes a bucket.
+func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDeleteRequest) (resp *pb.BucketDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, _, err = withTraceID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := endpoint.deleteBucketWithProgress(ctx, &BucketDeleteRequest{BucketDeleteRequest: req}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return deleted.BucketDeleteResponse, nil
+}
+
+// BucketDeleteRequest is a request for DeleteBucketWithOptions.
+type BucketDeleteRequest struct {
+	*pb.BucketDeleteRequest
+
+	// DryRun makes the request only report the number of objects, which would be
+	// deleted, without deleting the objects or the bucket.
+	DryRun bool
+
+	// IdempotencyKey makes a retry of the request with the same key replay the
+	// response of the first successful attempt instead of deleting the bucket again.
+	// It's ignored for dry runs.
+	IdempotencyKey []byte
+
+	// SkipPieceDeletion deletes the objects without deleting their pieces from the
+	// storage nodes, which speeds up the teardown of tests with ephemeral storage nodes.
+	// It's ignored unless Config.TestingAllowSkipPieceDeletion is set.
+	SkipPieceDeletion bool
+
+	// TraceID correlates the logs and traces of the request, it's generated when
+	// it isn't set. See TraceIDMetadataKey.
+	TraceID string
+}
+
+// BucketDeleteResponse is a response for DeleteBucketWithOptions.
+type BucketDeleteResponse struct {
+	*pb.BucketDeleteResponse
+
+	// FreedBytes is the encrypted size of the deleted segments, which were stored
+	// on storage nodes. Inline segments and segments, which are still referenced
+	// by server-side copies, aren't included.
+	FreedBytes int64
+
+	// AbortedUploadsCount is the number of pending multipart uploads, which were
+	// aborted by deleting all objects of the bucket. They are included in
+	// DeletedObjectsCount.
+	AbortedUploadsCount int64
+
+	// TraceID is the trace id of the request.
+	TraceID string
+}
+
+// BucketNotEmptyError is the cause of the FailedPrecondition error returned when
+// deleting a bucket, which contains objects, to a caller with Read or List permission.
+type BucketNotEmptyError struct {
+	// ObjectCount is the number of objects in the bucket, counted like a dry run.
+	ObjectCount int64
+}
+
+// Error implements the error interface.
+func (err *BucketNotEmptyError) Error() string {
+	return fmt.Sprintf("bucket not empty: it contains %d objects", err.ObjectCount)
+}
+
+// BucketPendingUploadsError is the cause of the FailedPrecondition error returned when
+// deleting a bucket, which contains only pending multipart uploads, to a caller with
+// Read or List permission. Deleting the bucket with DeleteAll aborts the uploads.
+type BucketPendingUploadsError struct {
+	// UploadCount is the number of pending multipart uploads in the bucket.
+	UploadCount int64
+}
+
+// Error implements the error interface.
+func (err *BucketPendingUploadsError) Error() string {
+	return fmt.Sprintf("bucket not empty: it contains %d pending multipart uploads, delete all objects to abort them", err.UploadCount)
+}
+
+// BucketTooLargeToDeleteAllError is the cause of the FailedPrecondition error returned
+// when deleting a bucket with DeleteAll, which contains more than MaxDeleteAllObjects objects.
+type BucketTooLargeToDeleteAllError struct {
+	// ObjectCount is the number of objects in the bucket, counted like a dry run.
+	ObjectCount int64
+	// MaxObjects is the maximum number of objects deleted with DeleteAll.
+	MaxObjects int64
+}
+
+// Error implements the error interface.
+func (err *BucketTooLargeToDeleteAllError) Error() string {
+	return fmt.Sprintf("bucket contains %d objects, more than the %d objects, which can be deleted with DeleteAll, delete the objects in pages before deleting the bucket", err.ObjectCount, err.MaxObjects)
+}
+
+// DeleteBucketWithOptions deletes a bucket like DeleteBucket, with additional options.
+func (endpoint *Endpoint) DeleteBucketWithOptions(ctx context.Context, req *BucketDeleteRequest) (resp *BucketDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, traceID, err := withTraceID(ctx, req.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = endpoint.deleteBucketWithProgress(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.TraceID = traceID
+	return resp, nil
+}
+
+// BucketDeleteProgress is a message sent by DeleteBucketStream.
+type BucketDeleteProgress struct {
+	// DeletedObjectsCount is the number of objects deleted so far.
+	DeletedObjectsCount int64
+	// Done is set on the final message, which is sent after the bucket is deleted.
+	Done bool
+	// Bucket is the deleted bucket, it's set only on the final message.
+	Bucket *pb.Bucket
+}
+
+// BucketDeleteStream is the stream DeleteBucketStream sends progress messages to.
+type BucketDeleteStream interface {
+	Context() context.Context
+	Send(*BucketDeleteProgress) error
+}
+
+// deleteBucketProgressInterval is the minimum interval between progress messages.
+const deleteBucketProgressInterval = time.Second
+
+// DeleteBucketStream deletes a bucket like DeleteBucket, but while objects of
+// a non-empty bucket are deleted, it periodically sends the number of objects
+// deleted so far. The final message has Done set and reports the total.
+//
+// Cancelling the stream context stops the deletion after the current batch.
+func (endpoint *Endpoint) DeleteBucketStream(req *pb.BucketDeleteRequest, stream BucketDeleteStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	var lastSent time.Time
+	progress := func(ctx context.Context, deletedObjectCount int64) error {
+		if time.Since(lastSent) < deleteBucketProgressInterval {
+			return nil
+		}
+		lastSent = time.Now()
+		return stream.Send(&BucketDeleteProgress{DeletedObjectsCount: deletedObjectCount})
+	}
+
+	resp, err := endpoint.deleteBucketWithProgress(ctx, &BucketDeleteRequest{BucketDeleteRequest: req}, progress)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&BucketDeleteProgress{
+		DeletedObjectsCount: resp.DeletedObjectsCount,
+		Done:                true,
+		Bucket:              resp.Bucket,
+	})
+}
+
+// deleteBucketWithProgress implements DeleteBucket, calling progress with the
+// number of deleted objects while a non-empty bucket is deleted.
+func (endpoint *Endpoint) deleteBucketWithProgress(ctx context.Context, req *BucketDeleteRequest, progress func(context.Context, int64) error) (resp *BucketDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { markBucketOutcome(bucketOpDelete, err) }()
+
+	now := endpoint.clock()
+
+	var canRead, canList bool
+
+	authDone := measureBucketPhase(bucketOpDelete, bucketPhaseAuth)
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionList,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canList,
+			optional:        true,
+		},
+	)
+	authDone(err)
+	if err != nil {
+		return nil, endpoint.hideBucketExistence(err, req.Name)
+	}
+
+	if !req.DryRun && endpoint.idempotencyEnabled(req.IdempotencyKey) {
+		replayed, err := endpoint.replayIdempotent(ctx, keyInfo.ProjectID, req.IdempotencyKey, bucketOpDelete, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if replayed != nil {
+			resp, err := decodeDeleteResponse(replayed)
+			if err != nil {
+				endpoint.logger(ctx).Error("internal", zap.Error(err))
+				return nil, rpcstatus.Error(rpcstatus.Internal, "unable to replay idempotent request")
+			}
+			return resp, nil
+		}
+
+		defer func() {
+			if err != nil {
+				return
+			}
+			encoded, encodeErr := encodeDeleteResponse(resp)
+			if encodeErr != nil {
+				endpoint.logger(ctx).Warn("unable to encode idempotency key result", zap.Error(encodeErr))
+				return
+			}
+			endpoint.storeIdempotent(ctx, keyInfo.ProjectID, req.IdempotencyKey, bucketOpDelete, req.Name, encoded)
+		}()
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		bucket     buckets.Bucket
+		convBucket *pb.Bucket
+	)
+	if canRead || canList {
+		// Info about deleted bucket is returned only if either Read, or List permission is granted.
+		getDone := measureBucketPhase(bucketOpDelete, bucketPhaseDB)
+		bucket, err = endpoint.bucketStore.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
+		getDone(err)
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			}
+			return nil, err
+		}
+
+		conversionDone := measureBucketPhase(bucketOpDelete, bucketPhaseProtoConversion)
+		rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
+		if err == nil {
+			convBucket, err = convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+		}
+		conversionDone(err)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+	}
+
+	if req.DryRun {
+		dryRunResp, err := endpoint.deleteBucketDryRun(ctx, req, keyInfo.ProjectID, convBucket, canRead, canList)
+		if err != nil {
+			return nil, err
+		}
+		return &BucketDeleteResponse{BucketDeleteResponse: dryRunResp}, nil
+	}
+
+	deleteDone := measureBucketPhase(bucketOpDelete, bucketPhaseDB)
+	err = endpoint.deleteBucket(ctx, req.Name, keyInfo.ProjectID)
+	deleteDone(err)
+	if err != nil {
+		if !canRead && !canList {
+			// No error info is returned if neither Read, nor List permission is granted.
+			return &BucketDeleteResponse{BucketDeleteResponse: &pb.BucketDeleteResponse{}}, nil
+		}
+		if ErrBucketNotEmpty.Has(err) {
+			// List permission is required to delete all objects in a bucket.
+			if !req.GetDeleteAll() || !canList {
+				return nil, endpoint.bucketNotEmpty(ctx, keyInfo.ProjectID, req.Name)
+			}
+
+			var abortedUploads int64
+			if !endpoint.config.BucketSoftDelete.Enabled {
+				// the uploads are counted before the deletion, since the objects
+				// are deleted regardless of their status.
+				abortedUploads, err = endpoint.countPendingUploads(ctx, keyInfo.ProjectID, req.Name)
+				if err != nil {
+					// the count is best effort
+					endpoint.logger(ctx).Warn("unable to count pending uploads", zap.ByteString("bucketName", req.Name), zap.Error(err))
+				}
+			}
+
+			deleteAllDone := measureBucketPhase(bucketOpDelete, bucketPhaseDB)
+			_, deletedObjCount, freedBytes, err := endpoint.deleteBucketNotEmpty(ctx, keyInfo.ProjectID, req.Name, endpoint.skipPieceDeletion(req), progress)
+			deleteAllDone(err)
+			if err != nil {
+				return nil, err
+			}
+
+			endpoint.trackBucketDeleted(req.Header, keyInfo)
+			endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionDelete)
+			endpoint.notifyBucketWebhook(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+			endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+
+			return &BucketDeleteResponse{
+				BucketDeleteResponse: &pb.BucketDeleteResponse{Bucket: convBucket, DeletedObjectsCount: deletedObjCount},
+				FreedBytes:           freedBytes,
+				AbortedUploadsCount:  abortedUploads,
+			}, nil
+		}
+		if storj.ErrBucketNotFound.Has(err) {
+			return &BucketDeleteResponse{BucketDeleteResponse: &pb.BucketDeleteResponse{Bucket: convBucket}}, nil
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.trackBucketDeleted(req.Header, keyInfo)
+	endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionDelete)
+	endpoint.notifyBucketWebhook(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+	endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+
+	return &BucketDeleteResponse{BucketDeleteResponse: &pb.BucketDeleteResponse{Bucket: convBucket}}, nil
+}
+
+// deleteBucketDryRun checks the request like deleteBucketWithProgress and returns
+// the number of objects, which would be deleted, without deleting anything.
+func (endpoint *Endpoint) deleteBucketDryRun(ctx context.Context, req *BucketDeleteRequest, projectID uuid.UUID, convBucket *pb.Bucket, canRead, canList bool) (resp *pb.BucketDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !canRead && !canList {
+		// No info is returned if neither Read, nor List permission is granted.
+		return &pb.BucketDeleteResponse{}, nil
+	}
+
+	count, err := endpoint.countDeleteAllObjects(ctx, projectID, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 0 {
+		// List permission is required to delete all objects in a bucket.
+		if !req.GetDeleteAll() || !canList {
+			return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, &BucketNotEmptyError{ObjectCount: count})
+		}
+		if err := endpoint.ensureNoLockedObjects(ctx, projectID, req.Name); err != nil {
+			return nil, err
+		}
+		if err := endpoint.checkMaxDeleteAllObjects(count); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.BucketDeleteResponse{Bucket: convBucket, DeletedObjectsCount: count}, nil
+}
+
+// countDeleteAllObjects returns the number of objects, which deleting the bucket
+// with DeleteAll would delete.
+func (endpoint *Endpoint) countDeleteAllObjects(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	count, err := endpoint.bucketObjects.CountBucketObjects(ctx, metabase.CountBucketObjects{
+		Bucket: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+	})
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return count, nil
+}
+
+// checkMaxDeleteAllObjects returns a FailedPrecondition error, when the bucket
+// with count objects is too large to delete all its objects at once.
+func (endpoint *Endpoint) checkMaxDeleteAllObjects(count int64) error {
+	maxObjects := endpoint.config.MaxDeleteAllObjects
+	if maxObjects > 0 && count > maxObjects {
+		return rpcstatus.Wrap(rpcstatus.FailedPrecondition, &BucketTooLargeToDeleteAllError{
+			ObjectCount: count,
+			MaxObjects:  maxObjects,
+		})
+	}
+	return nil
+}
+
+// bucketNotEmpty returns the FailedPrecondition error for a bucket, which can't be
+// deleted because it contains objects. It must be called only when the caller has
+// Read or List permission, since the error includes the number of objects. A bucket,
+// which contains only pending multipart uploads, gets a BucketPendingUploadsError.
+func (endpoint *Endpoint) bucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) error {
+	count, err := endpoint.bucketObjects.CountBucketObjects(ctx, metabase.CountBucketObjects{
+		Bucket: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+	})
+	if err != nil {
+		// the count is best effort
+		endpoint.logger(ctx).Warn("unable to count bucket objects", zap.ByteString("bucketName", bucketName), zap.Error(err))
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, ErrBucketNotEmpty.New("").Error())
+	}
+
+	pending, err := endpoint.countPendingUploads(ctx, projectID, bucketName)
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to count pending uploads", zap.ByteString("bucketName", bucketName), zap.Error(err))
+	} else if pending > 0 && pending == count {
+		return rpcstatus.Wrap(rpcstatus.FailedPrecondition, &BucketPendingUploadsError{UploadCount: pending})
+	}
+
+	return rpcstatus.Wrap(rpcstatus.FailedPrecondition, &BucketNotEmptyError{ObjectCount: count})
+}
+
+// countPendingUploads returns the number of pending multipart uploads in the bucket.
+func (endpoint *Endpoint) countPendingUploads(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return endpoint.bucketObjects.CountBucketObjects(ctx, metabase.CountBucketObjects{
+		Bucket:  metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+		Pending: true,
+	})
+}
+
+// deleteBucket deletes a bucket from the bucekts db.
+// The value attribution of the bucket is kept, so the usage of a bucket,
+// which is deleted and created again, stays attributed to the same partner.
+func (endpoint *Endpoint) deleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	empty, err := endpoint.isBucketEmpty(ctx, projectID, bucketName)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return ErrBucketNotEmpty.New("")
+	}
+
+	loggingSources := endpoint.bucketLoggingSources(ctx, projectID, bucketName)
+
+	if endpoint.config.BucketSoftDelete.Enabled {
+		err = endpoint.buckets.SoftDeleteBucket(ctx, bucketName, projectID, endpoint.clock())
+	} else {
+		err = endpoint.bucketStore.DeleteBucket(ctx, bucketName, projectID)
+	}
+	if err != nil {
+		return err
+	}
+
+	endpoint.invalidateBucketLogging(projectID, append(loggingSources, bucketName)...)
+	return nil
+}
+
+// isBucketEmpty returns whether bucket is empty. The check probes for a single object
+// and is bounded by the deadline of ctx, see metabase.BucketEmpty.
+func (endpoint *Endpoint) isBucketEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	empty, err := endpoint.bucketObjects.BucketEmpty(ctx, metabase.BucketEmpty{
+		ProjectID:  projectID,
+		BucketName: string(bucketName),
+	})
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	markBucketEmptyCheck(empty)
+	return empty, nil
+}
+
+// deleteAllRounds is the number of times the objects of a bucket are deleted,
+// before objects, which keep being committed, are reported as the bucket being
+// used by another process.
+const deleteAllRounds = 3
+
+// deleteBucketNotEmpty deletes all objects from bucket and deletes this bucket.
+// On success, it returns only the number of deleted objects and the number of
+// bytes freed on storage nodes.
+// When progress isn't nil, it's called with the number of objects deleted so far.
+// When skipPieces is set, the pieces of the deleted objects are left on the storage nodes.
+// Buckets with more than Config.MaxDeleteAllObjects objects aren't deleted.
+//
+// It's safe to repeat after an interruption: a repeated call deletes the objects,
+// which are left, and then the bucket. The bucket being deleted by a concurrent
+// repeated call isn't an error.
+func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte, skipPieces bool, progress func(context.Context, int64) error) ([]byte, int64, int64, error) {
+	if err := endpoint.ensureNoLockedObjects(ctx, projectID, bucketName); err != nil {
+		return nil, 0, 0, err
+	}
+
+	if endpoint.config.MaxDeleteAllObjects > 0 {
+		count, err := endpoint.countDeleteAllObjects(ctx, projectID, bucketName)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if err := endpoint.checkMaxDeleteAllObjects(count); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+
+	if endpoint.config.BucketSoftDelete.Enabled {
+		// objects are kept, so they can be restored with the bucket,
+		// they are deleted with the bucket once the retention window has passed.
+		loggingSources := endpoint.bucketLoggingSources(ctx, projectID, bucketName)
+		err := endpoint.buckets.SoftDeleteBucket(ctx, bucketName, projectID, endpoint.clock())
+		if err != nil {
+			if storj.ErrBucketNotFound.Has(err) {
+				return bucketName, 0, 0, nil
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, 0, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		endpoint.invalidateBucketLogging(projectID, append(loggingSources, bucketName)...)
+		return bucketName, 0, 0, nil
+	}
+
+	var deletedCount, freedBytes int64
+	for round := 1; ; round++ {
+		roundProgress := progress
+		if progress != nil {
+			deletedBefore := deletedCount
+			roundProgress = func(ctx context.Context, deleted int64) error {
+				return progress(ctx, deletedBefore+deleted)
+			}
+		}
+
+		deleted, freed, err := endpoint.deleteBucketObjects(ctx, projectID, bucketName, skipPieces, roundProgress)
+		deletedCount += deleted
+		freedBytes += freed
+		if err != nil {
+			if errors.Is(err, errDeleteDeadline) {
+				return nil, deletedCount, freedBytes, rpcstatus.Wrap(rpcstatus.DeadlineExceeded, &BucketDeleteIncompleteError{DeletedObjectsCount: deletedCount})
+			}
+			if errs2.IsCanceled(err) {
+				return nil, deletedCount, freedBytes, rpcstatus.Error(rpcstatus.Canceled, err.Error())
+			}
+			var piecesErr *BucketPiecesNotDeletedError
+			if errors.As(err, &piecesErr) {
+				piecesErr.DeletedObjectsCount = deletedCount
+				return nil, deletedCount, freedBytes, rpcstatus.Wrap(rpcstatus.Aborted, piecesErr)
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, 0, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+
+		err = endpoint.deleteBucket(ctx, bucketName, projectID)
+		switch {
+		case err == nil:
+			return bucketName, deletedCount, freedBytes, nil
+		case storj.ErrBucketNotFound.Has(err):
+			// a retry of the same request, which is still running, deleted the bucket first.
+			return bucketName, deletedCount, freedBytes, nil
+		case !ErrBucketNotEmpty.Has(err):
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, deletedCount, freedBytes, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		case round >= deleteAllRounds:
+			// objects kept being committed while they were deleted.
+			return nil, deletedCount, freedBytes, rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it's being used by another process")
+		}
+
+		// objects were committed while the others were deleted, e.g. by a retry of an
+		// interrupted upload or by a retry of this request, so they're deleted as well.
+		mon.Meter("delete_all_extra_round").Mark(1)
+	}
+}
+
+// ensureNoLockedObjects returns a FailedPrecondition error when object lock is
+// enabled for the bucket and it contains objects that are still retained.
+func (endpoint *Endpoint) ensureNoLockedObjects(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if !bucket.ObjectLockEnabled {
+		return nil
+	}
+
+	locked, err := endpoint.bucketObjects.BucketHasObjectsCreatedAfter(ctx, metabase.BucketHasObjectsCreatedAfter{
+		ProjectID:    projectID,
+		BucketName:   string(bucketName),
+		CreatedAfter: bucket.DefaultRetention.LockedSince(endpoint.clock()),
+	})
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if locked {
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it contains objects locked by object lock")
+	}
+	return nil
+}
+
+// deleteBucketObjects deletes all objects in a bucket, it stops early when the
+// request deadline is within the configured margin. It returns the number of
+// deleted objects and the total size of the deleted segments, whose pieces were
+// deleted from the storage nodes, summed over all batches. When skipPieces is set,
+// the pieces are left on the storage nodes and no bytes are freed.
+//
+// Failures to delete pieces are counted and logged. In the strict piece deletion
+// mode, it stops with a BucketPiecesNotDeletedError, once more segments than
+// tolerated failed.
+func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uuid.UUID, bucketName []byte, skipPieces bool, progress func(context.Context, int64) error) (_ int64, freedBytes int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleter := concurrentPiecesDeleter{
+		deleter:     endpoint.bucketObjects,
+		concurrency: endpoint.config.DeleteObjectsConcurrency,
+	}
+
+	var failedSegments int64
+	deletePieces := func(ctx context.Context, deleted []metabase.DeletedSegmentInfo) error {
+		if err := endpoint.deleteSegmentPieces(ctx, deleted); err != nil {
+			mon.Counter("bucket_delete_failed_piece_deletions").Inc(int64(len(deleted)))
+			failed := atomic.AddInt64(&failedSegments, int64(len(deleted)))
+			pieceDeletion := endpoint.config.BucketPieceDeletion
+			if pieceDeletion.Mode == PieceDeletionStrict && failed > pieceDeletion.MaxFailures {
+				return errTooManyPieceDeletionFailures
+			}
+			return nil
+		}
+
+		var size int64
+		for _, segment := range deleted {
+			size += int64(segment.EncryptedSize)
+		}
+		atomic.AddInt64(&freedBytes, size)
+		return nil
+	}
+	if skipPieces {
+		deletePieces = func(ctx context.Context, deleted []metabase.DeletedSegmentInfo) error { return nil }
+	}
+
+	bucketLocation := metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}
+	deleted, err := deleteBucketObjectsWithDeadline(ctx, deleter, metabase.DeleteBucketObjects{
+		Bucket:       bucketLocation,
+		DeletePieces: deletePieces,
+		Progress:     progress,
+	}, endpoint.config.DeleteDeadlineMargin)
+	if errors.Is(err, errTooManyPieceDeletionFailures) {
+		return deleted, freedBytes, &BucketPiecesNotDeletedError{
+			DeletedObjectsCount: deleted,
+			FailedSegments:      atomic.LoadInt64(&failedSegments),
+			MaxFailures:         endpoint.config.BucketPieceDeletion.MaxFailures,
+		}
+	}
+	return deleted, freedBytes, err
+}
+
+// skipPieceDeletion returns whether the pieces of the objects deleted by the request
+// are left on the storage nodes, which only tests are allowed to request.
+func (endpoint *Endpoint) skipPieceDeletion(req *BucketDeleteRequest) bool {
+	return req.SkipPieceDeletion && endpoint.config.TestingAllowSkipPieceDeletion
+}
+
+// BucketRestoreRequest is a request for RestoreBucket.
+type BucketRestoreRequest struct {
+	Header *pb.RequestHeader
+	Name   []byte
+}
+
+// BucketRestoreResponse is a response for RestoreBucket.
+type BucketRestoreResponse struct {
+	Bucket *pb.Bucket
+}
+
+// RestoreBucket restores a soft-deleted bucket, together with its objects,
+// when the retention window hasn't passed yet.
+func (endpoint *Endpoint) RestoreBucket(ctx context.Context, req *BucketRestoreRequest) (resp *BucketRestoreResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	if !endpoint.config.BucketSoftDelete.Enabled {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "bucket soft-delete is disabled")
+	}
+
+	now := endpoint.clock()
+
+	var canRead bool
+
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionRead,
+				Bucket: req.Name,
+				Time:   now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.Name)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedAfter := now.Add(-endpoint.config.BucketSoftDelete.RetentionWindow)
+	err = endpoint.buckets.RestoreBucket(ctx, req.Name, keyInfo.ProjectID, deletedAfter)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "bucket not found or it can no longer be restored")
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionRestore)
+	endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventCreated)
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketRestoreResponse{Bucket: convBucket}, nil
+}
+
+// BucketDeleteStatus describes the outcome of deleting a single bucket in a batch.
+type BucketDeleteStatus int
+
+const (
+	// BucketDeleted means the bucket was deleted.
+	BucketDeleted BucketDeleteStatus = iota
+	// BucketDeleteNotFound means the bucket did not exist.
+	BucketDeleteNotFound
+	// BucketDeleteNotEmpty means the bucket was not deleted because it contains objects.
+	BucketDeleteNotEmpty
+	// BucketDeleteFailed means the bucket could not be deleted because of another error.
+	BucketDeleteFailed
+	// BucketDeletePermissionDenied means the API key is not allowed to delete the bucket.
+	BucketDeletePermissionDenied
+	// BucketDeleteProcessed means the delete was attempted, but the outcome is not
+	// revealed because the API key has neither Read, nor List permission for the bucket.
+	BucketDeleteProcessed
+)
+
+// BatchDeleteBucketsRequest is a request to delete multiple buckets at once.
+type BatchDeleteBucketsRequest struct {
+	Header    *pb.RequestHeader
+	Names     [][]byte
+	DeleteAll bool
+}
+
+// BatchDeleteBucketResult is the result of deleting a single bucket in a batch.
+type BatchDeleteBucketResult struct {
+	Name                []byte
+	Status              BucketDeleteStatus
+	DeletedObjectsCount int64
+	Error               string
+}
+
+// BatchDeleteBucketsResponse is a response for BatchDeleteBuckets.
+type BatchDeleteBucketsResponse struct {
+	Results []BatchDeleteBucketResult
+}
+
+// BatchDeleteBuckets deletes multiple buckets. Each bucket is handled
+// independently, so failing to delete one bucket doesn't fail the whole batch.
+// Names that occur more than once in a batch are deleted only once and share
+// the result.
+func (endpoint *Endpoint) BatchDeleteBuckets(ctx context.Context, req *BatchDeleteBucketsRequest) (resp *BatchDeleteBucketsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	if endpoint.config.MaxBatchDeleteBuckets <= 0 {
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "batch bucket deletion is disabled")
+	}
+	if len(req.Names) > endpoint.config.MaxBatchDeleteBuckets {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "number of buckets (%d) exceeds the batch limit (%d)", len(req.Names), endpoint.config.MaxBatchDeleteBuckets)
+	}
+
+	key, keyInfo, err := endpoint.validateBasic(ctx, req.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	now := endpoint.clock()
+	permitted := func(op macaroon.ActionType, bucketName []byte) bool {
+		return key.Check(ctx, keyInfo.Secret, macaroon.Action{
+			Op:     op,
+			Bucket: bucketName,
+			Time:   now,
+		}, endpoint.revocations) == nil
+	}
+
+	resp = &BatchDeleteBucketsResponse{
+		Results: make([]BatchDeleteBucketResult, len(req.Names)),
+	}
+	processed := make(map[string]int, len(req.Names))
+	for i, name := range req.Names {
+		if first, ok := processed[string(name)]; ok {
+			resp.Results[i] = resp.Results[first]
+			continue
+		}
+		processed[string(name)] = i
+
+		if err := endpoint.validateBucket(ctx, name); err != nil {
+			resp.Results[i] = BatchDeleteBucketResult{
+				Name:   name,
+				Status: BucketDeleteFailed,
+				Error:  err.Error(),
+			}
+			continue
+		}
+
+		if !permitted(macaroon.ActionDelete, name) {
+			resp.Results[i] = BatchDeleteBucketResult{
+				Name:   name,
+				Status: BucketDeletePermissionDenied,
+				Error:  "Unauthorized API credentials",
+			}
+			continue
+		}
+
+		canRead := permitted(macaroon.ActionRead, name)
+		canList := permitted(macaroon.ActionList, name)
+
+		bucketName, err := endpoint.resolveBucketName(ctx, name, keyInfo.ProjectID)
+		if err != nil {
+			resp.Results[i] = BatchDeleteBucketResult{
+				Name:   name,
+				Status: BucketDeleteFailed,
+				Error:  err.Error(),
+			}
+			continue
+		}
+
+		result := endpoint.batchDeleteBucket(ctx, keyInfo.ProjectID, bucketName, req.DeleteAll, canList)
+		result.Name = name
+		if result.Status == BucketDeleted {
+			endpoint.trackBucketDeleted(req.Header, keyInfo)
+			endpoint.auditBucket(keyInfo, bucketName, buckets.AuditActionDelete)
+			endpoint.notifyBucketWebhook(keyInfo.ProjectID, bucketName, BucketWebhookEventDeleted)
+			endpoint.notifyBucketWatchers(keyInfo.ProjectID, bucketName, BucketWebhookEventDeleted)
+		}
+		if !canRead && !canList {
+			// No info is returned if neither Read, nor List permission is granted.
+			result = BatchDeleteBucketResult{
+				Name:   name,
+				Status: BucketDeleteProcessed,
+			}
+		}
+		resp.Results[i] = result
+	}
+
+	return resp, nil
+}
+
+// batchDeleteBucket deletes a single bucket as part of BatchDeleteBuckets.
+func (endpoint *Endpoint) batchDeleteBucket(ctx context.Context, projectID uuid.UUID, bucketName []byte, deleteAll, canList bool) BatchDeleteBucketResult {
+	result := BatchDeleteBucketResult{Name: bucketName}
+
+	err := endpoint.deleteBucket(ctx, bucketName, projectID)
+	switch {
+	case err == nil:
+		result.Status = BucketDeleted
+	case storj.ErrBucketNotFound.Has(err):
+		result.Status = BucketDeleteNotFound
+	case ErrBucketNotEmpty.Has(err):
+		result.Status = BucketDeleteNotEmpty
+		if !deleteAll {
+			return result
+		}
+		if !canList {
+			result.Error = "List permission is required to delete all objects in a bucket"
+			return result
+		}
+
+		_, deletedObjCount, _, err := endpoint.deleteBucketNotEmpty(ctx, projectID, bucketName, false, nil)
+		result.DeletedObjectsCount = deletedObjCount
+		if err != nil {
+			result.Status = BucketDeleteFailed
+			result.Error = err.Error()
+			return result
+		}
+		result.Status = BucketDeleted
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		result.Status = BucketDeleteFailed
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// BucketRenameRequest is a request for RenameBucket.
+type BucketRenameRequest struct {
+	Header  *pb.RequestHeader
+	Name    []byte
+	NewName []byte
+}
+
+// BucketRenameResponse is a response for RenameBucket.
+type BucketRenameResponse struct {
+	Bucket *pb.Bucket
+}
+
+// RenameBucket renames an empty bucket.
+//
+// Object keys are encrypted with keys derived from the bucket name, which the satellite
+// doesn't know, so the objects can't be re-encrypted for the new name. Buckets with
+// objects, including pending ones, are refused with FailedPrecondition.
+//
+// The bucket and its objects are in different databases, so the rename can't be atomic.
+// It's done in two phases, see buckets.Rename: when the rename fails after the bucket
+// got its new name, repeating the request resumes moving the objects, which were
+// committed while the bucket was renamed.
+func (endpoint *Endpoint) RenameBucket(ctx context.Context, req *BucketRenameRequest) (resp *BucketRenameResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := endpoint.clock()
+	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.NewName,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(ctx, req.NewName)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	err = endpoint.validateBucketNotReserved(req.NewName)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	rename, err := endpoint.buckets.GetBucketRename(ctx, keyInfo.ProjectID, req.Name)
+	switch {
+	case err == nil:
+		if !bytes.Equal(rename.NewName, req.NewName) {
+			return nil, rpcstatus.Errorf(rpcstatus.FailedPrecondition, "bucket is already being renamed to %q", rename.NewName)
+		}
+		mon.Meter("bucket_rename_resumed").Mark(1)
+	case buckets.ErrBucketRenameNotFound.Has(err):
+		existingName, err := endpoint.resolveBucketName(ctx, req.NewName, keyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		// a new name, which differs only in case, refers to the renamed bucket itself
+		exists := false
+		if !bytes.Equal(existingName, req.Name) || bytes.Equal(req.NewName, req.Name) {
+			exists, err = endpoint.bucketStore.HasBucket(ctx, existingName, keyInfo.ProjectID)
+			if err != nil {
+				endpoint.logger(ctx).Error("internal", zap.Error(err))
+				return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+		}
+		if exists {
+			return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists")
+		}
+
+		err = endpoint.checkBucketNotFrozen(ctx, req.Name, keyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		empty, err := endpoint.isBucketEmpty(ctx, keyInfo.ProjectID, req.Name)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if !empty {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "only empty buckets can be renamed, object keys are encrypted with keys derived from the bucket name")
+		}
+
+		loggingSources := endpoint.bucketLoggingSources(ctx, keyInfo.ProjectID, req.Name)
+		err = endpoint.buckets.StartBucketRename(ctx, keyInfo.ProjectID, req.Name, req.NewName)
+		if err != nil {
+			switch {
+			case storj.ErrBucketNotFound.Has(err):
+				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			case buckets.ErrBucketAlreadyExists.Has(err):
+				// HasBucket doesn't report soft-deleted buckets, but their names remain taken.
+				return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists or was deleted and can still be restored")
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		endpoint.invalidateBucketLogging(keyInfo.ProjectID, append(loggingSources, req.Name)...)
+		endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionDelete)
+		endpoint.auditBucket(keyInfo, req.NewName, buckets.AuditActionCreate)
+		endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+		endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.NewName, BucketWebhookEventCreated)
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	_, err = endpoint.bucketObjects.MoveBucketObjects(ctx, metabase.MoveBucketObjects{
+		Bucket:        metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Name)},
+		NewBucketName: string(req.NewName),
+	})
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to move bucket objects",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.ByteString("Bucket", req.Name),
+			zap.ByteString("New Bucket", req.NewName),
+			zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to move bucket objects, repeat the request to resume the rename")
+	}
+
+	err = endpoint.buckets.FinishBucketRename(ctx, keyInfo.ProjectID, req.Name)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, req.NewName, keyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketRenameResponse{Bucket: convBucket}, nil
+}
+
+// BucketTransferRequest is a request for TransferBucket.
+type BucketTransferRequest struct {
+	// Header authorizes the request in the project of the bucket.
+	Header *pb.RequestHeader
+	// DestinationHeader authorizes the request in the project the bucket is transferred to.
+	DestinationHeader *pb.RequestHeader
+	Name              []byte
+}
+
+// BucketTransferResponse is a response for TransferBucket.
+type BucketTransferResponse struct {
+	Bucket *pb.Bucket
+}
+
+// TransferBucket moves a bucket together with its objects to another project of the same
+// account, without copying them. The bucket keeps its name.
+//
+// The bucket and its objects are in different databases, so the transfer can't be atomic.
+// It's done in two phases, see buckets.Transfer: the bucket moves to the destination
+// project atomically with its attribution, and when moving its objects fails afterwards,
+// repeating the request resumes it. Until then some objects may still be listed only in
+// the source project. The usage accounted before the transfer stays with the source project.
+//
+// Object keys are encrypted with keys derived from the bucket name, so the objects stay
+// accessible in the destination project with the same encryption passphrase.
+func (endpoint *Endpoint) TransferBucket(ctx context.Context, req *BucketTransferRequest) (resp *BucketTransferResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := endpoint.clock()
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name, err = endpoint.resolveBucketName(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DestinationHeader == nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "destination header missing")
+	}
+	destKeyInfo, err := endpoint.validateAuth(ctx, req.DestinationHeader, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Name,
+		Time:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if keyInfo.ProjectID == destKeyInfo.ProjectID {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "bucket is already in the destination project")
+	}
+
+	err = endpoint.checkSameAccount(ctx, keyInfo.ProjectID, destKeyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := endpoint.buckets.GetBucketTransfer(ctx, keyInfo.ProjectID, req.Name)
+	switch {
+	case err == nil:
+		if transfer.NewProjectID != destKeyInfo.ProjectID {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "bucket is already being transferred to another project")
+		}
+		mon.Meter("bucket_transfer_resumed").Mark(1)
+	case buckets.ErrBucketTransferNotFound.Has(err):
+		destName, err := endpoint.resolveBucketName(ctx, req.Name, destKeyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := endpoint.bucketStore.HasBucket(ctx, destName, destKeyInfo.ProjectID)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		if exists {
+			return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists in the destination project")
+		}
+
+		err = endpoint.checkBucketLimit(ctx, destKeyInfo.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		loggingSources := endpoint.bucketLoggingSources(ctx, keyInfo.ProjectID, req.Name)
+		err = endpoint.buckets.StartBucketTransfer(ctx, keyInfo.ProjectID, req.Name, destKeyInfo.ProjectID)
+		if err != nil {
+			switch {
+			case storj.ErrBucketNotFound.Has(err):
+				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+			case buckets.ErrBucketAlreadyExists.Has(err):
+				// HasBucket doesn't report soft-deleted buckets, but their names remain taken.
+				return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists or was deleted and can still be restored in the destination project")
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		endpoint.invalidateBucketLogging(keyInfo.ProjectID, append(loggingSources, req.Name)...)
+		endpoint.auditBucket(keyInfo, req.Name, buckets.AuditActionDelete)
+		endpoint.auditBucket(destKeyInfo, req.Name, buckets.AuditActionCreate)
+		endpoint.notifyBucketWatchers(keyInfo.ProjectID, req.Name, BucketWebhookEventDeleted)
+		endpoint.notifyBucketWatchers(destKeyInfo.ProjectID, req.Name, BucketWebhookEventCreated)
+	default:
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	_, err = endpoint.bucketObjects.TransferBucketObjects(ctx, metabase.TransferBucketObjects{
+		Bucket:       metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Name)},
+		NewProjectID: destKeyInfo.ProjectID,
+	})
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to transfer bucket objects",
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Stringer("New Project ID", destKeyInfo.ProjectID),
+			zap.ByteString("Bucket", req.Name),
+			zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to transfer bucket objects, repeat the request to resume the transfer")
+	}
+
+	err = endpoint.buckets.FinishBucketTransfer(ctx, keyInfo.ProjectID, req.Name)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, req.Name, destKeyInfo.ProjectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	rs, err := endpoint.projectRedundancyScheme(ctx, destKeyInfo.ProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &BucketTransferResponse{Bucket: convBucket}, nil
+}
+
+// checkSameAccount checks that both projects are owned by the same user.
+func (endpoint *Endpoint) checkSameAccount(ctx context.Context, projectID, otherProjectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := endpoint.projects.Get(ctx, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	otherProject, err := endpoint.projects.Get(ctx, otherProjectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	if project.OwnerID != otherProject.OwnerID {
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "projects belong to different accounts")
+	}
+	return nil
+}
+
+// ListBuckets returns buckets in a project where the bucket name matches the request cursor.
+// An API key restricted to specific buckets lists only those, allowed buckets ending
+// with buckets.AllowedPrefixWildcard allow all buckets with the preceding prefix.
+func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListRequest) (resp *pb.BucketListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, _, err = withTraceID(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := endpoint.listBuckets(ctx, &BucketListRequest{
+		Header:    req.Header,
+		Cursor:    req.Cursor,
+		Limit:     req.Limit,
+		Direction: req.Direction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BucketListResponse{
+		Items: list.Items,
+		More:  list.More,
+	}, nil
+}
+
+// BucketListStream is the stream ListBucketsStream sends the buckets to.
+type BucketListStream interface {
+	Context() context.Context
+	Send(*pb.BucketListItem) error
+}
+
+// listBucketsStreamPageSize is the default size of the pages ListBucketsStream lists.
+const listBucketsStreamPageSize = 1000
+
+// ListBucketsStream sends all the buckets of a project, starting after the request cursor,
+// without the client having to page through them. The request limit sets the size of the
+// pages listed from the database, not the number of buckets sent.
+//
+// Cancelling the stream context stops the listing after the current bucket.
+func (endpoint *Endpoint) ListBucketsStream(req *pb.BucketListRequest, stream BucketListStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+	defer func() { markBucketOutcome(bucketOpList, err) }()
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	authDone := measureBucketPhase(bucketOpList, bucketPhaseAuth)
+	keyInfo, action, err := endpoint.validateListBuckets(ctx, req.Header)
+	if err != nil {
+		authDone(err)
+		return err
+	}
+
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
+	authDone(err)
+	if err != nil {
+		return err
+	}
+
+	pageSize := int(req.Limit)
+	if pageSize <= 0 {
+		pageSize = listBucketsStreamPageSize
+	}
+	listOpts := storj.BucketListOptions{
+		Cursor:    string(req.Cursor),
+		Limit:     pageSize,
+		Direction: storj.ListDirection(req.Direction),
+	}
+	if listOpts.Direction == 0 {
+		listOpts.Direction = storj.After
+	}
+
+	for {
+		dbDone := measureBucketPhase(bucketOpList, bucketPhaseDB)
+		bucketList, err := endpoint.bucketStore.ListBuckets(endpoint.bucketReadContext(ctx), keyInfo.ProjectID, buckets.ListOptions{
+			BucketListOptions: listOpts,
+		}, allowedBuckets)
+		dbDone(err)
+		if err != nil {
+			if errs2.IsCanceled(err) {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+
+		for _, item := range bucketList.Items {
+			if err := ctx.Err(); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+			err := stream.Send(&pb.BucketListItem{
+				Name:      []byte(item.Name),
+				CreatedAt: item.Created,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !bucketList.More || len(bucketList.Items) == 0 {
+			return nil
+		}
+		listOpts = listOpts.NextPage(bucketList)
+	}
+}
+
+// BucketWatchRequest is a request for WatchBuckets.
+type BucketWatchRequest struct {
+	Header *pb.RequestHeader
+}
+
+// BucketWatchStream is the stream WatchBuckets sends the bucket events to.
+type BucketWatchStream interface {
+	Context() context.Context
+	Send(*BucketWatchEvent) error
+}
+
+// WatchBuckets sends the buckets of a project as BucketWatchEventExisting events,
+// followed by BucketWatchEventSynced, and then the buckets created and deleted in
+// the project as it happens, until the stream context is canceled. Only the buckets,
+// which the API key is allowed to list, are sent.
+//
+// The events are published by the API instance, which handles the request, so the
+// buckets created and deleted through other instances, or directly in the database,
+// are only sent once the stream lists the buckets again, every
+// metainfo.bucket-watch.resync-interval. Buckets, which are created and deleted
+// in between, aren't sent. Soft-deleted buckets are sent as deleted, removing them
+// once the retention window has passed doesn't send another event.
+//
+// When the client falls too far behind, the stream fails with ResourceExhausted
+// and the client should watch again.
+func (endpoint *Endpoint) WatchBuckets(req *BucketWatchRequest, stream BucketWatchStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, action, err := endpoint.validateListBuckets(ctx, req.Header)
+	if err != nil {
+		return err
+	}
+
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
+	if err != nil {
+		return err
+	}
+
+	// watching starts before the snapshot is listed, so no event is missed
+	watcher, stop := endpoint.bucketWatchers.Watch(keyInfo.ProjectID, allowedBuckets)
+	defer stop()
+
+	snapshot, err := endpoint.listWatchedBuckets(ctx, keyInfo.ProjectID, allowedBuckets)
+	if err != nil {
+		return err
+	}
+
+	// known are the buckets the client was sent, so the events, which repeat
+	// the snapshot or the resync, aren't sent again.
+	known := make(map[string]struct{}, len(snapshot))
+	for _, bucket := range snapshot {
+		err := stream.Send(&BucketWatchEvent{
+			Event:     BucketWatchEventExisting,
+			Name:      []byte(bucket.Name),
+			Timestamp: bucket.Created,
+		})
+		if err != nil {
+			return err
+		}
+		known[bucket.Name] = struct{}{}
+	}
+
+	if err := stream.Send(&BucketWatchEvent{Event: BucketWatchEventSynced}); err != nil {
+		return err
+	}
+
+	send := func(event BucketWatchEvent) error {
+		_, ok := known[string(event.Name)]
+		switch event.Event {
+		case BucketWebhookEventCreated:
+			if ok {
+				return nil
+			}
+			known[string(event.Name)] = struct{}{}
+		case BucketWebhookEventDeleted:
+			if !ok {
+				return nil
+			}
+			delete(known, string(event.Name))
+		}
+		return stream.Send(&event)
+	}
+
+	var resync <-chan time.Time
+	if interval := endpoint.config.BucketWatch.ResyncInterval; interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		resync = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return rpcstatus.Wrap(rpcstatus.Canceled, ctx.Err())
+		case <-watcher.overflowed:
+			mon.Meter("bucket_watch_overflowed").Mark(1)
+			return rpcstatus.Error(rpcstatus.ResourceExhausted, "too many bucket events are waiting to be sent, watch again")
+		case event := <-watcher.events:
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-resync:
+			current, err := endpoint.listWatchedBuckets(ctx, keyInfo.ProjectID, allowedBuckets)
+			if err != nil {
+				return err
+			}
+			for _, event := range diffWatchedBuckets(known, current, endpoint.clock()) {
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// listWatchedBuckets lists the allowed buckets of the project for WatchBuckets.
+// They're listed from the primary, since the read replica may lag behind the
+// events, which are sent after them.
+func (endpoint *Endpoint) listWatchedBuckets(ctx context.Context, projectID uuid.UUID, allowedBuckets macaroon.AllowedBuckets) (_ []storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var list []storj.Bucket
+	listOpts := storj.BucketListOptions{
+		Limit:     listBucketsStreamPageSize,
+		Direction: storj.After,
+	}
+	for {
+		bucketList, err := endpoint.bucketStore.ListBuckets(ctx, projectID, buckets.ListOptions{
+			BucketListOptions: listOpts,
+		}, allowedBuckets)
+		if err != nil {
+			if errs2.IsCanceled(err) {
+				return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		list = append(list, bucketList.Items...)
+
+		if !bucketList.More || len(bucketList.Items) == 0 {
+			return list, nil
+		}
+		listOpts = listOpts.NextPage(bucketList)
+	}
+}
+
+// BucketListRequest is a request for listing buckets, extending
+// pb.BucketListRequest with options that aren't part of the protocol yet.
+type BucketListRequest struct {
+	Header    *pb.RequestHeader
+	Cursor    []byte
+	Limit     int32
+	Direction int32
+
+	// Prefix restricts the listing to buckets whose name starts with it.
+	Prefix []byte
+
+	// OrderBy is the order of the listing. It defaults to ordering by name.
+	OrderBy buckets.BucketOrder
+	// CursorCreatedAt is the creation time of the cursor bucket, when ordering by creation time.
+	CursorCreatedAt time.Time
+
+	// ModifiedSince, when set, lists only the buckets modified after it.
+	// Creating a bucket counts as modifying it.
+	ModifiedSince time.Time
+
+	// IncludeEmptyStatus makes the response report whether each of the buckets is empty.
+	IncludeEmptyStatus bool
+	// IncludeStats makes ListBucketsDetailed report the number of objects in each
+	// of the buckets and their total size. It requires an additional metabase query.
+	IncludeStats bool
+
+	// ContinuationToken continues the listing, which returned it. The cursor, prefix,
+	// order and ModifiedSince of the listing are taken from the token, so it can't be
+	// used with Cursor.
+	ContinuationToken []byte
+
+	// TraceID correlates the logs and traces of the request, it's generated when
+	// it isn't set. See TraceIDMetadataKey.
+	TraceID string
+}
+
+// BucketListResponse is the response for BucketListRequest.
+type BucketListResponse struct {
+	Items []*pb.BucketListItem
+	More  bool
+	// ContinuationToken continues the listing with the next page, it's set when there are more buckets.
+	ContinuationToken []byte
+
+	// Empty reports whether the bucket of the item with the same index has no objects.
+	// It's only set when IncludeEmptyStatus is requested.
+	Empty []bool
+
+	// TraceID is the trace id of the request.
+	TraceID string
+}
+
+// ListBucketsInfo returns buckets in a project where the bucket name matches the request prefix.
+func (endpoint *Endpoint) ListBucketsInfo(ctx context.Context, req *BucketListRequest) (resp *BucketListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	ctx, traceID, err := withTraceID(ctx, req.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkLegacyBucketCursor(req); err != nil {
+		return nil, err
+	}
+
+	resp, err = endpoint.listBuckets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.TraceID = traceID
+	return resp, nil
+}
+
+func (endpoint *Endpoint) listBuckets(ctx context.Context, req *BucketListRequest) (resp *BucketListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { markBucketOutcome(bucketOpList, err) }()
+
+	authDone := measureBucketPhase(bucketOpList, bucketPhaseAuth)
+	keyInfo, action, err := endpoint.validateListBuckets(ctx, req.Header)
+	if err != nil {
+		authDone(err)
+		return nil, err
+	}
+
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
+	authDone(err)
+	if err != nil {
+		return nil, err
+	}
+
+	listOpts, err := endpoint.bucketListOptions(ctx, keyInfo.ProjectID, req, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	dbDone := measureBucketPhase(bucketOpList, bucketPhaseDB)
+	bucketList, err := endpoint.bucketStore.ListBuckets(endpoint.bucketReadContext(ctx), keyInfo.ProjectID, listOpts, allowedBuckets)
+	dbDone(err)
+	if err != nil {
+		return nil, err
+	}
+
+	conversionDone := measureBucketPhase(bucketOpList, bucketPhaseProtoConversion)
+	bucketItems := make([]*pb.BucketListItem, len(bucketList.Items))
+	for i, item := range bucketList.Items {
+		bucketItems[i] = &pb.BucketListItem{
+			Name:      []byte(item.Name),
+			CreatedAt: item.Created,
+		}
+	}
+	conversionDone(nil)
+
+	var empty []bool
+	if req.IncludeEmptyStatus {
+		empty, err = endpoint.bucketsEmpty(ctx, keyInfo.ProjectID, bucketList.Items)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+	}
+
+	resp = &BucketListResponse{
+		Items: bucketItems,
+		More:  bucketList.More,
+		Empty: empty,
+	}
+	if bucketList.More && len(bucketList.Items) > 0 {
+		last := bucketList.Items[len(bucketList.Items)-1]
+		resp.ContinuationToken, err = endpoint.bucketListToken(ctx, keyInfo.ProjectID, listOpts, []byte(last.Name), last.Created)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+// checkLegacyBucketCursor rejects the bucket name cursor, when only continuation tokens are accepted.
+func (endpoint *Endpoint) checkLegacyBucketCursor(req *BucketListRequest) error {
+	if len(req.Cursor) > 0 && !endpoint.config.ListBucketsLegacyCursor {
+		return rpcstatus.Error(rpcstatus.InvalidArgument, "bucket name cursors aren't accepted anymore, use the continuation token")
+	}
+	return nil
+}
+
+// bucketListOptions returns the listing options of the request. A request with
+// a continuation token continues the listing, which returned the token.
+func (endpoint *Endpoint) bucketListOptions(ctx context.Context, projectID uuid.UUID, req *BucketListRequest, limit int) (_ buckets.ListOptions, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(req.ContinuationToken) == 0 {
+		return buckets.ListOptions{
+			BucketListOptions: storj.BucketListOptions{
+				Cursor:    string(req.Cursor),
+				Limit:     limit,
+				Direction: storj.ListDirection(req.Direction),
+			},
+			Prefix:          string(req.Prefix),
+			OrderBy:         req.OrderBy,
+			CursorCreatedAt: req.CursorCreatedAt,
+			ModifiedSince:   req.ModifiedSince,
+		}, nil
+	}
+
+	if len(req.Cursor) > 0 {
+		return buckets.ListOptions{}, rpcstatus.Error(rpcstatus.InvalidArgument, "cursor and continuation token can't be used together")
+	}
+
+	token, err := decodeBucketListToken(ctx, endpoint.satellite, req.ContinuationToken)
+	if err != nil {
+		return buckets.ListOptions{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if token.ProjectID != projectID {
+		return buckets.ListOptions{}, rpcstatus.Error(rpcstatus.InvalidArgument, ErrInvalidContinuationToken.New("issued for a different project").Error())
+	}
+	if endpoint.clock().After(token.IssuedAt.Add(endpoint.config.ListBucketsTokenExpiration)) {
+		return buckets.ListOptions{}, rpcstatus.Error(rpcstatus.InvalidArgument, ErrInvalidContinuationToken.New("expired").Error())
+	}
+
+	return buckets.ListOptions{
+		BucketListOptions: storj.BucketListOptions{
+			Cursor:    string(token.Cursor),
+			Limit:     limit,
+			Direction: storj.After,
+		},
+		Prefix:          string(token.Prefix),
+		OrderBy:         token.OrderBy,
+		CursorCreatedAt: token.CursorCreatedAt,
+		ModifiedSince:   token.ModifiedSince,
+	}, nil
+}
+
+// bucketListToken returns the continuation token of the listing, which continues after the last bucket.
+func (endpoint *Endpoint) bucketListToken(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, lastName []byte, lastCreatedAt time.Time) ([]byte, error) {
+	return encodeBucketListToken(ctx, endpoint.satellite, bucketListToken{
+		ProjectID:       projectID,
+		Prefix:          []byte(listOpts.Prefix),
+		OrderBy:         listOpts.OrderBy,
+		Cursor:          lastName,
+		CursorCreatedAt: lastCreatedAt,
+		ModifiedSince:   listOpts.ModifiedSince,
+		IssuedAt:        endpoint.clock(),
+	})
+}
+
+// bucketsEmpty returns whether each of the buckets is empty, checking all of them with a single query.
+func (endpoint *Endpoint) bucketsEmpty(ctx context.Context, projectID uuid.UUID, bucketList []storj.Bucket) (_ []bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	names := make([]string, len(bucketList))
+	for i, bucket := range bucketList {
+		names[i] = bucket.Name
+	}
+
+	emptyByName, err := endpoint.bucketObjects.BucketsEmpty(ctx, metabase.BucketsEmpty{
+		ProjectID:   projectID,
+		BucketNames: names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	empty := make([]bool, len(names))
+	for i, name := range names {
+		empty[i] = emptyByName[name]
+	}
+	return empty, nil
+}
+
+// bucketStats returns the number of committed objects and their total size for
+// each of the buckets, getting all of them with a single query.
+func (endpoint *Endpoint) bucketStats(ctx context.Context, projectID uuid.UUID, names []string) (_ []metabase.BucketStatsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	statsByName, err := endpoint.bucketObjects.BucketStatsBatch(ctx, metabase.BucketStatsBatch{
+		ProjectID:   projectID,
+		BucketNames: names,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]metabase.BucketStatsResult, len(names))
+	for i, name := range names {
+		stats[i] = statsByName[name]
 	}
+	return stats, nil
+}
 
-	// override RS to fit satellite settings
-	convBucket, err := convertBucketToProto(bucket, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
-	if err != nil {
-		return resp, err
-	}
+// BucketListDetailedItem is a bucket returned by ListBucketsDetailed.
+type BucketListDetailedItem struct {
+	Bucket *pb.Bucket
 
-	return &pb.BucketGetResponse{
-		Bucket: convBucket,
-	}, nil
+	Placement         storj.PlacementConstraint
+	ObjectLockEnabled bool
+	DefaultRetention  buckets.DefaultRetention
+	Tags              map[string]string
+	Frozen            bool
+	LastModified      time.Time
+
+	// ObjectCount and TotalSize are set only when IncludeStats was requested.
+	ObjectCount int64
+	TotalSize   int64
 }
 
-// CreateBucket creates a new bucket.
-func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (resp *pb.BucketCreateResponse, err error) {
+// BucketListDetailedResponse is a response for ListBucketsDetailed.
+type BucketListDetailedResponse struct {
+	Items []*BucketListDetailedItem
+	More  bool
+	// ContinuationToken continues the listing with the next page, it's set when there are more buckets.
+	ContinuationToken []byte
+}
+
+// ListBucketsDetailed returns buckets in a project where the bucket name matches the request prefix,
+// together with all their attributes. It uses the same cursor and prefix semantics as ListBucketsInfo,
+// but the limit is capped by ListBucketsDetailedLimit. Since the items carry what GetBucket returns,
+// it requires Read permission and returns only the buckets the key may read.
+func (endpoint *Endpoint) ListBucketsDetailed(ctx context.Context, req *BucketListRequest) (resp *BucketListDetailedResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
-	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
-		Op:     macaroon.ActionWrite,
-		Bucket: req.Name,
-		Time:   time.Now(),
-	})
-	if err != nil {
+	if err := endpoint.checkLegacyBucketCursor(req); err != nil {
 		return nil, err
 	}
 
-	err = endpoint.validateBucket(ctx, req.Name)
-	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	action := macaroon.Action{
+		Op:   macaroon.ActionRead,
+		Time: endpoint.clock(),
 	}
-
-	// checks if bucket exists before updates it or makes a new entry
-	exists, err := endpoint.buckets.HasBucket(ctx, req.GetName(), keyInfo.ProjectID)
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, action)
 	if err != nil {
-		endpoint.log.Error("internal", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if exists {
-		// When the bucket exists, try to set the attribution.
-		if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.GetName()); err != nil {
-			return nil, err
-		}
-		return nil, rpcstatus.Error(rpcstatus.AlreadyExists, "bucket already exists")
+		return nil, err
 	}
 
-	// check if project has exceeded its allocated bucket limit
-	maxBuckets, err := endpoint.projects.GetMaxBuckets(ctx, keyInfo.ProjectID)
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
 	if err != nil {
 		return nil, err
 	}
-	if maxBuckets == nil {
-		defaultMaxBuckets := endpoint.config.ProjectLimits.MaxBuckets
-		maxBuckets = &defaultMaxBuckets
+
+	limit := int(req.Limit)
+	if limit <= 0 || limit > endpoint.config.ListBucketsDetailedLimit {
+		limit = endpoint.config.ListBucketsDetailedLimit
 	}
-	bucketCount, err := endpoint.buckets.CountBuckets(ctx, keyInfo.ProjectID)
+	listOpts, err := endpoint.bucketListOptions(ctx, keyInfo.ProjectID, req, limit)
 	if err != nil {
 		return nil, err
 	}
-	if bucketCount >= *maxBuckets {
-		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, fmt.Sprintf("number of allocated buckets (%d) exceeded", endpoint.config.ProjectLimits.MaxBuckets))
+	bucketList, err := endpoint.buckets.ListMinimalBuckets(endpoint.bucketReadContext(ctx), keyInfo.ProjectID, listOpts, allowedBuckets)
+	if err != nil {
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucketReq, err := convertProtoToBucket(req, keyInfo.ProjectID)
+	rs, err := endpoint.projectRedundancyScheme(ctx, keyInfo.ProjectID)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket, err := endpoint.buckets.CreateBucket(ctx, bucketReq)
-	if err != nil {
-		endpoint.log.Error("error while creating bucket", zap.String("bucketName", bucketReq.Name), zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	items := make([]*BucketListDetailedItem, len(bucketList.Items))
+	for i, bucket := range bucketList.Items {
+		convBucket, err := convertBucketToProto(bucket, rs, endpoint.config.MaxSegmentSize)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		items[i] = &BucketListDetailedItem{
+			Bucket:            convBucket,
+			Placement:         bucket.Placement,
+			ObjectLockEnabled: bucket.ObjectLockEnabled,
+			DefaultRetention:  bucket.DefaultRetention,
+			Tags:              bucket.Tags,
+			Frozen:            bucket.Frozen,
+			LastModified:      bucket.LastModified,
+		}
 	}
 
-	// Once we have created the bucket, we can try setting the attribution.
-	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.GetName()); err != nil {
-		return nil, err
+	if req.IncludeStats {
+		names := make([]string, len(bucketList.Items))
+		for i, bucket := range bucketList.Items {
+			names[i] = string(bucket.Name)
+		}
+		stats, err := endpoint.bucketStats(ctx, keyInfo.ProjectID, names)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		for i, item := range items {
+			item.ObjectCount = stats[i].ObjectCount
+			item.TotalSize = stats[i].TotalSize
+		}
 	}
 
-	// override RS to fit satellite settings
-	convBucket, err := convertBucketToProto(buckets.Bucket{
-		Name:      []byte(bucket.Name),
-		CreatedAt: bucket.Created,
-	}, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
-	if err != nil {
-		endpoint.log.Error("error while converting bucket to proto", zap.String("bucketName", bucket.Name), zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to create bucket")
+	resp = &BucketListDetailedResponse{
+		Items: items,
+		More:  bucketList.More,
+	}
+	if bucketList.More && len(bucketList.Items) > 0 {
+		last := bucketList.Items[len(bucketList.Items)-1]
+		resp.ContinuationToken, err = endpoint.bucketListToken(ctx, keyInfo.ProjectID, listOpts, last.Name, last.CreatedAt)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
 	}
+	return resp, nil
+}
 
-	return &pb.BucketCreateResponse{
-		Bucket: convBucket,
-	}, nil
+// bucketReadContext marks ctx for serving bucket reads from the read replica,
+// when enabled. It must only be used by requests, which don't modify buckets.
+func (endpoint *Endpoint) bucketReadContext(ctx context.Context) context.Context {
+	if !endpoint.config.BucketReadsFromReplica {
+		return ctx
+	}
+	return dbreplica.WithReadOnly(ctx)
 }
 
-// DeleteBucket deletes a bucket.
-func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDeleteRequest) (resp *pb.BucketDeleteResponse, err error) {
+// validateListBuckets authorizes listing buckets, which requires List permission.
+// Bucket listing used to check Read permission instead, so while ListBucketsReadFallback
+// is enabled Read permission is still accepted. It returns the action, which was
+// permitted, to restrict the listing to the buckets allowed for it.
+func (endpoint *Endpoint) validateListBuckets(ctx context.Context, header *pb.RequestHeader) (_ *console.APIKeyInfo, _ macaroon.Action, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
-
-	now := time.Now()
-
-	var canRead, canList bool
-
-	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
-		verifyPermission{
-			action: macaroon.Action{
-				Op:     macaroon.ActionDelete,
-				Bucket: req.Name,
-				Time:   now,
-			},
-		},
-		verifyPermission{
-			action: macaroon.Action{
-				Op:     macaroon.ActionRead,
-				Bucket: req.Name,
-				Time:   now,
-			},
-			actionPermitted: &canRead,
-			optional:        true,
-		},
-		verifyPermission{
-			action: macaroon.Action{
-				Op:     macaroon.ActionList,
-				Bucket: req.Name,
-				Time:   now,
-			},
-			actionPermitted: &canList,
-			optional:        true,
-		},
-	)
-	if err != nil {
-		return nil, err
+	action := macaroon.Action{
+		Op:   macaroon.ActionList,
+		Time: endpoint.clock(),
+	}
+	if !endpoint.config.ListBucketsReadFallback {
+		keyInfo, err := endpoint.validateAuth(ctx, header, action)
+		return keyInfo, action, err
 	}
 
-	err = endpoint.validateBucket(ctx, req.Name)
+	key, keyInfo, err := endpoint.validateBasic(ctx, header)
 	if err != nil {
-		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		return nil, action, err
 	}
 
-	var (
-		bucket     buckets.Bucket
-		convBucket *pb.Bucket
-	)
-	if canRead || canList {
-		// Info about deleted bucket is returned only if either Read, or List permission is granted.
-		bucket, err = endpoint.buckets.GetMinimalBucket(ctx, req.Name, keyInfo.ProjectID)
-		if err != nil {
-			if storj.ErrBucketNotFound.Has(err) {
-				return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
-			}
-			return nil, err
-		}
+	err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
+	if err == nil {
+		return keyInfo, action, nil
+	}
 
-		convBucket, err = convertBucketToProto(bucket, endpoint.defaultRS, endpoint.config.MaxSegmentSize)
-		if err != nil {
-			return nil, err
-		}
+	action.Op = macaroon.ActionRead
+	if err := key.Check(ctx, keyInfo.Secret, action, endpoint.revocations); err != nil {
+		endpoint.logger(ctx).Debug("unauthorized request", zap.Error(err))
+		return nil, action, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	err = endpoint.deleteBucket(ctx, req.Name, keyInfo.ProjectID)
-	if err != nil {
-		if !canRead && !canList {
-			// No error info is returned if neither Read, nor List permission is granted.
-			return &pb.BucketDeleteResponse{}, nil
-		}
-		if ErrBucketNotEmpty.Has(err) {
-			// List permission is required to delete all objects in a bucket.
-			if !req.GetDeleteAll() || !canList {
-				return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
-			}
+	mon.Meter("list_buckets_read_permission_fallback").Mark(1)
+	return keyInfo, action, nil
+}
 
-			_, deletedObjCount, err := endpoint.deleteBucketNotEmpty(ctx, keyInfo.ProjectID, req.Name)
-			if err != nil {
-				return nil, err
-			}
+// BucketCountRequest is a request for CountBuckets.
+type BucketCountRequest struct {
+	Header *pb.RequestHeader
 
-			return &pb.BucketDeleteResponse{Bucket: convBucket, DeletedObjectsCount: deletedObjCount}, nil
-		}
-		if storj.ErrBucketNotFound.Has(err) {
-			return &pb.BucketDeleteResponse{Bucket: convBucket}, nil
-		}
-		endpoint.log.Error("internal", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	}
+	// Approximate accepts an approximate count for projects with more than
+	// Config.ApproximateBucketCountThreshold buckets, which is faster.
+	Approximate bool
+}
+
+// BucketCountResponse is a response for CountBuckets.
+type BucketCountResponse struct {
+	Count int64
 
-	return &pb.BucketDeleteResponse{Bucket: convBucket}, nil
+	// Approximate is set when the project has more than Count buckets, which
+	// weren't all counted.
+	Approximate bool
 }
 
-// deleteBucket deletes a bucket from the bucekts db.
-func (endpoint *Endpoint) deleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+// CountBuckets returns the number of buckets in the project, which the API key may list.
+// For an API key that isn't restricted to specific buckets, it's the number of buckets
+// counted towards the project bucket limit, otherwise the number of the allowed buckets,
+// which exist.
+//
+// When the request accepts an approximate count, at most Config.ApproximateBucketCountThreshold
+// buckets are counted, and larger projects are reported as having more than that.
+func (endpoint *Endpoint) CountBuckets(ctx context.Context, req *BucketCountRequest) (resp *BucketCountResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	empty, err := endpoint.isBucketEmpty(ctx, projectID, bucketName)
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, action, err := endpoint.validateListBuckets(ctx, req.Header)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if !empty {
-		return ErrBucketNotEmpty.New("")
+
+	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
+	if err != nil {
+		return nil, err
 	}
 
-	return endpoint.buckets.DeleteBucket(ctx, bucketName, projectID)
-}
+	if allowedBuckets.All {
+		threshold := endpoint.config.ApproximateBucketCountThreshold
+		if req.Approximate && threshold > 0 {
+			count, err := endpoint.bucketStore.CountBucketsUpTo(ctx, keyInfo.ProjectID, threshold+1)
+			if err != nil {
+				endpoint.logger(ctx).Error("internal", zap.Error(err))
+				return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			if count > threshold {
+				return &BucketCountResponse{Count: int64(threshold), Approximate: true}, nil
+			}
+			return &BucketCountResponse{Count: int64(count)}, nil
+		}
 
-// isBucketEmpty returns whether bucket is empty.
-func (endpoint *Endpoint) isBucketEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) (bool, error) {
-	empty, err := endpoint.metabase.BucketEmpty(ctx, metabase.BucketEmpty{
-		ProjectID:  projectID,
-		BucketName: string(bucketName),
-	})
-	return empty, Error.Wrap(err)
-}
+		count, err := endpoint.bucketStore.CountBuckets(ctx, keyInfo.ProjectID)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		return &BucketCountResponse{Count: int64(count)}, nil
+	}
 
-// deleteBucketNotEmpty deletes all objects from bucket and deletes this bucket.
-// On success, it returns only the number of deleted objects.
-func (endpoint *Endpoint) deleteBucketNotEmpty(ctx context.Context, projectID uuid.UUID, bucketName []byte) ([]byte, int64, error) {
-	deletedCount, err := endpoint.deleteBucketObjects(ctx, projectID, bucketName)
-	if err != nil {
-		endpoint.log.Error("internal", zap.Error(err))
-		return nil, 0, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	if len(allowedBuckets.Buckets) == 0 {
+		return &BucketCountResponse{}, nil
 	}
 
-	err = endpoint.deleteBucket(ctx, bucketName, projectID)
+	names := make([][]byte, 0, len(allowedBuckets.Buckets))
+	for name := range allowedBuckets.Buckets {
+		names = append(names, []byte(name))
+	}
+	exists, err := endpoint.buckets.HasBuckets(ctx, names, keyInfo.ProjectID)
 	if err != nil {
-		if ErrBucketNotEmpty.Has(err) {
-			return nil, deletedCount, rpcstatus.Error(rpcstatus.FailedPrecondition, "cannot delete the bucket because it's being used by another process")
-		}
-		if storj.ErrBucketNotFound.Has(err) {
-			return bucketName, 0, nil
-		}
-		endpoint.log.Error("internal", zap.Error(err))
-		return nil, deletedCount, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	return bucketName, deletedCount, nil
+	resp = &BucketCountResponse{}
+	for _, exist := range exists {
+		if exist {
+			resp.Count++
+		}
+	}
+	return resp, nil
 }
 
-// deleteBucketObjects deletes all objects in a bucket.
-func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ int64, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	bucketLocation := metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)}
-	deletedObjects, err := endpoint.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
-		Bucket: bucketLocation,
-		DeletePieces: func(ctx context.Context, deleted []metabase.DeletedSegmentInfo) error {
-			endpoint.deleteSegmentPieces(ctx, deleted)
-			return nil
-		},
-	})
+// ProjectBucketsSummaryRequest is a request for GetProjectBucketsSummary.
+type ProjectBucketsSummaryRequest struct {
+	Header *pb.RequestHeader
+}
 
-	return deletedObjects, Error.Wrap(err)
+// ProjectBucketsSummaryResponse is a response for GetProjectBucketsSummary.
+type ProjectBucketsSummaryResponse struct {
+	BucketCount int64
+	ObjectCount int64
+	TotalBytes  int64
 }
 
-// ListBuckets returns buckets in a project where the bucket name matches the request cursor.
-func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListRequest) (resp *pb.BucketListResponse, err error) {
+// GetProjectBucketsSummary returns the number of buckets in the project together with the
+// number of committed objects and their total size across these buckets. For an API key
+// restricted to specific buckets, the summary covers only the allowed buckets, which exist.
+func (endpoint *Endpoint) GetProjectBucketsSummary(ctx context.Context, req *ProjectBucketsSummaryRequest) (resp *ProjectBucketsSummaryResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
 	action := macaroon.Action{
-		// TODO: This has to be ActionList, but it seems to be set to
-		// ActionRead as a hacky workaround to make bucket listing possible.
 		Op:   macaroon.ActionRead,
-		Time: time.Now(),
+		Time: endpoint.clock(),
 	}
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, action)
 	if err != nil {
@@ -318,38 +3492,51 @@ func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListReq
 		return nil, err
 	}
 
-	listOpts := storj.BucketListOptions{
-		Cursor:    string(req.Cursor),
-		Limit:     int(req.Limit),
-		Direction: storj.ListDirection(req.Direction),
-	}
-	bucketList, err := endpoint.buckets.ListBuckets(ctx, keyInfo.ProjectID, listOpts, allowedBuckets)
-	if err != nil {
-		return nil, err
+	resp = &ProjectBucketsSummaryResponse{}
+	opts := metabase.ProjectBucketsStats{
+		ProjectID: keyInfo.ProjectID,
 	}
 
-	bucketItems := make([]*pb.BucketListItem, len(bucketList.Items))
-	for i, item := range bucketList.Items {
-		bucketItems[i] = &pb.BucketListItem{
-			Name:      []byte(item.Name),
-			CreatedAt: item.Created,
+	if allowedBuckets.All {
+		count, err := endpoint.bucketStore.CountBuckets(ctx, keyInfo.ProjectID)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		resp.BucketCount = int64(count)
+	} else {
+		if len(allowedBuckets.Buckets) == 0 {
+			return resp, nil
 		}
-	}
 
-	return &pb.BucketListResponse{
-		Items: bucketItems,
-		More:  bucketList.More,
-	}, nil
-}
+		names := make([][]byte, 0, len(allowedBuckets.Buckets))
+		for name := range allowedBuckets.Buckets {
+			names = append(names, []byte(name))
+		}
+		exists, err := endpoint.buckets.HasBuckets(ctx, names, keyInfo.ProjectID)
+		if err != nil {
+			endpoint.logger(ctx).Error("internal", zap.Error(err))
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+
+		opts.BucketNames = []string{}
+		for i, exist := range exists {
+			if exist {
+				opts.BucketNames = append(opts.BucketNames, string(names[i]))
+			}
+		}
+		resp.BucketCount = int64(len(opts.BucketNames))
+	}
 
-// CountBuckets returns the number of buckets a project currently has.
-// TODO: add this to the uplink client side.
-func (endpoint *Endpoint) CountBuckets(ctx context.Context, projectID uuid.UUID) (count int, err error) {
-	count, err = endpoint.buckets.CountBuckets(ctx, projectID)
+	stats, err := endpoint.bucketObjects.ProjectBucketsStats(ctx, opts)
 	if err != nil {
-		return 0, err
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
-	return count, nil
+	resp.ObjectCount = stats.ObjectCount
+	resp.TotalBytes = stats.TotalSize
+
+	return resp, nil
 }
 
 func getAllowedBuckets(ctx context.Context, header *pb.RequestHeader, action macaroon.Action) (_ macaroon.AllowedBuckets, err error) {
@@ -359,6 +3546,9 @@ func getAllowedBuckets(ctx context.Context, header *pb.RequestHeader, action mac
 	}
 	allowedBuckets, err := key.GetAllowedBuckets(ctx, action)
 	if err != nil {
+		if macaroon.ErrUnauthorized.Has(err) {
+			return macaroon.AllowedBuckets{}, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		}
 		return macaroon.AllowedBuckets{}, rpcstatus.Errorf(rpcstatus.Internal, "GetAllowedBuckets: %v", err)
 	}
 	return allowedBuckets, err
@@ -380,17 +3570,96 @@ func convertProtoToBucket(req *pb.BucketCreateRequest, projectID uuid.UUID) (buc
 		return bucket, errs.New("Invalid uuid")
 	}
 
+	var encryptionParameters storj.EncryptionParameters
+	if params := req.GetDefaultEncryptionParameters(); params != nil {
+		if params.BlockSize < 0 || params.BlockSize > math.MaxInt32 {
+			return bucket, errs.New("Invalid encryption block size: %d", params.BlockSize)
+		}
+		encryptionParameters = storj.EncryptionParameters{
+			CipherSuite: storj.CipherSuite(params.CipherSuite),
+			BlockSize:   int32(params.BlockSize),
+		}
+	}
+
 	return storj.Bucket{
-		ID:        bucketID,
-		Name:      string(req.GetName()),
-		ProjectID: projectID,
-		PartnerID: partnerID,
+		ID:                          bucketID,
+		Name:                        string(req.GetName()),
+		ProjectID:                   projectID,
+		PartnerID:                   partnerID,
+		DefaultSegmentsSize:         req.GetDefaultSegmentSize(),
+		DefaultEncryptionParameters: encryptionParameters,
 	}, nil
 }
 
+// projectRedundancyScheme returns the redundancy scheme configured for the project,
+// falling back to the satellite default when the project has no override.
+func (endpoint *Endpoint) projectRedundancyScheme(ctx context.Context, projectID uuid.UUID) (_ *pb.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rs, err := endpoint.projects.GetDefaultRedundancyScheme(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if rs == nil {
+		return endpoint.defaultRS, nil
+	}
+
+	return convertRedundancySchemeToProto(*rs), nil
+}
+
+func convertRedundancySchemeToProto(rs storj.RedundancyScheme) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_SchemeType(rs.Algorithm),
+		MinReq:           int32(rs.RequiredShares),
+		RepairThreshold:  int32(rs.RepairShares),
+		SuccessThreshold: int32(rs.OptimalShares),
+		Total:            int32(rs.TotalShares),
+		ErasureShareSize: rs.ShareSize,
+	}
+}
+
+// convertStoredBucketParameters returns the redundancy scheme and encryption parameters
+// stored with the bucket verbatim, nil when they aren't stored.
+func convertStoredBucketParameters(bucket storj.Bucket) (rs *pb.RedundancyScheme, encryption *pb.EncryptionParameters) {
+	if !bucket.DefaultRedundancyScheme.IsZero() {
+		rs = convertRedundancySchemeToProto(bucket.DefaultRedundancyScheme)
+	}
+	if !bucket.DefaultEncryptionParameters.IsZero() {
+		encryption = &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite(bucket.DefaultEncryptionParameters.CipherSuite),
+			BlockSize:   int64(bucket.DefaultEncryptionParameters.BlockSize),
+		}
+	}
+	return rs, encryption
+}
+
 func convertBucketToProto(bucket buckets.Bucket, rs *pb.RedundancyScheme, maxSegmentSize memory.Size) (pbBucket *pb.Bucket, err error) {
 	if len(bucket.Name) == 0 {
-		return nil, nil
+		return nil, ErrBucketNameMissing.New("created at %s", bucket.CreatedAt)
+	}
+
+	if err := validateRedundancyScheme(rs); err != nil {
+		return nil, err
+	}
+
+	// use the encryption parameters stored with the bucket,
+	// falling back to satellite defaults for unset values
+	encryptionParameters := &pb.EncryptionParameters{
+		CipherSuite: pb.CipherSuite_ENC_AESGCM,
+		BlockSize:   stripeSize(rs),
+	}
+	if bucket.DefaultEncryptionParameters.CipherSuite != storj.EncUnspecified {
+		encryptionParameters.CipherSuite = pb.CipherSuite(bucket.DefaultEncryptionParameters.CipherSuite)
+	}
+	if bucket.DefaultEncryptionParameters.BlockSize != 0 {
+		encryptionParameters.BlockSize = int64(bucket.DefaultEncryptionParameters.BlockSize)
+	}
+
+	// use the segment size stored with the bucket, unless the satellite
+	// maximum has been lowered below it since the bucket was created
+	segmentSize := maxSegmentSize
+	if bucket.DefaultSegmentSize > 0 && bucket.DefaultSegmentSize < maxSegmentSize {
+		segmentSize = bucket.DefaultSegmentSize
 	}
 
 	return &pb.Bucket{
@@ -398,12 +3667,9 @@ func convertBucketToProto(bucket buckets.Bucket, rs *pb.RedundancyScheme, maxSeg
 		CreatedAt: bucket.CreatedAt,
 
 		// default satellite values
-		PathCipher:              pb.CipherSuite_ENC_AESGCM,
-		DefaultSegmentSize:      maxSegmentSize.Int64(),
-		DefaultRedundancyScheme: rs,
-		DefaultEncryptionParameters: &pb.EncryptionParameters{
-			CipherSuite: pb.CipherSuite_ENC_AESGCM,
-			BlockSize:   int64(rs.ErasureShareSize * rs.MinReq),
-		},
+		PathCipher:                  pb.CipherSuite_ENC_AESGCM,
+		DefaultSegmentSize:          segmentSize.Int64(),
+		DefaultRedundancyScheme:     rs,
+		DefaultEncryptionParameters: encryptionParameters,
 	}, nil
 }
@@ -4,23 +4,39 @@
 package metainfo_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/common/errs2"
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/uplink"
 	"storj.io/uplink/private/metaclient"
 )
@@ -69,6 +85,69 @@ func TestMaxOutBuckets(t *testing.T) {
 	})
 }
 
+func TestMaxOutBucketsCustomLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		defaultLimit := planet.Satellites[0].Config.Metainfo.ProjectLimits.MaxBuckets
+		limit := defaultLimit / 2
+		require.NotZero(t, limit)
+
+		err := planet.Satellites[0].DB.Console().Projects().UpdateBucketLimit(ctx, planet.Uplinks[0].Projects[0].ID, limit)
+		require.NoError(t, err)
+
+		for i := 1; i <= limit; i++ {
+			err := planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "test"+strconv.Itoa(i))
+			require.NoError(t, err)
+		}
+		err = planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], fmt.Sprintf("test%d", limit+1))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("number of allocated buckets (%d) exceeded", limit))
+		require.NotContains(t, err.Error(), fmt.Sprintf("(%d)", defaultLimit))
+	})
+}
+
+func TestGetBucketQuota(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		defaultLimit := sat.Config.Metainfo.ProjectLimits.MaxBuckets
+		limit := defaultLimit / 2
+		require.NotZero(t, limit)
+
+		overridden, regular := planet.Uplinks[0], planet.Uplinks[1]
+		require.NoError(t, sat.DB.Console().Projects().UpdateBucketLimit(ctx, overridden.Projects[0].ID, limit))
+
+		require.NoError(t, overridden.CreateBucket(ctx, sat, "bucket-a"))
+		require.NoError(t, overridden.CreateBucket(ctx, sat, "bucket-b"))
+		require.NoError(t, regular.CreateBucket(ctx, sat, "bucket-a"))
+
+		resp, err := endpoint.GetBucketQuota(ctx, &metainfo.BucketQuotaRequest{
+			Header: &pb.RequestHeader{ApiKey: overridden.APIKey[sat.ID()].SerializeRaw()},
+		})
+		require.NoError(t, err)
+		require.Equal(t, limit, resp.MaxBuckets)
+		require.Equal(t, 2, resp.BucketCount)
+
+		resp, err = endpoint.GetBucketQuota(ctx, &metainfo.BucketQuotaRequest{
+			Header: &pb.RequestHeader{ApiKey: regular.APIKey[sat.ID()].SerializeRaw()},
+		})
+		require.NoError(t, err)
+		require.Equal(t, defaultLimit, resp.MaxBuckets)
+		require.Equal(t, 1, resp.BucketCount)
+
+		// the quota requires read permission
+		noRead, err := regular.APIKey[sat.ID()].Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		_, err = endpoint.GetBucketQuota(ctx, &metainfo.BucketQuotaRequest{
+			Header: &pb.RequestHeader{ApiKey: noRead.SerializeRaw()},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
 func TestBucketNameValidation(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, UplinkCount: 1,
@@ -130,6 +209,56 @@ func TestBucketNameValidation(t *testing.T) {
 	})
 }
 
+func TestReservedBucketPrefixes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		createBucket := func(name string) error {
+			_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte(name)})
+			return err
+		}
+
+		err := createBucket("storj-bucket")
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), `"storj-"`)
+
+		err = createBucket("sys-bucket")
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Contains(t, err.Error(), `"sys-"`)
+
+		// near-misses aren't reserved
+		require.NoError(t, createBucket("storjbucket"))
+		require.NoError(t, createBucket("my-sys-bucket"))
+		require.NoError(t, createBucket("allowed"))
+
+		_, err = endpoint.RenameBucket(ctx, &metainfo.BucketRenameRequest{
+			Header:  header,
+			Name:    []byte("allowed"),
+			NewName: []byte("sys-allowed"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// buckets created before the prefix was reserved keep working
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "storj-existing",
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("storj-existing")})
+		require.NoError(t, err)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("storj-existing")})
+		require.NoError(t, err)
+	})
+}
+
 func TestBucketEmptinessBeforeDelete(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, UplinkCount: 1,
@@ -201,3 +330,3631 @@ func TestDeleteBucket(t *testing.T) {
 		require.Len(t, buckets.GetItems(), 0)
 	})
 }
+
+func TestBatchDeleteBuckets(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.MaxBatchDeleteBuckets = 4
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		noListKey, err := apiKey.Restrict(macaroon.Caveat{DisallowLists: true})
+		require.NoError(t, err)
+		restrictedKey, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("allowed-bucket")}},
+		})
+		require.NoError(t, err)
+
+		for _, tt := range []struct {
+			name         string
+			emptyBuckets []string
+			fullBuckets  []string
+			key          *macaroon.APIKey
+			names        []string
+			deleteAll    bool
+
+			errCode   rpcstatus.StatusCode
+			statuses  []metainfo.BucketDeleteStatus
+			withError []bool
+			deleted   []int64
+		}{
+			{
+				name:    "batch over the limit",
+				key:     apiKey,
+				names:   []string{"a", "b", "c", "d", "e"},
+				errCode: rpcstatus.InvalidArgument,
+			},
+			{
+				name:         "mixed outcomes",
+				emptyBuckets: []string{"empty-bucket"},
+				fullBuckets:  []string{"full-bucket"},
+				key:          apiKey,
+				names:        []string{"empty-bucket", "missing-bucket", "full-bucket"},
+				statuses:     []metainfo.BucketDeleteStatus{metainfo.BucketDeleted, metainfo.BucketDeleteNotFound, metainfo.BucketDeleteNotEmpty},
+				withError:    []bool{false, false, false},
+			},
+			{
+				name:        "delete all",
+				fullBuckets: []string{"full-bucket"},
+				key:         apiKey,
+				names:       []string{"full-bucket"},
+				deleteAll:   true,
+				statuses:    []metainfo.BucketDeleteStatus{metainfo.BucketDeleted},
+				withError:   []bool{false},
+				deleted:     []int64{1},
+			},
+			{
+				name:         "invalid bucket name",
+				emptyBuckets: []string{"valid-bucket"},
+				key:          apiKey,
+				names:        []string{"a", "valid-bucket"},
+				statuses:     []metainfo.BucketDeleteStatus{metainfo.BucketDeleteFailed, metainfo.BucketDeleted},
+				withError:    []bool{true, false},
+			},
+			{
+				name:        "delete all without list permission",
+				fullBuckets: []string{"full-bucket"},
+				key:         noListKey,
+				names:       []string{"full-bucket"},
+				deleteAll:   true,
+				statuses:    []metainfo.BucketDeleteStatus{metainfo.BucketDeleteNotEmpty},
+				withError:   []bool{true},
+			},
+			{
+				name:         "key restricted to a subset of buckets",
+				emptyBuckets: []string{"allowed-bucket", "other-bucket"},
+				key:          restrictedKey,
+				names:        []string{"allowed-bucket", "other-bucket"},
+				statuses:     []metainfo.BucketDeleteStatus{metainfo.BucketDeleted, metainfo.BucketDeletePermissionDenied},
+				withError:    []bool{false, true},
+			},
+			{
+				name:         "duplicate name",
+				emptyBuckets: []string{"dup-bucket"},
+				key:          apiKey,
+				names:        []string{"dup-bucket", "dup-bucket"},
+				statuses:     []metainfo.BucketDeleteStatus{metainfo.BucketDeleted, metainfo.BucketDeleted},
+				withError:    []bool{false, false},
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				for _, bucket := range tt.emptyBuckets {
+					require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], bucket))
+				}
+				for _, bucket := range tt.fullBuckets {
+					require.NoError(t, planet.Uplinks[0].Upload(ctx, planet.Satellites[0], bucket, "object", testrand.Bytes(memory.KiB)))
+				}
+
+				names := make([][]byte, len(tt.names))
+				for i, name := range tt.names {
+					names[i] = []byte(name)
+				}
+
+				resp, err := endpoint.BatchDeleteBuckets(ctx, &metainfo.BatchDeleteBucketsRequest{
+					Header:    &pb.RequestHeader{ApiKey: tt.key.SerializeRaw()},
+					Names:     names,
+					DeleteAll: tt.deleteAll,
+				})
+				if tt.errCode != 0 {
+					require.True(t, errs2.IsRPC(err, tt.errCode))
+					return
+				}
+				require.NoError(t, err)
+				require.Len(t, resp.Results, len(tt.statuses))
+				for i, result := range resp.Results {
+					require.Equal(t, tt.names[i], string(result.Name))
+					require.Equal(t, tt.statuses[i], result.Status, tt.names[i])
+					require.Equal(t, tt.withError[i], result.Error != "", tt.names[i])
+					if tt.deleted != nil {
+						require.Equal(t, tt.deleted[i], result.DeletedObjectsCount, tt.names[i])
+					}
+				}
+
+				// clean up whatever the batch didn't delete
+				for _, bucket := range append(tt.emptyBuckets, tt.fullBuckets...) {
+					_, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+						Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+						Name:      []byte(bucket),
+						DeleteAll: true,
+					})
+					require.NoError(t, err)
+				}
+
+				buckets, err := endpoint.ListBuckets(ctx, &pb.BucketListRequest{
+					Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+					Direction: int32(storj.Forward),
+				})
+				require.NoError(t, err)
+				require.Len(t, buckets.GetItems(), 0)
+			})
+		}
+	})
+}
+
+func TestGetBucketInfoStats(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "test-bucket"))
+
+		getInfo := func(key *macaroon.APIKey, includeStats bool) (*metainfo.BucketGetResponse, error) {
+			return endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+				Header:       &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+				Name:         []byte("test-bucket"),
+				IncludeStats: includeStats,
+			})
+		}
+
+		// empty bucket reports zeros
+		resp, err := getInfo(apiKey, true)
+		require.NoError(t, err)
+		require.Equal(t, []byte("test-bucket"), resp.Bucket.Name)
+		require.Zero(t, resp.ObjectCount)
+		require.Zero(t, resp.TotalSize)
+
+		for i := 0; i < 2; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "test-bucket", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		var totalSize int64
+		for _, object := range objects {
+			totalSize += object.TotalEncryptedSize
+		}
+
+		resp, err = getInfo(apiKey, true)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.ObjectCount)
+		require.Equal(t, totalSize, resp.TotalSize)
+
+		// stats are computed only when requested
+		resp, err = getInfo(apiKey, false)
+		require.NoError(t, err)
+		require.Zero(t, resp.ObjectCount)
+		require.Zero(t, resp.TotalSize)
+
+		// read permission is checked before stats are computed
+		otherBucketKey, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("other-bucket")}},
+		})
+		require.NoError(t, err)
+		_, err = getInfo(otherBucketKey, true)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+// deleteBucketStream collects the messages sent by DeleteBucketStream.
+type deleteBucketStream struct {
+	ctx      context.Context
+	messages []*metainfo.BucketDeleteProgress
+	onSend   func(*metainfo.BucketDeleteProgress)
+}
+
+func (stream *deleteBucketStream) Context() context.Context { return stream.ctx }
+
+func (stream *deleteBucketStream) Send(msg *metainfo.BucketDeleteProgress) error {
+	stream.messages = append(stream.messages, msg)
+	if stream.onSend != nil {
+		stream.onSend(msg)
+	}
+	return nil
+}
+
+func TestDeleteBucketStream(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		upload := func(bucketName string, count int) {
+			for i := 0; i < count; i++ {
+				err := planet.Uplinks[0].Upload(ctx, sat, bucketName, "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+				require.NoError(t, err)
+			}
+		}
+
+		deleteRequest := func(bucketName string) *pb.BucketDeleteRequest {
+			return &pb.BucketDeleteRequest{
+				Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Name:      []byte(bucketName),
+				DeleteAll: true,
+			}
+		}
+
+		t.Run("reports total", func(t *testing.T) {
+			upload("stream-bucket", 3)
+
+			stream := &deleteBucketStream{ctx: ctx}
+			err := endpoint.DeleteBucketStream(deleteRequest("stream-bucket"), stream)
+			require.NoError(t, err)
+
+			require.NotEmpty(t, stream.messages)
+			last := stream.messages[len(stream.messages)-1]
+			require.True(t, last.Done)
+			require.EqualValues(t, 3, last.DeletedObjectsCount)
+			require.Equal(t, []byte("stream-bucket"), last.Bucket.Name)
+			for _, msg := range stream.messages[:len(stream.messages)-1] {
+				require.False(t, msg.Done)
+				require.LessOrEqual(t, msg.DeletedObjectsCount, last.DeletedObjectsCount)
+			}
+
+			_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Name:   []byte("stream-bucket"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+
+		t.Run("cancel", func(t *testing.T) {
+			upload("cancel-bucket", 2)
+
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream := &deleteBucketStream{
+				ctx: streamCtx,
+				onSend: func(msg *metainfo.BucketDeleteProgress) {
+					if !msg.Done {
+						cancel()
+					}
+				},
+			}
+			err := endpoint.DeleteBucketStream(deleteRequest("cancel-bucket"), stream)
+			require.True(t, errs2.IsRPC(err, rpcstatus.Canceled), err)
+			for _, msg := range stream.messages {
+				require.False(t, msg.Done)
+			}
+
+			// the bucket itself isn't deleted after cancellation
+			_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Name:   []byte("cancel-bucket"),
+			})
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestBucketEncryptionParameters(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		rs := planet.Satellites[0].Config.Metainfo.RS
+		defaultParameters := &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite_ENC_AESGCM,
+			BlockSize:   int64(rs.ErasureShareSize.Int32()) * int64(rs.Min),
+		}
+
+		for _, tt := range []struct {
+			name       string
+			parameters *pb.EncryptionParameters
+			expected   *pb.EncryptionParameters
+		}{
+			{
+				name:     "default-bucket",
+				expected: defaultParameters,
+			},
+			{
+				name:       "custom-block-size",
+				parameters: &pb.EncryptionParameters{CipherSuite: pb.CipherSuite_ENC_SECRETBOX, BlockSize: 1024},
+				expected:   &pb.EncryptionParameters{CipherSuite: pb.CipherSuite_ENC_SECRETBOX, BlockSize: 1024},
+			},
+			{
+				name:       "only-block-size",
+				parameters: &pb.EncryptionParameters{BlockSize: 2048},
+				expected:   &pb.EncryptionParameters{CipherSuite: pb.CipherSuite_ENC_AESGCM, BlockSize: 2048},
+			},
+		} {
+			createResp, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+				Header:                      header,
+				Name:                        []byte(tt.name),
+				DefaultEncryptionParameters: tt.parameters,
+			})
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.expected.CipherSuite, createResp.Bucket.DefaultEncryptionParameters.CipherSuite, tt.name)
+			require.Equal(t, tt.expected.BlockSize, createResp.Bucket.DefaultEncryptionParameters.BlockSize, tt.name)
+
+			getResp, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+				Header: header,
+				Name:   []byte(tt.name),
+			})
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.expected.CipherSuite, getResp.Bucket.DefaultEncryptionParameters.CipherSuite, tt.name)
+			require.Equal(t, tt.expected.BlockSize, getResp.Bucket.DefaultEncryptionParameters.BlockSize, tt.name)
+		}
+	})
+}
+
+func TestBucketDefaultSegmentSize(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		maxSegmentSize := planet.Satellites[0].Config.Metainfo.MaxSegmentSize.Int64()
+
+		for _, tt := range []struct {
+			name        string
+			segmentSize int64
+			expected    int64
+		}{
+			{name: "default-bucket", expected: maxSegmentSize},
+			{name: "smaller-segments", segmentSize: maxSegmentSize / 4, expected: maxSegmentSize / 4},
+			{name: "max-segments", segmentSize: maxSegmentSize, expected: maxSegmentSize},
+		} {
+			createResp, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+				Header:             header,
+				Name:               []byte(tt.name),
+				DefaultSegmentSize: tt.segmentSize,
+			})
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.expected, createResp.Bucket.DefaultSegmentSize, tt.name)
+
+			getResp, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+				Header: header,
+				Name:   []byte(tt.name),
+			})
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.expected, getResp.Bucket.DefaultSegmentSize, tt.name)
+		}
+
+		for _, segmentSize := range []int64{-1, maxSegmentSize + 1} {
+			_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+				Header:             header,
+				Name:               []byte("invalid-segments"),
+				DefaultSegmentSize: segmentSize,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), segmentSize)
+		}
+
+		_, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+			Header: header,
+			Name:   []byte("invalid-segments"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestProjectRedundancyScheme(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		override := storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      512,
+		}
+		err := sat.DB.Console().Projects().UpdateDefaultRedundancyScheme(ctx, planet.Uplinks[0].Projects[0].ID, &override)
+		require.NoError(t, err)
+
+		rs := sat.Config.Metainfo.RS
+		for i, expected := range []*pb.RedundancyScheme{
+			{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           2,
+				RepairThreshold:  3,
+				SuccessThreshold: 4,
+				Total:            5,
+				ErasureShareSize: 512,
+			},
+			{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           int32(rs.Min),
+				RepairThreshold:  int32(rs.Repair),
+				SuccessThreshold: int32(rs.Success),
+				Total:            int32(rs.Total),
+				ErasureShareSize: rs.ErasureShareSize.Int32(),
+			},
+		} {
+			apiKey := planet.Uplinks[i].APIKey[sat.ID()]
+			header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+			createResp, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+				Header: header,
+				Name:   []byte("bucket"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, createResp.Bucket.DefaultRedundancyScheme)
+
+			getResp, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+				Header: header,
+				Name:   []byte("bucket"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, getResp.Bucket.DefaultRedundancyScheme)
+
+			deleteResp, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+				Header: header,
+				Name:   []byte("bucket"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, deleteResp.Bucket.DefaultRedundancyScheme)
+		}
+	})
+}
+
+func TestGetBucketInfoRaw(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+
+		_, err := sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "custom",
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+			DefaultRedundancyScheme: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				RequiredShares: 2,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+				ShareSize:      512,
+			},
+			DefaultEncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncSecretBox,
+				BlockSize:   1024,
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "unset",
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+		})
+		require.NoError(t, err)
+
+		getBucket := func(name string, raw bool) *pb.Bucket {
+			resp, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+				Header: header,
+				Name:   []byte(name),
+				Raw:    raw,
+			})
+			require.NoError(t, err)
+			return resp.Bucket
+		}
+
+		rs := sat.Config.Metainfo.RS
+		satelliteRS := &pb.RedundancyScheme{
+			Type:             pb.RedundancyScheme_RS,
+			MinReq:           int32(rs.Min),
+			RepairThreshold:  int32(rs.Repair),
+			SuccessThreshold: int32(rs.Success),
+			Total:            int32(rs.Total),
+			ErasureShareSize: rs.ErasureShareSize.Int32(),
+		}
+
+		custom := getBucket("custom", false)
+		require.Equal(t, satelliteRS, custom.DefaultRedundancyScheme)
+
+		customRaw := getBucket("custom", true)
+		require.Equal(t, &pb.RedundancyScheme{
+			Type:             pb.RedundancyScheme_RS,
+			MinReq:           2,
+			RepairThreshold:  3,
+			SuccessThreshold: 4,
+			Total:            5,
+			ErasureShareSize: 512,
+		}, customRaw.DefaultRedundancyScheme)
+		require.Equal(t, &pb.EncryptionParameters{
+			CipherSuite: pb.CipherSuite_ENC_SECRETBOX,
+			BlockSize:   1024,
+		}, customRaw.DefaultEncryptionParameters)
+		require.Equal(t, custom.DefaultEncryptionParameters, customRaw.DefaultEncryptionParameters)
+		require.Equal(t, custom.Name, customRaw.Name)
+		require.Equal(t, custom.DefaultSegmentSize, customRaw.DefaultSegmentSize)
+
+		unset := getBucket("unset", false)
+		require.Equal(t, satelliteRS, unset.DefaultRedundancyScheme)
+		require.NotNil(t, unset.DefaultEncryptionParameters)
+
+		unsetRaw := getBucket("unset", true)
+		require.Nil(t, unsetRaw.DefaultRedundancyScheme)
+		require.Nil(t, unsetRaw.DefaultEncryptionParameters)
+
+		_, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+			Header: header,
+			Name:   []byte("missing"),
+			Raw:    true,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestGetBucketLocation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.PlacementRegions = metainfo.PlacementRegions{storj.EU: "eu-central-1"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		for _, bucket := range []storj.Bucket{
+			{ID: testrand.UUID(), Name: "global-bucket", ProjectID: projectID},
+			{ID: testrand.UUID(), Name: "eu-bucket", ProjectID: projectID, Placement: storj.EU},
+			{ID: testrand.UUID(), Name: "us-bucket", ProjectID: projectID, Placement: storj.US},
+		} {
+			_, err := sat.API.Buckets.Service.CreateBucket(ctx, bucket)
+			require.NoError(t, err)
+		}
+
+		for _, tt := range []struct {
+			name      string
+			placement storj.PlacementConstraint
+			region    string
+		}{
+			{name: "global-bucket", placement: storj.EveryCountry},
+			{name: "eu-bucket", placement: storj.EU, region: "eu-central-1"},
+			{name: "us-bucket", placement: storj.US},
+		} {
+			resp, err := sat.API.Metainfo.Endpoint.GetBucketLocation(ctx, &metainfo.BucketLocationRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Name:   []byte(tt.name),
+			})
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.placement, resp.Placement, tt.name)
+			require.Equal(t, tt.region, resp.Region, tt.name)
+		}
+
+		_, err := sat.API.Metainfo.Endpoint.GetBucketLocation(ctx, &metainfo.BucketLocationRequest{
+			Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Name:   []byte("missing-bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestGetBucketMetadata(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.PlacementRegions = metainfo.PlacementRegions{storj.EU: "eu-central-1"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for _, bucket := range []storj.Bucket{
+			{ID: testrand.UUID(), Name: "global-bucket", ProjectID: projectID},
+			{ID: testrand.UUID(), Name: "eu-bucket", ProjectID: projectID, Placement: storj.EU},
+		} {
+			_, err := sat.API.Buckets.Service.CreateBucket(ctx, bucket)
+			require.NoError(t, err)
+		}
+
+		for _, name := range []string{"global-bucket", "eu-bucket"} {
+			metadata, err := endpoint.GetBucketMetadata(ctx, &metainfo.BucketMetadataRequest{Header: header, Name: []byte(name)})
+			require.NoError(t, err, name)
+
+			info, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte(name)})
+			require.NoError(t, err, name)
+			require.Equal(t, info.Bucket.Name, metadata.Name, name)
+			require.True(t, info.Bucket.CreatedAt.Equal(metadata.CreatedAt), name)
+
+			location, err := endpoint.GetBucketLocation(ctx, &metainfo.BucketLocationRequest{Header: header, Name: []byte(name)})
+			require.NoError(t, err, name)
+			require.Equal(t, location.Placement, metadata.Placement, name)
+			require.Equal(t, location.Region, metadata.Region, name)
+		}
+
+		_, err := endpoint.GetBucketMetadata(ctx, &metainfo.BucketMetadataRequest{Header: header, Name: []byte("missing-bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		noReadKey, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		_, err = endpoint.GetBucketMetadata(ctx, &metainfo.BucketMetadataRequest{
+			Header: &pb.RequestHeader{ApiKey: noReadKey.SerializeRaw()},
+			Name:   []byte("eu-bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+func TestBucketObjectLock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		retention := buckets.DefaultRetention{Mode: buckets.ComplianceMode, Days: 1}
+
+		// a retention period is required when object lock is enabled
+		_, err := endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("invalid")},
+			ObjectLockEnabled:   true,
+			DefaultRetention:    buckets.DefaultRetention{Mode: buckets.ComplianceMode},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		createResp, err := endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("locked")},
+			ObjectLockEnabled:   true,
+			DefaultRetention:    retention,
+		})
+		require.NoError(t, err)
+		require.True(t, createResp.ObjectLockEnabled)
+		require.Equal(t, retention, createResp.DefaultRetention)
+
+		getResp, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("locked")})
+		require.NoError(t, err)
+		require.True(t, getResp.ObjectLockEnabled)
+		require.Equal(t, retention, getResp.DefaultRetention)
+
+		// object lock can't be disabled once enabled
+		err = sat.API.Buckets.Service.UpdateBucketObjectLock(ctx, []byte("locked"), projectID, false, buckets.DefaultRetention{})
+		require.True(t, buckets.ErrObjectLock.Has(err))
+
+		err = planet.Uplinks[0].Upload(ctx, sat, "locked", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    header,
+			Name:      []byte("locked"),
+			DeleteAll: true,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		// buckets without object lock are deleted together with their objects
+		err = planet.Uplinks[0].Upload(ctx, sat, "unlocked", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("unlocked")})
+		require.NoError(t, err)
+		require.False(t, getResp.ObjectLockEnabled)
+
+		deleteResp, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    header,
+			Name:      []byte("unlocked"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleteResp.DeletedObjectsCount)
+	})
+}
+
+func TestHasBuckets(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.MaxHasBuckets = 4
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for _, name := range []string{"bucket-a", "bucket-c"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], name))
+		}
+
+		resp, err := endpoint.HasBuckets(ctx, &metainfo.HasBucketsRequest{Header: header})
+		require.NoError(t, err)
+		require.Empty(t, resp.Exists)
+
+		resp, err = endpoint.HasBuckets(ctx, &metainfo.HasBucketsRequest{
+			Header: header,
+			Names:  [][]byte{[]byte("bucket-a"), []byte("bucket-b"), []byte("bucket-c"), []byte("bucket-a")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []bool{true, false, true, true}, resp.Exists)
+
+		// buckets not allowed by the API key are reported as missing
+		restricted, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("bucket-c")}},
+		})
+		require.NoError(t, err)
+
+		resp, err = endpoint.HasBuckets(ctx, &metainfo.HasBucketsRequest{
+			Header: &pb.RequestHeader{ApiKey: restricted.SerializeRaw()},
+			Names:  [][]byte{[]byte("bucket-a"), []byte("bucket-c")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []bool{false, true}, resp.Exists)
+
+		_, err = endpoint.HasBuckets(ctx, &metainfo.HasBucketsRequest{
+			Header: header,
+			Names:  [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestBucketSoftDelete(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketSoftDelete.Enabled = true
+				config.Metainfo.BucketSoftDelete.RetentionWindow = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "deleted", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		// restoring a bucket, which wasn't deleted, fails
+		_, err = endpoint.RestoreBucket(ctx, &metainfo.BucketRestoreRequest{Header: header, Name: []byte("deleted")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		deleteResp, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    header,
+			Name:      []byte("deleted"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+		require.Zero(t, deleteResp.DeletedObjectsCount)
+
+		// the bucket is hidden, but its objects are kept
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("deleted")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		exists, err := sat.API.Buckets.Service.HasBucket(ctx, []byte("deleted"), projectID)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		listResp, err := endpoint.ListBuckets(ctx, &pb.BucketListRequest{Header: header, Direction: int32(storj.Forward)})
+		require.NoError(t, err)
+		require.Empty(t, listResp.Items)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		// the name stays taken during the retention window
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("deleted")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		restoreResp, err := endpoint.RestoreBucket(ctx, &metainfo.BucketRestoreRequest{Header: header, Name: []byte("deleted")})
+		require.NoError(t, err)
+		require.Equal(t, []byte("deleted"), restoreResp.Bucket.Name)
+
+		data, err := planet.Uplinks[0].Download(ctx, sat, "deleted", "object")
+		require.NoError(t, err)
+		require.Len(t, data, int(memory.KiB))
+
+		// buckets can't be restored after the retention window
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    header,
+			Name:      []byte("deleted"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+
+		// the retention window is measured with the clock of the endpoint
+		defer endpoint.TestingSetNow(time.Now)
+		endpoint.TestingSetNow(func() time.Time { return time.Now().Add(2 * time.Hour) })
+		_, err = endpoint.RestoreBucket(ctx, &metainfo.BucketRestoreRequest{Header: header, Name: []byte("deleted")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestDeleteBucketDryRun(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		// a non-empty bucket can't be deleted without DeleteAll
+		_, err := endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")},
+			DryRun:              true,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		dryRunResp, err := endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket"), DeleteAll: true},
+			DryRun:              true,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, dryRunResp.DeletedObjectsCount)
+		require.Equal(t, []byte("bucket"), dryRunResp.Bucket.Name)
+
+		// nothing was deleted
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		// the count matches the real deletion
+		deleteResp, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket"), DeleteAll: true})
+		require.NoError(t, err)
+		require.Equal(t, dryRunResp.DeletedObjectsCount, deleteResp.DeletedObjectsCount)
+
+		// without Read and List permissions no information is returned
+		restricted, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true, DisallowLists: true})
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].CreateBucket(ctx, sat, "other")
+		require.NoError(t, err)
+
+		dryRunResp, err = endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: &pb.RequestHeader{ApiKey: restricted.SerializeRaw()}, Name: []byte("other")},
+			DryRun:              true,
+		})
+		require.NoError(t, err)
+		require.Nil(t, dryRunResp.Bucket)
+		require.Zero(t, dryRunResp.DeletedObjectsCount)
+	})
+}
+
+func TestDeleteBucketMaxDeleteAllObjects(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.MaxDeleteAllObjects = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for i := 0; i < 2; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "under", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "over", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		// a bucket at the limit is deleted with its objects
+		resp, err := endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("under"), DeleteAll: true})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, resp.DeletedObjectsCount)
+
+		// a bucket over the limit is refused, for dry runs too
+		for _, dryRun := range []bool{true, false} {
+			_, err = endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+				BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("over"), DeleteAll: true},
+				DryRun:              dryRun,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition), "dry run %v", dryRun)
+			require.Contains(t, err.Error(), "delete the objects in pages")
+			var tooLargeErr *metainfo.BucketTooLargeToDeleteAllError
+			require.True(t, errors.As(err, &tooLargeErr))
+			require.EqualValues(t, 3, tooLargeErr.ObjectCount)
+			require.EqualValues(t, 2, tooLargeErr.MaxObjects)
+		}
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("over")})
+		require.NoError(t, err)
+	})
+}
+
+func TestDeleteBucketFreedBytes(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object"+strconv.Itoa(i), testrand.Bytes(10*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		var expectedFreedBytes int64
+		for _, object := range objects {
+			expectedFreedBytes += object.TotalEncryptedSize
+		}
+		require.NotZero(t, expectedFreedBytes)
+
+		resp, err := endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket"), DeleteAll: true},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.DeletedObjectsCount)
+		require.Equal(t, expectedFreedBytes, resp.FreedBytes)
+
+		// an empty bucket doesn't free anything
+		err = planet.Uplinks[0].CreateBucket(ctx, sat, "empty")
+		require.NoError(t, err)
+
+		resp, err = endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("empty"), DeleteAll: true},
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.FreedBytes)
+	})
+}
+
+func TestDeleteBucketNotEmptyDetail(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		for i := 0; i < 2; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		deleteBucket := func(key *macaroon.APIKey) (*pb.BucketDeleteResponse, error) {
+			return endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+				Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+				Name:   []byte("bucket"),
+			})
+		}
+
+		// with List permission the number of objects is returned
+		_, err := deleteBucket(apiKey)
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		var notEmptyErr *metainfo.BucketNotEmptyError
+		require.True(t, errors.As(err, &notEmptyErr))
+		require.EqualValues(t, 2, notEmptyErr.ObjectCount)
+
+		// Read permission is enough to get the number of objects
+		noList, err := apiKey.Restrict(macaroon.Caveat{DisallowLists: true})
+		require.NoError(t, err)
+
+		_, err = deleteBucket(noList)
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		require.True(t, errors.As(err, &notEmptyErr))
+		require.EqualValues(t, 2, notEmptyErr.ObjectCount)
+
+		// without Read and List permission nothing is revealed
+		noReadList, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true, DisallowLists: true})
+		require.NoError(t, err)
+
+		resp, err := deleteBucket(noReadList)
+		require.NoError(t, err)
+		require.Nil(t, resp.Bucket)
+		require.Zero(t, resp.DeletedObjectsCount)
+
+		// the bucket and its objects are kept
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+	})
+}
+
+func TestDeleteBucketPendingUploads(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		project, err := planet.Uplinks[0].OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.EnsureBucket(ctx, "bucket")
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			info, err := project.BeginUpload(ctx, "bucket", "pending"+strconv.Itoa(i), nil)
+			require.NoError(t, err)
+			upload, err := project.UploadPart(ctx, "bucket", "pending"+strconv.Itoa(i), info.UploadID, 1)
+			require.NoError(t, err)
+			_, err = upload.Write(testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+			require.NoError(t, upload.Commit())
+		}
+
+		// a normal delete reports the pending uploads
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		var pendingErr *metainfo.BucketPendingUploadsError
+		require.True(t, errors.As(err, &pendingErr))
+		require.EqualValues(t, 2, pendingErr.UploadCount)
+
+		// a committed object makes it a regular non-empty bucket
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "bucket", "committed", testrand.Bytes(memory.KiB)))
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		var notEmptyErr *metainfo.BucketNotEmptyError
+		require.True(t, errors.As(err, &notEmptyErr))
+		require.EqualValues(t, 3, notEmptyErr.ObjectCount)
+
+		// deleting all objects aborts the uploads
+		resp, err := endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{
+				Header:    header,
+				Name:      []byte("bucket"),
+				DeleteAll: true,
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, resp.DeletedObjectsCount)
+		require.EqualValues(t, 2, resp.AbortedUploadsCount)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Empty(t, segments)
+	})
+}
+
+func TestFrozenBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, sat, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		data := testrand.Bytes(memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "frozen", "object", data))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		getResp, err := endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+			Header:        header,
+			Bucket:        []byte("frozen"),
+			EncryptedPath: []byte(objects[0].ObjectKey),
+		})
+		require.NoError(t, err)
+
+		// an upload, which began before the bucket was frozen
+		pending, err := metainfoClient.BeginObject(ctx, metaclient.BeginObjectParams{
+			Bucket:             []byte("frozen"),
+			EncryptedObjectKey: []byte("pending-object"),
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   256,
+			},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketFrozen(ctx, []byte("frozen"), projectID, true))
+
+		// uploads are rejected
+		err = planet.Uplinks[0].Upload(ctx, sat, "frozen", "new-object", data)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bucket is frozen")
+
+		// also when they began before the bucket was frozen
+		err = metainfoClient.CommitObject(ctx, metaclient.CommitObjectParams{
+			StreamID: pending.StreamID,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		require.Contains(t, err.Error(), "bucket is frozen")
+
+		// metadata isn't updated
+		_, err = endpoint.UpdateObjectMetadata(ctx, &pb.ObjectUpdateMetadataRequest{
+			Header:                        header,
+			Bucket:                        getResp.Object.Bucket,
+			EncryptedObjectKey:            getResp.Object.EncryptedPath,
+			Version:                       getResp.Object.Version,
+			StreamId:                      getResp.Object.StreamId,
+			EncryptedMetadataNonce:        testrand.Nonce(),
+			EncryptedMetadata:             testrand.BytesInt(64),
+			EncryptedMetadataEncryptedKey: testrand.BytesInt(32),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		// reads still work and report the state
+		info, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+			Header: header,
+			Name:   []byte("frozen"),
+		})
+		require.NoError(t, err)
+		require.True(t, info.Frozen)
+
+		list, err := endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header: header,
+			Limit:  10,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		require.True(t, list.Items[0].Frozen)
+
+		downloaded, err := planet.Uplinks[0].Download(ctx, sat, "frozen", "object")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+
+		listed, err := planet.Uplinks[0].ListObjects(ctx, sat, "frozen")
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+
+		// unfreezing allows uploads again
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketFrozen(ctx, []byte("frozen"), projectID, false))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "frozen", "new-object", data))
+
+		// deletes work on a frozen bucket
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketFrozen(ctx, []byte("frozen"), projectID, true))
+		require.NoError(t, planet.Uplinks[0].DeleteObject(ctx, sat, "frozen", "object"))
+		require.NoError(t, planet.Uplinks[0].DeleteBucket(ctx, sat, "frozen"))
+	})
+}
+
+func TestHideBucketExistence(t *testing.T) {
+	for _, hide := range []bool{false, true} {
+		t.Run(fmt.Sprintf("hide=%t", hide), func(t *testing.T) {
+			testplanet.Run(t, testplanet.Config{
+				SatelliteCount: 1, UplinkCount: 1,
+				Reconfigure: testplanet.Reconfigure{
+					Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+						config.Metainfo.HideBucketExistence = hide
+					},
+				},
+			}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+				apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+				sat := planet.Satellites[0]
+				endpoint := sat.API.Metainfo.Endpoint
+
+				require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "existing"))
+
+				otherBucketKey, err := apiKey.Restrict(macaroon.Caveat{
+					AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("other")}},
+				})
+				require.NoError(t, err)
+				header := &pb.RequestHeader{ApiKey: otherBucketKey.SerializeRaw()}
+
+				unauthorized := rpcstatus.PermissionDenied
+				if hide {
+					unauthorized = rpcstatus.NotFound
+				}
+
+				// a missing bucket
+				_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("other")})
+				require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+				missingErr := err
+
+				// an existing bucket the key isn't authorized for
+				_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("existing")})
+				require.True(t, errs2.IsRPC(err, unauthorized))
+				if hide {
+					require.Equal(t, strings.ReplaceAll(missingErr.Error(), "other", "existing"), err.Error())
+				}
+
+				_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("missing")})
+				require.True(t, errs2.IsRPC(err, unauthorized))
+
+				_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("existing")})
+				require.True(t, errs2.IsRPC(err, unauthorized))
+
+				_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("missing")})
+				require.True(t, errs2.IsRPC(err, unauthorized))
+
+				// an authorized key still gets the bucket
+				_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{
+					Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+					Name:   []byte("existing"),
+				})
+				require.NoError(t, err)
+
+				_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+					Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+					Name:   []byte("existing"),
+				})
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestBucketWebhookEvents(t *testing.T) {
+	var mu sync.Mutex
+	var events []metainfo.BucketWebhookEvent
+	failing := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get(metainfo.BucketWebhookSignatureHeader) != metainfo.SignBucketWebhookPayload("secret", body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var event metainfo.BucketWebhookEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events = append(events, event)
+	}))
+	defer server.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketWebhook.URL = server.URL
+				config.Metainfo.BucketWebhook.Secret = "secret"
+				config.Metainfo.BucketWebhook.MaxAttempts = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		// webhook failures don't fail the requests
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "failing"))
+
+		mu.Lock()
+		failing = false
+		mu.Unlock()
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket"))
+		require.NoError(t, planet.Uplinks[0].DeleteBucket(ctx, sat, "bucket"))
+
+		// buckets deleted in a batch are notified too
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "batched"))
+		resp, err := sat.API.Metainfo.Endpoint.BatchDeleteBuckets(ctx, &metainfo.BatchDeleteBucketsRequest{
+			Header: &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()},
+			Names:  [][]byte{[]byte("batched"), []byte("missing")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, metainfo.BucketDeleted, resp.Results[0].Status)
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(events) == 4
+		}, testcontext.DefaultTimeout, time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		for i, expected := range [][2]string{
+			{metainfo.BucketWebhookEventCreated, "bucket"},
+			{metainfo.BucketWebhookEventDeleted, "bucket"},
+			{metainfo.BucketWebhookEventCreated, "batched"},
+			{metainfo.BucketWebhookEventDeleted, "batched"},
+		} {
+			require.Equal(t, projectID, events[i].ProjectID)
+			require.Equal(t, expected, [2]string{events[i].Event, events[i].BucketName})
+			require.False(t, events[i].Timestamp.IsZero())
+		}
+	})
+}
+
+func TestCaseInsensitiveBucketNames(t *testing.T) {
+	for _, insensitive := range []bool{false, true} {
+		t.Run(fmt.Sprintf("insensitive=%t", insensitive), func(t *testing.T) {
+			testplanet.Run(t, testplanet.Config{
+				SatelliteCount: 1, UplinkCount: 1,
+				Reconfigure: testplanet.Reconfigure{
+					Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+						config.Metainfo.CaseInsensitiveNames = insensitive
+					},
+				},
+			}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+				apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+				endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+				header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+				create := func(name string) error {
+					_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte(name)})
+					return err
+				}
+
+				if !insensitive {
+					// uppercase names are rejected, except at the end of a label,
+					// where they create a separate bucket
+					require.True(t, errs2.IsRPC(create("MyBucket"), rpcstatus.InvalidArgument))
+					require.NoError(t, create("mybucket"))
+					require.NoError(t, create("mybuckeT"))
+
+					_, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("MYBUCKET")})
+					require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+					return
+				}
+
+				require.NoError(t, create("MyBucket"))
+				require.True(t, errs2.IsRPC(create("mybucket"), rpcstatus.AlreadyExists))
+				require.True(t, errs2.IsRPC(create("mybuckeT"), rpcstatus.AlreadyExists))
+
+				// the bucket keeps the name it was created with
+				resp, err := endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("MYBUCKET")})
+				require.NoError(t, err)
+				require.Equal(t, []byte("MyBucket"), resp.Bucket.Name)
+
+				_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("mybucket")})
+				require.NoError(t, err)
+				_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("MyBucket")})
+				require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+				// buckets created before are matched by their exact name, and lowercase ones by any case
+				for _, name := range []string{"legacy", "legacY"} {
+					_, err = planet.Satellites[0].API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+						ID:        testrand.UUID(),
+						Name:      name,
+						ProjectID: planet.Uplinks[0].Projects[0].ID,
+					})
+					require.NoError(t, err)
+				}
+
+				resp, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("LEGACY")})
+				require.NoError(t, err)
+				require.Equal(t, []byte("legacy"), resp.Bucket.Name)
+				resp, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("legacY")})
+				require.NoError(t, err)
+				require.Equal(t, []byte("legacY"), resp.Bucket.Name)
+				require.True(t, errs2.IsRPC(create("Legacy"), rpcstatus.AlreadyExists))
+
+				// the names of a bulk check are resolved together
+				require.NoError(t, create("Other"))
+				hasResp, err := endpoint.HasBuckets(ctx, &metainfo.HasBucketsRequest{
+					Header: header,
+					Names:  [][]byte{[]byte("OTHER"), []byte("Legacy"), []byte("legacy"), []byte("mybucket")},
+				})
+				require.NoError(t, err)
+				require.Equal(t, []bool{true, true, true, false}, hasResp.Exists)
+			})
+		})
+	}
+}
+
+func TestBucketEndpointClock(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		now := time.Now()
+		defer endpoint.TestingSetNow(time.Now)
+
+		notAfter := now.Add(time.Hour)
+		expiring, err := apiKey.Restrict(macaroon.Caveat{NotAfter: &notAfter})
+		require.NoError(t, err)
+		header := &pb.RequestHeader{ApiKey: expiring.SerializeRaw()}
+
+		endpoint.TestingSetNow(func() time.Time { return now })
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		_, err = endpoint.ListBuckets(ctx, &pb.BucketListRequest{Header: header, Direction: int32(storj.Forward)})
+		require.NoError(t, err)
+
+		// after the key expired, all the requests are rejected
+		endpoint.TestingSetNow(func() time.Time { return notAfter.Add(time.Minute) })
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("other")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		_, err = endpoint.ListBuckets(ctx, &pb.BucketListRequest{Header: header, Direction: int32(storj.Forward)})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		// the bucket is still there
+		endpoint.TestingSetNow(func() time.Time { return now })
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+	})
+}
+
+func TestCreateBucketAlreadyExists(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		createResp, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+			Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Name:   []byte("bucket"),
+		})
+		require.NoError(t, err)
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+			Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Name:   []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		var existsErr *metainfo.BucketAlreadyExistsError
+		require.True(t, errors.As(err, &existsErr))
+		require.NotNil(t, existsErr.Bucket)
+		require.Equal(t, createResp.Bucket.Name, existsErr.Bucket.Name)
+		require.Equal(t, createResp.Bucket.DefaultRedundancyScheme, existsErr.Bucket.DefaultRedundancyScheme)
+
+		// without Read permission the bucket details are not returned
+		restricted, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{
+			Header: &pb.RequestHeader{ApiKey: restricted.SerializeRaw()},
+			Name:   []byte("bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+		require.True(t, errors.As(err, &existsErr))
+		require.Nil(t, existsErr.Bucket)
+	})
+}
+
+// fakeBucketAnalytics records the bucket lifecycle events.
+type fakeBucketAnalytics struct {
+	mu      sync.Mutex
+	created []uuid.UUID
+	deleted []uuid.UUID
+}
+
+func (analytics *fakeBucketAnalytics) TrackBucketCreated(projectID uuid.UUID, userAgent string, partnerID uuid.UUID) {
+	analytics.mu.Lock()
+	defer analytics.mu.Unlock()
+	analytics.created = append(analytics.created, projectID)
+}
+
+func (analytics *fakeBucketAnalytics) TrackBucketDeleted(projectID uuid.UUID, userAgent string, partnerID uuid.UUID) {
+	analytics.mu.Lock()
+	defer analytics.mu.Unlock()
+	analytics.deleted = append(analytics.deleted, projectID)
+}
+
+func TestBucketAnalytics(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		analytics := &fakeBucketAnalytics{}
+		endpoint.TestingSetAnalytics(analytics)
+
+		_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("Invalid_Name")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Empty(t, analytics.created)
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("tracked")})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{projectID}, analytics.created)
+
+		// creating an existing bucket doesn't create anything
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("tracked")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+		require.Len(t, analytics.created, 1)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("Invalid_Name")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		require.Empty(t, analytics.deleted)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("tracked")})
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{projectID}, analytics.deleted)
+
+		// deleting a missing bucket doesn't delete anything
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("tracked")})
+		require.NoError(t, err)
+		require.Len(t, analytics.deleted, 1)
+	})
+}
+
+func TestBucketTagging(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, sat, "bucket")
+		require.NoError(t, err)
+
+		getResp, err := endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Tags)
+
+		_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Tags:   map[string]string{"env": "prod", "cost-center": "42"},
+		})
+		require.NoError(t, err)
+
+		// a new tag set replaces the previous one
+		_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{
+			Header: header,
+			Name:   []byte("bucket"),
+			Tags:   map[string]string{"env": "staging"},
+		})
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"env": "staging"}, getResp.Tags)
+
+		// tags exceeding the limits are rejected and the current set is kept
+		tooMany := map[string]string{}
+		for i := 0; i <= buckets.MaxTags; i++ {
+			tooMany["key"+strconv.Itoa(i)] = "value"
+		}
+		for _, tags := range []map[string]string{
+			tooMany,
+			{strings.Repeat("k", buckets.MaxTagKeyLength+1): "value"},
+			{"key": strings.Repeat("v", buckets.MaxTagValueLength+1)},
+		} {
+			_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{Header: header, Name: []byte("bucket"), Tags: tags})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		}
+
+		getResp, err = endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"env": "staging"}, getResp.Tags)
+
+		_, err = endpoint.DeleteBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Tags)
+
+		// deleting the bucket drops its tags
+		_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{Header: header, Name: []byte("bucket"), Tags: map[string]string{"env": "prod"}})
+		require.NoError(t, err)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		_, err = endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		err = planet.Uplinks[0].CreateBucket(ctx, sat, "bucket")
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketTagging(ctx, &metainfo.BucketTaggingRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Tags)
+
+		_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{Header: header, Name: []byte("missing"), Tags: map[string]string{"env": "prod"}})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestBucketCORS(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, sat, "bucket")
+		require.NoError(t, err)
+
+		getResp, err := endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Rules)
+
+		rules := []buckets.CORSRule{
+			{
+				AllowedOrigins: []string{"https://app.example.test"},
+				AllowedMethods: []string{"GET", "PUT"},
+				AllowedHeaders: []string{"Content-Type"},
+				MaxAgeSeconds:  3600,
+			},
+			{
+				AllowedOrigins: []string{"*"},
+				AllowedMethods: []string{"HEAD"},
+			},
+		}
+		_, err = endpoint.SetBucketCORS(ctx, &metainfo.SetBucketCORSRequest{Header: header, Name: []byte("bucket"), Rules: rules})
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Equal(t, rules, getResp.Rules)
+
+		// invalid rules are rejected and the current rules are kept
+		for _, invalid := range [][]buckets.CORSRule{
+			nil,
+			{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}},
+			{{AllowedMethods: []string{"GET"}}},
+			{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: -1}},
+		} {
+			_, err = endpoint.SetBucketCORS(ctx, &metainfo.SetBucketCORSRequest{Header: header, Name: []byte("bucket"), Rules: invalid})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		}
+
+		getResp, err = endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Equal(t, rules, getResp.Rules)
+
+		_, err = endpoint.DeleteBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Rules)
+
+		// deleting the bucket drops its CORS rules
+		_, err = endpoint.SetBucketCORS(ctx, &metainfo.SetBucketCORSRequest{Header: header, Name: []byte("bucket"), Rules: rules})
+		require.NoError(t, err)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		_, err = endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		err = planet.Uplinks[0].CreateBucket(ctx, sat, "bucket")
+		require.NoError(t, err)
+
+		getResp, err = endpoint.GetBucketCORS(ctx, &metainfo.BucketCORSRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+		require.Empty(t, getResp.Rules)
+
+		_, err = endpoint.SetBucketCORS(ctx, &metainfo.SetBucketCORSRequest{Header: header, Name: []byte("missing"), Rules: rules})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestBucketDefaultObjectTTL(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "logs"))
+
+		for _, ttl := range []time.Duration{0, -time.Hour} {
+			_, err := endpoint.SetBucketTTL(ctx, &metainfo.SetBucketTTLRequest{Header: header, Name: []byte("logs"), TTL: ttl})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		}
+
+		_, err := endpoint.SetBucketTTL(ctx, &metainfo.SetBucketTTLRequest{Header: header, Name: []byte("missing"), TTL: time.Hour})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		const ttl = 7 * 24 * time.Hour
+		_, err = endpoint.SetBucketTTL(ctx, &metainfo.SetBucketTTLRequest{Header: header, Name: []byte("logs"), TTL: ttl})
+		require.NoError(t, err)
+
+		info, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("logs")})
+		require.NoError(t, err)
+		require.Equal(t, ttl, info.DefaultObjectTTL)
+
+		before := time.Now()
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "logs", "inherited", testrand.Bytes(5*memory.KiB)))
+		after := time.Now()
+
+		explicit := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		require.NoError(t, planet.Uplinks[0].UploadWithExpiration(ctx, sat, "logs", "explicit", testrand.Bytes(5*memory.KiB), explicit))
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		// object keys are encrypted, so the objects are told apart by their expiration
+		var inherited, ownExpiration int
+		for _, object := range objects {
+			require.NotNil(t, object.ExpiresAt)
+			if object.ExpiresAt.Before(before.Add(ttl / 2)) {
+				ownExpiration++
+				require.WithinDuration(t, explicit, *object.ExpiresAt, time.Second)
+				continue
+			}
+			inherited++
+			require.False(t, object.ExpiresAt.Before(before.Add(ttl).Add(-time.Second)))
+			require.False(t, object.ExpiresAt.After(after.Add(ttl).Add(time.Second)))
+		}
+		require.Equal(t, 1, inherited)
+		require.Equal(t, 1, ownExpiration)
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		for _, segment := range segments {
+			require.NotNil(t, segment.ExpiresAt)
+		}
+	})
+}
+
+func TestBucketCostCenter(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketCostCenters = []string{"finance", "research"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		_, err := endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("invalid")},
+			CostCenter:          "marketing",
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("invalid")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("reports")},
+			CostCenter:          "finance",
+		})
+		require.NoError(t, err)
+
+		info, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("reports")})
+		require.NoError(t, err)
+		require.Equal(t, "finance", info.CostCenter)
+
+		_, err = endpoint.SetBucketCostCenter(ctx, &metainfo.SetBucketCostCenterRequest{Header: header, Name: []byte("reports"), CostCenter: "marketing"})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = endpoint.SetBucketCostCenter(ctx, &metainfo.SetBucketCostCenterRequest{Header: header, Name: []byte("missing"), CostCenter: "research"})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		for _, costCenter := range []string{"research", ""} {
+			resp, err := endpoint.SetBucketCostCenter(ctx, &metainfo.SetBucketCostCenterRequest{Header: header, Name: []byte("reports"), CostCenter: costCenter})
+			require.NoError(t, err)
+			require.Equal(t, costCenter, resp.CostCenter)
+
+			info, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("reports")})
+			require.NoError(t, err)
+			require.Equal(t, costCenter, info.CostCenter)
+		}
+
+		// Write permission is required
+		noWrite, err := apiKey.Restrict(macaroon.Caveat{DisallowWrites: true})
+		require.NoError(t, err)
+		_, err = endpoint.SetBucketCostCenter(ctx, &metainfo.SetBucketCostCenterRequest{
+			Header:     &pb.RequestHeader{ApiKey: noWrite.SerializeRaw()},
+			Name:       []byte("reports"),
+			CostCenter: "finance",
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+func TestBucketCreatedBy(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		project := planet.Uplinks[0].Projects[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("created")})
+		require.NoError(t, err)
+
+		resp, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("created")})
+		require.NoError(t, err)
+		require.Equal(t, project.Owner.ID, resp.CreatedBy)
+
+		// buckets created without a known creator still work
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "legacy",
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		resp, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("legacy")})
+		require.NoError(t, err)
+		require.True(t, resp.CreatedBy.IsZero())
+		require.Equal(t, []byte("legacy"), resp.Bucket.Name)
+	})
+}
+
+func TestListBucketsPermissions(t *testing.T) {
+	for _, readFallback := range []bool{true, false} {
+		readFallback := readFallback
+		t.Run(fmt.Sprintf("read-fallback=%t", readFallback), func(t *testing.T) {
+			testplanet.Run(t, testplanet.Config{
+				SatelliteCount: 1, UplinkCount: 1,
+				Reconfigure: testplanet.Reconfigure{
+					Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+						config.Metainfo.ListBucketsReadFallback = readFallback
+					},
+				},
+			}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+				apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+				endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+				err := planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "bucket")
+				require.NoError(t, err)
+
+				listOnly, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+				require.NoError(t, err)
+				readOnly, err := apiKey.Restrict(macaroon.Caveat{DisallowLists: true})
+				require.NoError(t, err)
+
+				for _, tt := range []struct {
+					name    string
+					key     *macaroon.APIKey
+					allowed bool
+				}{
+					{name: "read+list", key: apiKey, allowed: true},
+					{name: "list-only", key: listOnly, allowed: true},
+					{name: "read-only", key: readOnly, allowed: readFallback},
+				} {
+					resp, err := endpoint.ListBuckets(ctx, &pb.BucketListRequest{
+						Header:    &pb.RequestHeader{ApiKey: tt.key.SerializeRaw()},
+						Direction: int32(storj.Forward),
+					})
+					if !tt.allowed {
+						require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied), tt.name)
+						continue
+					}
+					require.NoError(t, err, tt.name)
+					require.Len(t, resp.Items, 1, tt.name)
+					require.Equal(t, []byte("bucket"), resp.Items[0].Name, tt.name)
+				}
+			})
+		})
+	}
+}
+
+func TestListBucketsAllowedPrefix(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		for _, name := range []string{"logs-a", "logs-b", "other"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], name))
+		}
+
+		exact, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("logs-a")}},
+		})
+		require.NoError(t, err)
+		prefix, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("logs-" + buckets.AllowedPrefixWildcard)}},
+		})
+		require.NoError(t, err)
+
+		for _, tt := range []struct {
+			name     string
+			key      *macaroon.APIKey
+			expected []string
+		}{
+			{name: "exact", key: exact, expected: []string{"logs-a"}},
+			{name: "prefix", key: prefix, expected: []string{"logs-a", "logs-b"}},
+			{name: "unrestricted", key: apiKey, expected: []string{"logs-a", "logs-b", "other"}},
+		} {
+			// a limit of one makes the listing page through the filtered buckets
+			var names []string
+			var cursor []byte
+			for {
+				resp, err := endpoint.ListBuckets(ctx, &pb.BucketListRequest{
+					Header:    &pb.RequestHeader{ApiKey: tt.key.SerializeRaw()},
+					Cursor:    cursor,
+					Limit:     1,
+					Direction: int32(storj.After),
+				})
+				require.NoError(t, err, tt.name)
+				for _, item := range resp.Items {
+					names = append(names, string(item.Name))
+					cursor = item.Name
+				}
+				if !resp.More {
+					break
+				}
+			}
+			require.Equal(t, tt.expected, names, tt.name)
+		}
+	})
+}
+
+type listBucketsStream struct {
+	ctx    context.Context
+	items  []*pb.BucketListItem
+	onSend func(*pb.BucketListItem)
+}
+
+func (stream *listBucketsStream) Context() context.Context { return stream.ctx }
+
+func (stream *listBucketsStream) Send(item *pb.BucketListItem) error {
+	stream.items = append(stream.items, item)
+	if stream.onSend != nil {
+		stream.onSend(item)
+	}
+	return nil
+}
+
+func (stream *listBucketsStream) names() []string {
+	names := make([]string, len(stream.items))
+	for i, item := range stream.items {
+		names[i] = string(item.Name)
+	}
+	return names
+}
+
+func TestListBucketsStream(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		for _, name := range []string{"logs-a", "logs-b", "logs-c", "other-a", "other-b"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], name))
+		}
+
+		prefix, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("logs-" + buckets.AllowedPrefixWildcard)}},
+		})
+		require.NoError(t, err)
+
+		t.Run("multiple pages", func(t *testing.T) {
+			// a limit of two makes the listing stream five buckets from three pages
+			stream := &listBucketsStream{ctx: ctx}
+			err := endpoint.ListBucketsStream(&pb.BucketListRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Limit:  2,
+			}, stream)
+			require.NoError(t, err)
+			require.Equal(t, []string{"logs-a", "logs-b", "logs-c", "other-a", "other-b"}, stream.names())
+		})
+
+		t.Run("cursor", func(t *testing.T) {
+			stream := &listBucketsStream{ctx: ctx}
+			err := endpoint.ListBucketsStream(&pb.BucketListRequest{
+				Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Cursor:    []byte("logs-b"),
+				Limit:     2,
+				Direction: int32(storj.After),
+			}, stream)
+			require.NoError(t, err)
+			require.Equal(t, []string{"logs-c", "other-a", "other-b"}, stream.names())
+		})
+
+		t.Run("allowed buckets", func(t *testing.T) {
+			stream := &listBucketsStream{ctx: ctx}
+			err := endpoint.ListBucketsStream(&pb.BucketListRequest{
+				Header: &pb.RequestHeader{ApiKey: prefix.SerializeRaw()},
+				Limit:  1,
+			}, stream)
+			require.NoError(t, err)
+			require.Equal(t, []string{"logs-a", "logs-b", "logs-c"}, stream.names())
+		})
+
+		t.Run("cancel", func(t *testing.T) {
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream := &listBucketsStream{
+				ctx: streamCtx,
+				onSend: func(*pb.BucketListItem) {
+					cancel()
+				},
+			}
+			err := endpoint.ListBucketsStream(&pb.BucketListRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Limit:  2,
+			}, stream)
+			require.True(t, errs2.IsRPC(err, rpcstatus.Canceled), err)
+			require.Equal(t, []string{"logs-a"}, stream.names())
+		})
+	})
+}
+
+// watchBucketsStream passes the events WatchBuckets sends to the test.
+type watchBucketsStream struct {
+	ctx    context.Context
+	events chan *metainfo.BucketWatchEvent
+}
+
+func (stream *watchBucketsStream) Context() context.Context { return stream.ctx }
+
+func (stream *watchBucketsStream) Send(event *metainfo.BucketWatchEvent) error {
+	select {
+	case stream.events <- event:
+		return nil
+	case <-stream.ctx.Done():
+		return stream.ctx.Err()
+	}
+}
+
+// next returns the type and the bucket name of the next event sent to the stream.
+func (stream *watchBucketsStream) next(t *testing.T) (string, string) {
+	select {
+	case event := <-stream.events:
+		return event.Event, string(event.Name)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a bucket event")
+		return "", ""
+	}
+}
+
+// watchBuckets starts WatchBuckets with the API key, the returned function
+// cancels the stream and returns the error it ended with.
+func watchBuckets(ctx context.Context, endpoint *metainfo.Endpoint, key *macaroon.APIKey) (*watchBucketsStream, func() error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &watchBucketsStream{ctx: streamCtx, events: make(chan *metainfo.BucketWatchEvent)}
+	done := make(chan error, 1)
+	go func() {
+		done <- endpoint.WatchBuckets(&metainfo.BucketWatchRequest{
+			Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+		}, stream)
+	}()
+	return stream, func() error {
+		cancel()
+		return <-done
+	}
+}
+
+func TestWatchBuckets(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "logs-existing"))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "photos"))
+
+		scoped, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("logs-" + buckets.AllowedPrefixWildcard)}},
+		})
+		require.NoError(t, err)
+
+		watch := func(key *macaroon.APIKey) (*watchBucketsStream, func() error) {
+			return watchBuckets(ctx, endpoint, key)
+		}
+		next := func(stream *watchBucketsStream) (string, string) {
+			return stream.next(t)
+		}
+
+		all, stopAll := watch(apiKey)
+		logs, stopLogs := watch(scoped)
+
+		for _, expected := range [][2]string{
+			{metainfo.BucketWatchEventExisting, "logs-existing"},
+			{metainfo.BucketWatchEventExisting, "photos"},
+			{metainfo.BucketWatchEventSynced, ""},
+		} {
+			event, name := next(all)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+		for _, expected := range [][2]string{
+			{metainfo.BucketWatchEventExisting, "logs-existing"},
+			{metainfo.BucketWatchEventSynced, ""},
+		} {
+			event, name := next(logs)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "videos"))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "logs-new"))
+		require.NoError(t, planet.Uplinks[0].DeleteBucket(ctx, sat, "logs-new"))
+
+		for _, expected := range [][2]string{
+			{metainfo.BucketWebhookEventCreated, "videos"},
+			{metainfo.BucketWebhookEventCreated, "logs-new"},
+			{metainfo.BucketWebhookEventDeleted, "logs-new"},
+		} {
+			event, name := next(all)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+		// the buckets outside of the scope of the API key aren't sent
+		for _, expected := range [][2]string{
+			{metainfo.BucketWebhookEventCreated, "logs-new"},
+			{metainfo.BucketWebhookEventDeleted, "logs-new"},
+		} {
+			event, name := next(logs)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+
+		// canceling the stream ends it
+		require.True(t, errs2.IsRPC(stopAll(), rpcstatus.Canceled))
+		require.True(t, errs2.IsRPC(stopLogs(), rpcstatus.Canceled))
+
+		// an expired API key has neither List nor Read permission
+		expiredAt := time.Now().Add(-time.Hour)
+		expired, err := apiKey.Restrict(macaroon.Caveat{NotAfter: &expiredAt})
+		require.NoError(t, err)
+		err = endpoint.WatchBuckets(&metainfo.BucketWatchRequest{
+			Header: &pb.RequestHeader{ApiKey: expired.SerializeRaw()},
+		}, &watchBucketsStream{ctx: ctx})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+func TestWatchBucketsResync(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketWatch.ResyncInterval = 100 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "existing"))
+
+		stream, stop := watchBuckets(ctx, endpoint, apiKey)
+		for _, expected := range [][2]string{
+			{metainfo.BucketWatchEventExisting, "existing"},
+			{metainfo.BucketWatchEventSynced, ""},
+		} {
+			event, name := stream.next(t)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+
+		// buckets changed without publishing events, like through another
+		// API instance, are sent once the stream lists the buckets again
+		_, err := sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "elsewhere",
+			ProjectID: projectID,
+		})
+		require.NoError(t, err)
+		event, name := stream.next(t)
+		require.Equal(t, [2]string{metainfo.BucketWebhookEventCreated, "elsewhere"}, [2]string{event, name})
+
+		require.NoError(t, sat.API.Buckets.Service.DeleteBucket(ctx, []byte("elsewhere"), projectID))
+		event, name = stream.next(t)
+		require.Equal(t, [2]string{metainfo.BucketWebhookEventDeleted, "elsewhere"}, [2]string{event, name})
+
+		// renamed buckets are sent once, although the resync sees them too
+		_, err = endpoint.RenameBucket(ctx, &metainfo.BucketRenameRequest{
+			Header:  header,
+			Name:    []byte("existing"),
+			NewName: []byte("renamed"),
+		})
+		require.NoError(t, err)
+		for _, expected := range [][2]string{
+			{metainfo.BucketWebhookEventDeleted, "existing"},
+			{metainfo.BucketWebhookEventCreated, "renamed"},
+		} {
+			event, name := stream.next(t)
+			require.Equal(t, expected, [2]string{event, name})
+		}
+
+		select {
+		case event := <-stream.events:
+			t.Fatalf("unexpected event %s of %q", event.Event, event.Name)
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		require.True(t, errs2.IsRPC(stop(), rpcstatus.Canceled))
+	})
+}
+
+func TestListBucketsEmptyStatus(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "empty-a"))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "full-b", "object", testrand.Bytes(memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "empty-c"))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "full-d", "object", testrand.Bytes(memory.KiB)))
+
+		resp, err := endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Direction: int32(storj.Forward),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 4)
+		require.Nil(t, resp.Empty)
+
+		resp, err = endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:             header,
+			Direction:          int32(storj.Forward),
+			IncludeEmptyStatus: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 4)
+		require.Equal(t, []bool{true, true, false, false}, resp.Empty)
+
+		// the status follows the listed page
+		resp, err = endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:             header,
+			Cursor:             []byte("full-b"),
+			Direction:          int32(storj.After),
+			Limit:              1,
+			IncludeEmptyStatus: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		require.Equal(t, []byte("full-d"), resp.Items[0].Name)
+		require.Equal(t, []bool{false}, resp.Empty)
+	})
+}
+
+func TestListBucketsDetailed(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ListBucketsDetailedLimit = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c", "other"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, name))
+		}
+
+		_, err := endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{
+			Header: header,
+			Name:   []byte("bucket-a"),
+			Tags:   map[string]string{"env": "prod"},
+		})
+		require.NoError(t, err)
+
+		// the limit is capped by the config
+		resp, err := endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header: header,
+			Limit:  100,
+			Prefix: []byte("bucket-"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 2)
+		require.True(t, resp.More)
+
+		item := resp.Items[0]
+		require.Equal(t, map[string]string{"env": "prod"}, item.Tags)
+
+		getResp, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("bucket-a")})
+		require.NoError(t, err)
+		require.Equal(t, getResp.Bucket.Name, item.Bucket.Name)
+		require.WithinDuration(t, getResp.Bucket.CreatedAt, item.Bucket.CreatedAt, time.Second)
+		require.Equal(t, getResp.Bucket.PathCipher, item.Bucket.PathCipher)
+		require.Equal(t, getResp.Bucket.DefaultSegmentSize, item.Bucket.DefaultSegmentSize)
+		require.Equal(t, getResp.Bucket.DefaultRedundancyScheme, item.Bucket.DefaultRedundancyScheme)
+		require.Equal(t, getResp.Bucket.DefaultEncryptionParameters, item.Bucket.DefaultEncryptionParameters)
+		require.Equal(t, getResp.ObjectLockEnabled, item.ObjectLockEnabled)
+		require.Equal(t, getResp.DefaultRetention, item.DefaultRetention)
+
+		// the cursor continues after the last returned bucket
+		resp, err = endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Cursor:    resp.Items[1].Bucket.Name,
+			Direction: int32(storj.After),
+			Prefix:    []byte("bucket-"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		require.Equal(t, []byte("bucket-c"), resp.Items[0].Bucket.Name)
+		require.False(t, resp.More)
+		require.Zero(t, resp.Items[0].ObjectCount)
+
+		// the stats match the ones of GetBucketInfo
+		for i := 0; i < 2; i++ {
+			err := planet.Uplinks[0].Upload(ctx, sat, "bucket-b", "object"+strconv.Itoa(i), testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+		resp, err = endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:       header,
+			Prefix:       []byte("bucket-"),
+			IncludeStats: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 2)
+
+		for _, item := range resp.Items {
+			getResp, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+				Header:       header,
+				Name:         item.Bucket.Name,
+				IncludeStats: true,
+			})
+			require.NoError(t, err)
+			require.Equal(t, getResp.ObjectCount, item.ObjectCount)
+			require.Equal(t, getResp.TotalSize, item.TotalSize)
+		}
+		require.Zero(t, resp.Items[0].ObjectCount)
+		require.EqualValues(t, 2, resp.Items[1].ObjectCount)
+		require.NotZero(t, resp.Items[1].TotalSize)
+	})
+}
+
+func TestListBucketsContinuationToken(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c", "other"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, name))
+		}
+
+		// the token keeps the prefix of the first page
+		var names []string
+		var token []byte
+		req := &metainfo.BucketListRequest{
+			Header:    header,
+			Direction: int32(storj.Forward),
+			Prefix:    []byte("bucket-"),
+			Limit:     1,
+		}
+		for {
+			resp, err := endpoint.ListBucketsInfo(ctx, req)
+			require.NoError(t, err)
+			for _, item := range resp.Items {
+				names = append(names, string(item.Name))
+			}
+			if !resp.More {
+				require.Nil(t, resp.ContinuationToken)
+				break
+			}
+			require.NotEmpty(t, resp.ContinuationToken)
+			token = resp.ContinuationToken
+			req = &metainfo.BucketListRequest{
+				Header:            header,
+				Limit:             1,
+				ContinuationToken: resp.ContinuationToken,
+			}
+		}
+		require.Equal(t, []string{"bucket-a", "bucket-b", "bucket-c"}, names)
+
+		detailed, err := endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:            header,
+			ContinuationToken: token,
+		})
+		require.NoError(t, err)
+		require.Len(t, detailed.Items, 1)
+		require.Equal(t, []byte("bucket-c"), detailed.Items[0].Bucket.Name)
+
+		tampered := append([]byte{}, token...)
+		tampered[len(tampered)/2] ^= 1
+		_, err = endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:            header,
+			ContinuationToken: tampered,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:            header,
+			Cursor:            []byte("bucket-a"),
+			ContinuationToken: token,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		defer endpoint.TestingSetNow(time.Now)
+		endpoint.TestingSetNow(func() time.Time { return time.Now().Add(25 * time.Hour) })
+		_, err = endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:            header,
+			ContinuationToken: token,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestListBucketsModifiedSince(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		since := time.Now().Add(-time.Hour)
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c", "bucket-d"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, name))
+			require.NoError(t, sat.DB.Buckets().TestingSetBucketCreatedAt(ctx, []byte(name), projectID, since.Add(-time.Hour)))
+		}
+
+		for _, name := range []string{"bucket-b", "bucket-d"} {
+			_, err := endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{
+				Header: header,
+				Name:   []byte(name),
+				Tags:   map[string]string{"env": "prod"},
+			})
+			require.NoError(t, err)
+		}
+
+		// the token keeps the filter of the first page
+		var names []string
+		req := &metainfo.BucketListRequest{
+			Header:        header,
+			Direction:     int32(storj.Forward),
+			Limit:         1,
+			ModifiedSince: since,
+		}
+		for {
+			resp, err := endpoint.ListBucketsInfo(ctx, req)
+			require.NoError(t, err)
+			for _, item := range resp.Items {
+				names = append(names, string(item.Name))
+			}
+			if !resp.More {
+				break
+			}
+			req = &metainfo.BucketListRequest{
+				Header:            header,
+				Limit:             1,
+				ContinuationToken: resp.ContinuationToken,
+			}
+		}
+		require.Equal(t, []string{"bucket-b", "bucket-d"}, names)
+
+		detailed, err := endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:        header,
+			ModifiedSince: since,
+		})
+		require.NoError(t, err)
+		require.Len(t, detailed.Items, 2)
+		for _, item := range detailed.Items {
+			require.True(t, item.LastModified.After(since))
+		}
+
+		untouched, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{Header: header, Name: []byte("bucket-a")})
+		require.NoError(t, err)
+		require.False(t, untouched.LastModified.After(since))
+	})
+}
+
+func TestValidateBucketName(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "existing"))
+
+		validate := func(key *macaroon.APIKey, name string) *metainfo.ValidateBucketNameResponse {
+			resp, err := endpoint.ValidateBucketName(ctx, &metainfo.ValidateBucketNameRequest{
+				Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+				Name:   []byte(name),
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		resp := validate(apiKey, "new-bucket")
+		require.True(t, resp.Valid)
+		require.True(t, resp.TakenChecked)
+		require.False(t, resp.Taken)
+
+		resp = validate(apiKey, "existing")
+		require.True(t, resp.Valid)
+		require.True(t, resp.TakenChecked)
+		require.True(t, resp.Taken)
+
+		for name, code := range map[string]metainfo.BucketNameErrorCode{
+			"a":        metainfo.BucketNameTooShort,
+			"bad_name": metainfo.BucketNameIllegalCharacter,
+			"1.2.3.4":  metainfo.BucketNameReserved,
+			"storj-x":  metainfo.BucketNameReserved,
+		} {
+			resp := validate(apiKey, name)
+			require.False(t, resp.Valid, name)
+			require.Equal(t, code, resp.Code, name)
+			require.NotEmpty(t, resp.Reason, name)
+			require.False(t, resp.TakenChecked, name)
+		}
+
+		resp = validate(apiKey, "")
+		require.False(t, resp.Valid)
+		require.NotEmpty(t, resp.Reason)
+
+		// keys without Read permission for the bucket can validate the name,
+		// but don't learn whether it's taken
+		noRead, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		otherBucket, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{{Bucket: []byte("other")}},
+		})
+		require.NoError(t, err)
+		for _, key := range []*macaroon.APIKey{noRead, otherBucket} {
+			resp := validate(key, "existing")
+			require.True(t, resp.Valid)
+			require.False(t, resp.TakenChecked)
+			require.False(t, resp.Taken)
+		}
+
+		_, err = endpoint.ValidateBucketName(ctx, &metainfo.ValidateBucketNameRequest{
+			Header: &pb.RequestHeader{ApiKey: []byte("invalid")},
+			Name:   []byte("new-bucket"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestCreateBucketFrom(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		_, err := sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:                  testrand.UUID(),
+			Name:                "template",
+			ProjectID:           projectID,
+			Placement:           storj.EU,
+			DefaultSegmentsSize: memory.MiB.Int64(),
+			DefaultEncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   29 * 256,
+			},
+		})
+		require.NoError(t, err)
+
+		retention := buckets.DefaultRetention{Mode: buckets.GovernanceMode, Days: 3}
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketObjectLock(ctx, []byte("template"), projectID, true, retention))
+		_, err = endpoint.SetBucketTagging(ctx, &metainfo.SetBucketTaggingRequest{
+			Header: header,
+			Name:   []byte("template"),
+			Tags:   map[string]string{"env": "prod", "team": "storage"},
+		})
+		require.NoError(t, err)
+		_, err = endpoint.SetBucketCORS(ctx, &metainfo.SetBucketCORSRequest{
+			Header: header,
+			Name:   []byte("template"),
+			Rules: []buckets.CORSRule{{
+				AllowedOrigins: []string{"https://app.example.test"},
+				AllowedMethods: []string{"GET"},
+			}},
+		})
+		require.NoError(t, err)
+		_, err = endpoint.SetBucketTTL(ctx, &metainfo.SetBucketTTLRequest{Header: header, Name: []byte("template"), TTL: 24 * time.Hour})
+		require.NoError(t, err)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "template", "object", testrand.Bytes(memory.KiB)))
+
+		resp, err := endpoint.CreateBucketFrom(ctx, &metainfo.BucketCreateFromRequest{
+			Header: header,
+			Source: []byte("template"),
+			Name:   []byte("copy"),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []byte("copy"), resp.Bucket.Name)
+		require.True(t, resp.ObjectLockEnabled)
+		require.Equal(t, retention, resp.DefaultRetention)
+
+		source, err := sat.API.Buckets.Service.GetMinimalBucket(ctx, []byte("template"), projectID)
+		require.NoError(t, err)
+		copied, err := sat.API.Buckets.Service.GetMinimalBucket(ctx, []byte("copy"), projectID)
+		require.NoError(t, err)
+		require.Equal(t, source.Placement, copied.Placement)
+		require.Equal(t, source.DefaultEncryptionParameters, copied.DefaultEncryptionParameters)
+		require.Equal(t, source.DefaultSegmentSize, copied.DefaultSegmentSize)
+		require.Equal(t, source.ObjectLockEnabled, copied.ObjectLockEnabled)
+		require.Equal(t, source.DefaultRetention, copied.DefaultRetention)
+		require.Equal(t, source.Tags, copied.Tags)
+		require.Equal(t, source.CORS, copied.CORS)
+		require.Equal(t, source.DefaultObjectTTL, copied.DefaultObjectTTL)
+
+		// the objects aren't copied
+		empty, err := sat.Metabase.DB.BucketEmpty(ctx, metabase.BucketEmpty{ProjectID: projectID, BucketName: "copy"})
+		require.NoError(t, err)
+		require.True(t, empty)
+
+		_, err = endpoint.CreateBucketFrom(ctx, &metainfo.BucketCreateFromRequest{
+			Header: header,
+			Source: []byte("template"),
+			Name:   []byte("copy"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		_, err = endpoint.CreateBucketFrom(ctx, &metainfo.BucketCreateFromRequest{
+			Header: header,
+			Source: []byte("missing"),
+			Name:   []byte("other"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		// Read permission for the source and Write permission for the new bucket are required
+		noRead, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		noWrite, err := apiKey.Restrict(macaroon.Caveat{DisallowWrites: true})
+		require.NoError(t, err)
+		for _, key := range []*macaroon.APIKey{noRead, noWrite} {
+			_, err = endpoint.CreateBucketFrom(ctx, &metainfo.BucketCreateFromRequest{
+				Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+				Source: []byte("template"),
+				Name:   []byte("other"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		}
+	})
+}
+
+func TestListBucketsLegacyCursorDisabled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ListBucketsLegacyCursor = false
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "bucket-a"))
+
+		_, err := endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Cursor:    []byte("bucket-a"),
+			Direction: int32(storj.After),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		_, err = endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Cursor:    []byte("bucket-a"),
+			Direction: int32(storj.After),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		resp, err := endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Direction: int32(storj.Forward),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+	})
+}
+
+func TestListBucketsDetailedNamelessBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+
+		// a bucket without name can only come from corrupted data, the endpoints never create one
+		_, err := sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			ID:        testrand.UUID(),
+			Name:      "",
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+		})
+		require.NoError(t, err)
+
+		resp, err := sat.API.Metainfo.Endpoint.ListBucketsDetailed(ctx, &metainfo.BucketListRequest{
+			Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Direction: int32(storj.Forward),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.Internal), err)
+		require.Nil(t, resp)
+	})
+}
+
+func TestCountBuckets(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		count := func(key *macaroon.APIKey) int64 {
+			resp, err := endpoint.CountBuckets(ctx, &metainfo.BucketCountRequest{
+				Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+			})
+			require.NoError(t, err)
+			return resp.Count
+		}
+
+		require.Zero(t, count(apiKey))
+
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], name))
+		}
+		require.EqualValues(t, 3, count(apiKey))
+
+		// a scoped key only counts the buckets it can see
+		scoped, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{
+				{Bucket: []byte("bucket-a")},
+				{Bucket: []byte("bucket-b")},
+				{Bucket: []byte("missing")},
+			},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count(scoped))
+
+		noLists, err := apiKey.Restrict(macaroon.Caveat{DisallowLists: true, DisallowReads: true})
+		require.NoError(t, err)
+		_, err = endpoint.CountBuckets(ctx, &metainfo.BucketCountRequest{
+			Header: &pb.RequestHeader{ApiKey: noLists.SerializeRaw()},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+func TestCountBucketsApproximate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.ApproximateBucketCountThreshold = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+
+		count := func(approximate bool) *metainfo.BucketCountResponse {
+			resp, err := endpoint.CountBuckets(ctx, &metainfo.BucketCountRequest{
+				Header:      &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Approximate: approximate,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		for _, name := range []string{"bucket-a", "bucket-b"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], name))
+		}
+		// up to the threshold the count is exact
+		require.Equal(t, &metainfo.BucketCountResponse{Count: 2}, count(true))
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "bucket-c"))
+		require.Equal(t, &metainfo.BucketCountResponse{Count: 2, Approximate: true}, count(true))
+
+		// requests, which don't accept an approximate count, count all the buckets
+		require.Equal(t, &metainfo.BucketCountResponse{Count: 3}, count(false))
+	})
+}
+
+func TestBucketTraceID(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		created, err := endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("traced")},
+			TraceID:             "create-trace",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "create-trace", created.TraceID)
+
+		got, err := endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+			Header:  header,
+			Name:    []byte("traced"),
+			TraceID: "get-trace",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "get-trace", got.TraceID)
+
+		// a trace id is generated when the request has none
+		list, err := endpoint.ListBucketsInfo(ctx, &metainfo.BucketListRequest{
+			Header:    header,
+			Direction: int32(storj.Forward),
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, list.TraceID)
+
+		deleted, err := endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("traced")},
+			TraceID:             "delete-trace",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "delete-trace", deleted.TraceID)
+
+		_, err = endpoint.GetBucketInfo(ctx, &metainfo.BucketGetRequest{
+			Header:  header,
+			Name:    []byte("traced"),
+			TraceID: "invalid trace",
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
+func TestGetProjectBucketsSummary(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+		endpoint := satellite.API.Metainfo.Endpoint
+
+		summary := func(key *macaroon.APIKey) *metainfo.ProjectBucketsSummaryResponse {
+			resp, err := endpoint.GetProjectBucketsSummary(ctx, &metainfo.ProjectBucketsSummaryRequest{
+				Header: &pb.RequestHeader{ApiKey: key.SerializeRaw()},
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		require.Equal(t, &metainfo.ProjectBucketsSummaryResponse{}, summary(apiKey))
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "bucket-a", "first", testrand.Bytes(memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "bucket-a", "second", testrand.Bytes(2*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "bucket-b", "third", testrand.Bytes(3*memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "bucket-c"))
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		sizes := map[string]int64{}
+		for _, object := range objects {
+			sizes[object.BucketName] += object.TotalEncryptedSize
+		}
+
+		require.Equal(t, &metainfo.ProjectBucketsSummaryResponse{
+			BucketCount: 3,
+			ObjectCount: 3,
+			TotalBytes:  sizes["bucket-a"] + sizes["bucket-b"],
+		}, summary(apiKey))
+
+		// a scoped key only sums the buckets it can see
+		scoped, err := apiKey.Restrict(macaroon.Caveat{
+			AllowedPaths: []*macaroon.Caveat_Path{
+				{Bucket: []byte("bucket-a")},
+				{Bucket: []byte("bucket-c")},
+				{Bucket: []byte("missing")},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &metainfo.ProjectBucketsSummaryResponse{
+			BucketCount: 2,
+			ObjectCount: 2,
+			TotalBytes:  sizes["bucket-a"],
+		}, summary(scoped))
+
+		noReads, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true})
+		require.NoError(t, err)
+		_, err = endpoint.GetProjectBucketsSummary(ctx, &metainfo.ProjectBucketsSummaryRequest{
+			Header: &pb.RequestHeader{ApiKey: noReads.SerializeRaw()},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+	})
+}
+
+func TestBucketIdempotencyKeys(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		endpoint := planet.Satellites[0].API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		createReq := &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("bucket")},
+			IdempotencyKey:      []byte("create-key"),
+		}
+		created, err := endpoint.CreateBucketWithOptions(ctx, createReq)
+		require.NoError(t, err)
+
+		// a retry with the same key replays the first response
+		replayed, err := endpoint.CreateBucketWithOptions(ctx, createReq)
+		require.NoError(t, err)
+		require.Equal(t, created.Bucket.Name, replayed.Bucket.Name)
+		require.True(t, created.Bucket.CreatedAt.Equal(replayed.Bucket.CreatedAt))
+
+		// without the key the request is executed again
+		_, err = endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: createReq.BucketCreateRequest,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		// the same key can't be used for a different request
+		_, err = endpoint.CreateBucketWithOptions(ctx, &metainfo.BucketCreateRequest{
+			BucketCreateRequest: &pb.BucketCreateRequest{Header: header, Name: []byte("other")},
+			IdempotencyKey:      []byte("create-key"),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		deleteReq := &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")},
+			IdempotencyKey:      []byte("delete-key"),
+		}
+		deleted, err := endpoint.DeleteBucketWithOptions(ctx, deleteReq)
+		require.NoError(t, err)
+		require.Equal(t, []byte("bucket"), deleted.Bucket.Name)
+
+		replayedDelete, err := endpoint.DeleteBucketWithOptions(ctx, deleteReq)
+		require.NoError(t, err)
+		require.Equal(t, deleted.Bucket.Name, replayedDelete.Bucket.Name)
+		require.Equal(t, deleted.FreedBytes, replayedDelete.FreedBytes)
+
+		_, err = endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: deleteReq.BucketDeleteRequest,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+	})
+}
+
+func TestRenameBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		projectID := planet.Uplinks[0].Projects[0].ID
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "source", "object", testrand.Bytes(memory.KiB)))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "existing"))
+
+		rename := func(name, newName string) (*metainfo.BucketRenameResponse, error) {
+			return endpoint.RenameBucket(ctx, &metainfo.BucketRenameRequest{
+				Header:  header,
+				Name:    []byte(name),
+				NewName: []byte(newName),
+			})
+		}
+
+		_, err := rename("source", "existing")
+		require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+		_, err = rename("missing", "target")
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = rename("source", "Invalid_Name")
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// object keys are encrypted with keys derived from the bucket name,
+		// so buckets with objects can't be renamed
+		_, err = rename("source", "target")
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		data, err := planet.Uplinks[0].Download(ctx, sat, "source", "object")
+		require.NoError(t, err)
+		require.Len(t, data, memory.KiB.Int())
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "frozen"))
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketFrozen(ctx, []byte("frozen"), projectID, true))
+		_, err = rename("frozen", "target")
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "empty"))
+		resp, err := rename("empty", "target")
+		require.NoError(t, err)
+		require.Equal(t, []byte("target"), resp.Bucket.Name)
+
+		exists, err := sat.API.Buckets.Service.HasBucket(ctx, []byte("empty"), projectID)
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		// objects uploaded after the rename are read back through the new name
+		expected := testrand.Bytes(memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "target", "object", expected))
+		data, err = planet.Uplinks[0].Download(ctx, sat, "target", "object")
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+
+		// an interrupted rename keeps the old name taken and is resumed by repeating the request
+		require.NoError(t, sat.API.Buckets.Service.StartBucketRename(ctx, projectID, []byte("existing"), []byte("renamed")))
+
+		err = planet.Uplinks[0].CreateBucket(ctx, sat, "existing")
+		require.Error(t, err)
+
+		_, err = rename("existing", "other")
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		resp, err = rename("existing", "renamed")
+		require.NoError(t, err)
+		require.Equal(t, []byte("renamed"), resp.Bucket.Name)
+
+		_, err = sat.API.Buckets.Service.GetBucketRename(ctx, projectID, []byte("existing"))
+		require.True(t, buckets.ErrBucketRenameNotFound.Has(err))
+	})
+}
+
+func TestBucketLogging(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketLogging.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "data"))
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "logs"))
+
+		t.Run("nonexistent target", func(t *testing.T) {
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{
+				Header:  header,
+				Name:    []byte("data"),
+				Logging: buckets.Logging{TargetBucket: []byte("missing")},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		})
+
+		t.Run("self reference", func(t *testing.T) {
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{
+				Header:  header,
+				Name:    []byte("data"),
+				Logging: buckets.Logging{TargetBucket: []byte("data")},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		})
+
+		t.Run("nonexistent bucket", func(t *testing.T) {
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{
+				Header:  header,
+				Name:    []byte("missing"),
+				Logging: buckets.Logging{TargetBucket: []byte("logs")},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+
+		t.Run("valid", func(t *testing.T) {
+			logging := buckets.Logging{TargetBucket: []byte("logs"), TargetPrefix: "data/"}
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{
+				Header:  header,
+				Name:    []byte("data"),
+				Logging: logging,
+			})
+			require.NoError(t, err)
+
+			resp, err := endpoint.GetBucketLogging(ctx, &metainfo.BucketLoggingRequest{Header: header, Name: []byte("data")})
+			require.NoError(t, err)
+			require.Equal(t, logging, resp.Logging)
+
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "data", "object", testrand.Bytes(memory.KiB)))
+			_, err = planet.Uplinks[0].Download(ctx, sat, "data", "object")
+			require.NoError(t, err)
+			// the accesses of buckets without logging aren't logged
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "logs", "object", testrand.Bytes(memory.KiB)))
+
+			records, err := sat.API.Buckets.Service.ListAccessLogs(ctx, 100)
+			require.NoError(t, err)
+
+			operations := map[string]bool{}
+			for _, record := range records {
+				require.Equal(t, []byte("data"), record.BucketName)
+				require.Equal(t, logging.TargetBucket, record.TargetBucket)
+				require.Equal(t, logging.TargetPrefix, record.TargetPrefix)
+				operations[record.Operation] = true
+			}
+			require.True(t, operations["PUT"])
+			require.True(t, operations["GET"])
+		})
+
+		t.Run("disable", func(t *testing.T) {
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{Header: header, Name: []byte("data")})
+			require.NoError(t, err)
+
+			resp, err := endpoint.GetBucketLogging(ctx, &metainfo.BucketLoggingRequest{Header: header, Name: []byte("data")})
+			require.NoError(t, err)
+			require.False(t, resp.Logging.Enabled())
+		})
+
+		t.Run("deleted target", func(t *testing.T) {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "deleted-logs"))
+			_, err := endpoint.SetBucketLogging(ctx, &metainfo.SetBucketLoggingRequest{
+				Header:  header,
+				Name:    []byte("data"),
+				Logging: buckets.Logging{TargetBucket: []byte("deleted-logs")},
+			})
+			require.NoError(t, err)
+
+			countRecords := func() int {
+				records, err := sat.API.Buckets.Service.ListAccessLogs(ctx, 1000)
+				require.NoError(t, err)
+				count := 0
+				for _, record := range records {
+					if string(record.TargetBucket) == "deleted-logs" {
+						count++
+					}
+				}
+				return count
+			}
+
+			// the access caches the logging configuration of the bucket
+			_, err = planet.Uplinks[0].Download(ctx, sat, "data", "object")
+			require.NoError(t, err)
+			logged := countRecords()
+			require.NotZero(t, logged)
+
+			require.NoError(t, planet.Uplinks[0].DeleteBucket(ctx, sat, "deleted-logs"))
+
+			resp, err := endpoint.GetBucketLogging(ctx, &metainfo.BucketLoggingRequest{Header: header, Name: []byte("data")})
+			require.NoError(t, err)
+			require.False(t, resp.Logging.Enabled())
+
+			// a new bucket, which reuses the name of the deleted target, doesn't receive the access logs
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "deleted-logs"))
+			_, err = planet.Uplinks[0].Download(ctx, sat, "data", "object")
+			require.NoError(t, err)
+			require.Equal(t, logged, countRecords())
+		})
+	})
+}
+
+func TestBucketCreationLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 2,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketCreationLimit.Limit = 3
+				config.Metainfo.BucketCreationLimit.Window = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+		otherHeader := &pb.RequestHeader{ApiKey: planet.Uplinks[1].APIKey[sat.ID()].SerializeRaw()}
+
+		now := time.Now()
+		endpoint.TestingSetNow(func() time.Time { return now })
+		defer endpoint.TestingSetNow(time.Now)
+
+		for i := 0; i < 3; i++ {
+			_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket" + strconv.Itoa(i))})
+			require.NoError(t, err)
+		}
+
+		_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket3")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+		require.Contains(t, err.Error(), "retry after 20m0s")
+
+		// gets and deletes aren't limited
+		_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: header, Name: []byte("bucket0")})
+		require.NoError(t, err)
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket0")})
+		require.NoError(t, err)
+
+		// deleting a bucket doesn't allow creating another one
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket3")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+		// the limit is per project
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: otherHeader, Name: []byte("bucket3")})
+		require.NoError(t, err)
+
+		now = now.Add(20 * time.Minute)
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket3")})
+		require.NoError(t, err)
+	})
+}
+
+func TestTransferBucket(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Transfer User",
+			Email:    "transfer@mail.test",
+		}, 2)
+		require.NoError(t, err)
+
+		authCtx, err := sat.AuthenticatedContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		var projectIDs []uuid.UUID
+		var headers []*pb.RequestHeader
+		var projects []*uplink.Project
+		for _, name := range []string{"source", "destination"} {
+			project, err := sat.AddProject(ctx, user.ID, name)
+			require.NoError(t, err)
+			projectIDs = append(projectIDs, project.ID)
+
+			_, apiKey, err := sat.API.Console.Service.CreateAPIKey(authCtx, project.ID, "root")
+			require.NoError(t, err)
+			headers = append(headers, &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()})
+
+			access, err := uplink.RequestAccessWithPassphrase(ctx, sat.NodeURL().String(), apiKey.Serialize(), "passphrase")
+			require.NoError(t, err)
+			uplinkProject, err := uplink.OpenProject(ctx, access)
+			require.NoError(t, err)
+			defer ctx.Check(uplinkProject.Close)
+			projects = append(projects, uplinkProject)
+		}
+		source, destination := headers[0], headers[1]
+
+		for _, header := range []*pb.RequestHeader{source, destination} {
+			_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("taken")})
+			require.NoError(t, err)
+		}
+
+		_, err = projects[0].CreateBucket(ctx, "moved")
+		require.NoError(t, err)
+		expectedData := testrand.Bytes(5 * memory.KiB)
+		upload, err := projects[0].UploadObject(ctx, "moved", "object", nil)
+		require.NoError(t, err)
+		_, err = upload.Write(expectedData)
+		require.NoError(t, err)
+		require.NoError(t, upload.Commit())
+
+		t.Run("name collision", func(t *testing.T) {
+			_, err := endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: destination, Name: []byte("taken"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+		})
+
+		t.Run("cross account", func(t *testing.T) {
+			otherAccount := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+			_, err := endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: otherAccount, Name: []byte("moved"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		})
+
+		t.Run("same project", func(t *testing.T) {
+			_, err := endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: source, Name: []byte("moved"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		})
+
+		t.Run("missing bucket", func(t *testing.T) {
+			_, err := endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: destination, Name: []byte("missing"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+
+		t.Run("resume", func(t *testing.T) {
+			_, err := endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: source, Name: []byte("resumed")})
+			require.NoError(t, err)
+
+			// the transfer was interrupted after the bucket moved
+			require.NoError(t, sat.API.Buckets.Service.StartBucketTransfer(ctx, projectIDs[0], []byte("resumed"), projectIDs[1]))
+
+			// the name stays taken in the source project until the transfer finishes
+			_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: source, Name: []byte("resumed")})
+			require.True(t, errs2.IsRPC(err, rpcstatus.AlreadyExists))
+
+			_, err = endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: destination, Name: []byte("resumed"),
+			})
+			require.NoError(t, err)
+
+			_, err = sat.API.Buckets.Service.GetBucketTransfer(ctx, projectIDs[0], []byte("resumed"))
+			require.True(t, buckets.ErrBucketTransferNotFound.Has(err))
+		})
+
+		t.Run("transfer", func(t *testing.T) {
+			resp, err := endpoint.TransferBucket(ctx, &metainfo.BucketTransferRequest{
+				Header: source, DestinationHeader: destination, Name: []byte("moved"),
+			})
+			require.NoError(t, err)
+			require.Equal(t, []byte("moved"), resp.Bucket.Name)
+
+			_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: source, Name: []byte("moved")})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+			_, err = endpoint.GetBucket(ctx, &pb.BucketGetRequest{Header: destination, Name: []byte("moved")})
+			require.NoError(t, err)
+
+			// the objects are accessible with the same passphrase in the destination project
+			download, err := projects[1].DownloadObject(ctx, "moved", "object", nil)
+			require.NoError(t, err)
+			defer ctx.Check(download.Close)
+			data, err := io.ReadAll(download)
+			require.NoError(t, err)
+			require.Equal(t, expectedData, data)
+		})
+	})
+}
+
+func TestDeleteBucketSkipPieceDeletion(t *testing.T) {
+	deleteBucket := func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) *metainfo.BucketDeleteResponse {
+		sat := planet.Satellites[0]
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+
+		err := planet.Uplinks[0].Upload(ctx, sat, "bucket", "object", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		resp, err := sat.API.Metainfo.Endpoint.DeleteBucketWithOptions(ctx, &metainfo.BucketDeleteRequest{
+			BucketDeleteRequest: &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket"), DeleteAll: true},
+			SkipPieceDeletion:   true,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.DeletedObjectsCount)
+
+		objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+		return resp
+	}
+
+	t.Run("ignored without testing config", func(t *testing.T) {
+		testplanet.Run(t, testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			resp := deleteBucket(t, ctx, planet)
+			require.NotZero(t, resp.FreedBytes)
+		})
+	})
+
+	t.Run("allowed by testing config", func(t *testing.T) {
+		testplanet.Run(t, testplanet.Config{
+			SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+			Reconfigure: testplanet.Reconfigure{
+				Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Metainfo.TestingAllowSkipPieceDeletion = true
+				},
+			},
+		}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			resp := deleteBucket(t, ctx, planet)
+			require.Zero(t, resp.FreedBytes)
+		})
+	})
+}
+
+// spanNames records the full names of the started spans.
+type spanNames struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (observer *spanNames) Start(span *monkit.Span) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+	observer.names = append(observer.names, span.Func().FullName())
+}
+
+func (observer *spanNames) Finish(span *monkit.Span, err error, panicked bool, finish time.Time) {}
+
+func TestDeleteBucketTraceSpans(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		sat := planet.Satellites[0]
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "traced", "object", testrand.Bytes(memory.KiB)))
+
+		observer := &spanNames{}
+		cancel := monkit.Default.ObserveTraces(func(trace *monkit.Trace) {
+			trace.ObserveSpans(observer)
+		})
+		defer cancel()
+
+		_, err := sat.API.Metainfo.Endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{
+			Header:    &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+			Name:      []byte("traced"),
+			DeleteAll: true,
+		})
+		require.NoError(t, err)
+
+		// the database and metabase calls of the endpoint have their own spans
+		observer.mu.Lock()
+		defer observer.mu.Unlock()
+		for _, name := range []string{
+			"storj.io/storj/satellite/satellitedb.(*bucketsDB).GetMinimalBucket",
+			"storj.io/storj/satellite/metabase.(*DB).BucketEmpty",
+			"storj.io/storj/satellite/metabase.(*DB).DeleteBucketObjects",
+			"storj.io/storj/satellite/satellitedb.(*bucketsDB).DeleteBucket",
+		} {
+			require.Contains(t, observer.names, name)
+		}
+	})
+}
+
+func TestBucketAudit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketAudit.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		readOnlyKey, err := apiKey.Restrict(macaroon.Caveat{DisallowWrites: true, DisallowDeletes: true})
+		require.NoError(t, err)
+		readOnlyHeader := &pb.RequestHeader{ApiKey: readOnlyKey.SerializeRaw()}
+
+		// failed mutations aren't audited
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: readOnlyHeader, Name: []byte("denied")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		_, err = endpoint.CreateBucket(ctx, &pb.BucketCreateRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: readOnlyHeader, Name: []byte("bucket")})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		_, err = endpoint.DeleteBucket(ctx, &pb.BucketDeleteRequest{Header: header, Name: []byte("bucket")})
+		require.NoError(t, err)
+
+		// the records are stored in the background
+		var records []buckets.AuditRecord
+		require.Eventually(t, func() bool {
+			records, err = sat.API.Buckets.Service.ListAuditRecords(ctx, projectID, 10)
+			require.NoError(t, err)
+			return len(records) >= 2
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Len(t, records, 2)
+
+		keyInfo, err := sat.DB.Console().APIKeys().GetByHead(ctx, apiKey.Head())
+		require.NoError(t, err)
+
+		var actions []string
+		for _, record := range records {
+			require.Equal(t, projectID, record.ProjectID)
+			require.Equal(t, []byte("bucket"), record.BucketName)
+			require.Equal(t, keyInfo.ID, record.APIKeyID)
+			require.Equal(t, apiKey.Head(), record.APIKeyHead)
+			actions = append(actions, record.Action)
+		}
+		require.ElementsMatch(t, []string{buckets.AuditActionCreate, buckets.AuditActionDelete}, actions)
+	})
+}
+
+func TestBucketAuditBatchDeleteAndRename(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketAudit.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.API.Metainfo.Endpoint
+		projectID := planet.Uplinks[0].Projects[0].ID
+		header := &pb.RequestHeader{ApiKey: planet.Uplinks[0].APIKey[sat.ID()].SerializeRaw()}
+
+		// the buckets are created directly in the database, so their creation isn't audited
+		for _, name := range []string{"first", "second", "renamed"} {
+			_, err := sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+				ID:        testrand.UUID(),
+				Name:      name,
+				ProjectID: projectID,
+			})
+			require.NoError(t, err)
+		}
+
+		resp, err := endpoint.BatchDeleteBuckets(ctx, &metainfo.BatchDeleteBucketsRequest{
+			Header: header,
+			Names:  [][]byte{[]byte("first"), []byte("second"), []byte("missing")},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+
+		_, err = endpoint.RenameBucket(ctx, &metainfo.BucketRenameRequest{
+			Header:  header,
+			Name:    []byte("renamed"),
+			NewName: []byte("new-name"),
+		})
+		require.NoError(t, err)
+
+		// the records are stored in the background, buckets, which weren't deleted, aren't audited
+		var records []buckets.AuditRecord
+		require.Eventually(t, func() bool {
+			records, err = sat.API.Buckets.Service.ListAuditRecords(ctx, projectID, 10)
+			require.NoError(t, err)
+			return len(records) >= 4
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Len(t, records, 4)
+
+		var audited []string
+		for _, record := range records {
+			audited = append(audited, record.Action+":"+string(record.BucketName))
+		}
+		require.ElementsMatch(t, []string{
+			buckets.AuditActionDelete + ":first",
+			buckets.AuditActionDelete + ":second",
+			buckets.AuditActionDelete + ":renamed",
+			buckets.AuditActionCreate + ":new-name",
+		}, audited)
+	})
+}
@@ -69,6 +69,11 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	objectKeyLength := len(req.EncryptedPath)
 	if objectKeyLength > endpoint.config.MaxEncryptedObjectKeyLength {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
@@ -80,7 +85,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}
 
 	// TODO this needs to be optimized to avoid DB call on each request
-	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
 			return nil, rpcstatus.Errorf(rpcstatus.NotFound, "bucket not found: %s", req.Bucket)
@@ -88,6 +93,16 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		endpoint.log.Error("unable to check bucket", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
+	if bucket.Frozen {
+		return nil, rpcstatus.Errorf(rpcstatus.PermissionDenied, "bucket is frozen: %s", req.Bucket)
+	}
+	placement := bucket.Placement
+
+	// objects without an expiration inherit the default TTL of the bucket
+	expirationDate := req.ExpiresAt
+	if expirationDate.IsZero() && bucket.DefaultObjectTTL > 0 {
+		expirationDate = now.Add(bucket.DefaultObjectTTL)
+	}
 
 	if canDelete {
 		_, err = endpoint.DeleteObjectAnyStatus(ctx, metabase.ObjectLocation{
@@ -112,7 +127,8 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		}
 	}
 
-	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.Bucket); err != nil {
+	// an upload doesn't fail just because the bucket is attributed to someone else
+	if err := endpoint.ensureAttribution(ctx, req.Header, keyInfo, req.Bucket); err != nil && !ErrAttributionConflict.Has(err) {
 		return nil, err
 	}
 
@@ -130,10 +146,10 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	}
 
 	var expiresAt *time.Time
-	if req.ExpiresAt.IsZero() {
+	if expirationDate.IsZero() {
 		expiresAt = nil
 	} else {
-		expiresAt = &req.ExpiresAt
+		expiresAt = &expirationDate
 	}
 
 	var nonce []byte
@@ -165,7 +181,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		EncryptedObjectKey:   req.EncryptedPath,
 		Version:              int32(object.Version),
 		CreationDate:         object.CreatedAt,
-		ExpirationDate:       req.ExpiresAt,
+		ExpirationDate:       expirationDate,
 		StreamId:             streamID[:],
 		MultipartObject:      object.FixedSegmentSize <= 0,
 		EncryptionParameters: req.EncryptionParameters,
@@ -178,6 +194,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 
 	endpoint.log.Info("Object Upload", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "put"), zap.String("type", "object"))
 	mon.Meter("req_put_object").Mark(1)
+	endpoint.logAccess(ctx, keyInfo, req.Bucket, accessLogPut, req.EncryptedPath)
 
 	return &pb.ObjectBeginResponse{
 		Bucket:           req.Bucket,
@@ -214,6 +231,12 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("Metadata is too large, got %v, maximum allowed is %v", metadataSize, endpoint.config.MaxMetadataSize))
 	}
 
+	// the upload may have begun before the bucket was frozen
+	err = endpoint.checkBucketNotFrozen(ctx, streamID.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -286,6 +309,11 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	mbObject, err := endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
 		ObjectLocation: metabase.ObjectLocation{
 			ProjectID:  keyInfo.ProjectID,
@@ -335,6 +363,7 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 
 	endpoint.log.Info("Object Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "object"))
 	mon.Meter("req_get_object").Mark(1)
+	endpoint.logAccess(ctx, keyInfo, req.Bucket, accessLogHead, req.EncryptedPath)
 
 	return &pb.ObjectGetResponse{Object: object}, nil
 }
@@ -364,6 +393,11 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	if exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID); err != nil {
 		if errs2.IsCanceled(err) {
 			return nil, rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -556,6 +590,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 
 	endpoint.log.Info("Download Object", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "download"), zap.String("type", "object"))
 	mon.Meter("req_download_object").Mark(1)
+	endpoint.logAccess(ctx, keyInfo, req.Bucket, accessLogGet, req.EncryptedObjectKey)
 
 	return &pb.ObjectDownloadResponse{
 		Object: protoObject,
@@ -713,6 +748,11 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO this needs to be optimized to avoid DB call on each request
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
@@ -815,6 +855,11 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	placement, err := endpoint.buckets.GetBucketPlacement(ctx, req.Bucket, keyInfo.ProjectID)
 	if err != nil {
 		if storj.ErrBucketNotFound.Has(err) {
@@ -927,6 +972,11 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	var deletedObjects []*pb.Object
 
 	if req.GetStatus() == int32(metabase.Pending) {
@@ -978,6 +1028,7 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 
 	endpoint.log.Info("Object Delete", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "delete"), zap.String("type", "object"))
 	mon.Meter("req_delete_object").Mark(1)
+	endpoint.logAccess(ctx, keyInfo, req.Bucket, accessLogDelete, req.EncryptedPath)
 
 	return &pb.ObjectBeginDeleteResponse{
 		Object: object,
@@ -1006,6 +1057,11 @@ func (endpoint *Endpoint) GetObjectIPs(ctx context.Context, req *pb.ObjectGetIPs
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO we may need custom metabase request to avoid two DB calls
 	object, err := endpoint.metabase.GetObjectExactVersion(ctx, metabase.GetObjectExactVersion{
 		ObjectLocation: metabase.ObjectLocation{
@@ -1081,6 +1137,11 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1092,6 +1153,11 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	err = endpoint.checkBucketNotFrozen(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	var encryptedMetadataNonce []byte
 	if !req.EncryptedMetadataNonce.IsZero() {
 		encryptedMetadataNonce = req.EncryptedMetadataNonce[:]
@@ -1400,13 +1466,16 @@ func (endpoint *Endpoint) deleteObjectsPieces(ctx context.Context, result metaba
 		deletedObjects[i] = deletedObject
 	}
 
-	endpoint.deleteSegmentPieces(ctx, result.Segments)
+	// the failure is logged, the pieces are left to the garbage collection
+	_ = endpoint.deleteSegmentPieces(ctx, result.Segments)
 
 	return deletedObjects, nil
 }
 
-func (endpoint *Endpoint) deleteSegmentPieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) {
-	var err error
+// deleteSegmentPieces deletes the pieces of the segments from the storage nodes.
+// A failure is logged and returned, the pieces, which weren't deleted, are left
+// to the garbage collection.
+func (endpoint *Endpoint) deleteSegmentPieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	nodesPieces := groupPiecesByNodeID(segments)
@@ -1421,12 +1490,11 @@ func (endpoint *Endpoint) deleteSegmentPieces(ctx context.Context, segments []me
 		})
 	}
 
-	// Only return an error if we failed to delete the objects. If we failed
-	// to delete pieces, let garbage collector take care of it.
 	err = endpoint.deletePieces.Delete(ctx, requests, deleteObjectPiecesSuccessThreshold)
 	if err != nil {
 		endpoint.log.Error("failed to delete pieces", zap.Error(err))
 	}
+	return err
 }
 
 // groupPiecesByNodeID returns a map that contains pieces with node id as the key.
@@ -1490,6 +1558,16 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 		}
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.NewBucket, err = endpoint.resolveBucketName(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// if source and target buckets are different, we need to check their geofencing configs
 	if !bytes.Equal(req.Bucket, req.NewBucket) {
 		// TODO we may try to combine those two DB calls into single one
@@ -1632,12 +1710,13 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
+	req.NewBucket, err = endpoint.resolveBucketName(ctx, req.NewBucket, keyInfo.ProjectID)
 	if err != nil {
-		endpoint.log.Error("unable to check bucket", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, err
+	}
+
+	if err := endpoint.checkTargetBucket(ctx, req.NewBucket, keyInfo.ProjectID); err != nil {
+		return nil, err
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
@@ -1666,6 +1745,44 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 	return &pb.ObjectFinishMoveResponse{}, nil
 }
 
+// checkTargetBucket returns an error when objects can't be moved or copied to the bucket,
+// because it doesn't exist or it's frozen.
+func (endpoint *Endpoint) checkTargetBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", bucketName)
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if bucket.Frozen {
+		return rpcstatus.Errorf(rpcstatus.PermissionDenied, "target bucket is frozen: %s", bucketName)
+	}
+	return nil
+}
+
+// checkBucketNotFrozen returns an error when the bucket is frozen. A bucket, which
+// doesn't exist, isn't frozen, the write then fails or succeeds like before.
+func (endpoint *Endpoint) checkBucketNotFrozen(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, err := endpoint.bucketStore.GetMinimalBucket(ctx, bucketName, projectID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return nil
+		}
+		endpoint.log.Error("unable to check bucket", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if bucket.Frozen {
+		return rpcstatus.Errorf(rpcstatus.PermissionDenied, "bucket is frozen: %s", bucketName)
+	}
+	return nil
+}
+
 // Server side copy.
 
 // BeginCopyObject begins copying object to different key.
@@ -1716,6 +1833,16 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		}
 	}
 
+	req.Bucket, err = endpoint.resolveBucketName(ctx, req.Bucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.NewBucket, err = endpoint.resolveBucketName(ctx, req.NewBucket, keyInfo.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// if source and target buckets are different, we need to check their geofencing configs
 	if !bytes.Equal(req.Bucket, req.NewBucket) {
 		// TODO we may try to combine those two DB calls into single one
@@ -1862,12 +1989,13 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	exists, err := endpoint.buckets.HasBucket(ctx, req.NewBucket, keyInfo.ProjectID)
+	req.NewBucket, err = endpoint.resolveBucketName(ctx, req.NewBucket, keyInfo.ProjectID)
 	if err != nil {
-		endpoint.log.Error("unable to check bucket", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
-	} else if !exists {
-		return nil, rpcstatus.Errorf(rpcstatus.NotFound, "target bucket not found: %s", req.NewBucket)
+		return nil, err
+	}
+
+	if err := endpoint.checkTargetBucket(ctx, req.NewBucket, keyInfo.ProjectID); err != nil {
+		return nil, err
 	}
 
 	streamUUID, err := uuid.FromBytes(streamID.StreamId)
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/storj/private/jitter"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -26,6 +27,8 @@ type Config struct {
 	Interval  time.Duration `help:"the time between each attempt to go through the db and clean up expired segments" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
 	Enabled   bool          `help:"set if expired segment cleanup is enabled or not" releaseDefault:"true" devDefault:"true"`
 	ListLimit int           `help:"how many expired objects to query in a batch" default:"100"`
+
+	StartJitter float64 `help:"fraction of the interval by which the first run is randomly delayed, negative uses chore-jitter.fraction" default:"-1"`
 }
 
 // Chore implements the expired segment cleanup chore.
@@ -36,8 +39,9 @@ type Chore struct {
 	config   Config
 	metabase *metabase.DB
 
-	nowFn func() time.Time
-	Loop  *sync2.Cycle
+	nowFn      func() time.Time
+	startDelay *jitter.Delay
+	Loop       *sync2.Cycle
 }
 
 // NewChore creates a new instance of the expireddeletion chore.
@@ -47,8 +51,9 @@ func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
 		config:   config,
 		metabase: metabase,
 
-		nowFn: time.Now,
-		Loop:  sync2.NewCycle(config.Interval),
+		nowFn:      time.Now,
+		startDelay: jitter.NewDelay(config.Interval, config.StartJitter),
+		Loop:       sync2.NewCycle(config.Interval),
 	}
 }
 
@@ -60,11 +65,16 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		return nil
 	}
 
+	if !chore.startDelay.Wait(ctx) {
+		return ctx.Err()
+	}
+
 	return chore.Loop.Run(ctx, chore.deleteExpiredObjects)
 }
 
 // Close stops the expireddeletion chore.
 func (chore *Chore) Close() error {
+	chore.startDelay.Close()
 	chore.Loop.Close()
 	return nil
 }
@@ -0,0 +1,141 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
+)
+
+// maxIdempotencyKeyLength is the maximum length of a client supplied idempotency key.
+const maxIdempotencyKeyLength = 128
+
+// idempotencyEnabled returns whether the request result should be replayed or stored by key.
+func (endpoint *Endpoint) idempotencyEnabled(key []byte) bool {
+	return len(key) > 0 && endpoint.config.IdempotencyKeyTTL > 0
+}
+
+// replayIdempotent returns the response stored for the idempotency key, or nil when
+// the request wasn't executed yet. It fails when the key was used for another request.
+func (endpoint *Endpoint) replayIdempotent(ctx context.Context, projectID uuid.UUID, key []byte, operation string, bucketName []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "idempotency key is longer than %d bytes", maxIdempotencyKeyLength)
+	}
+
+	result, err := endpoint.buckets.GetIdempotencyResult(ctx, projectID, key, time.Now().Add(-endpoint.config.IdempotencyKeyTTL))
+	if err != nil {
+		if buckets.ErrIdempotencyKeyNotFound.Has(err) {
+			return nil, nil
+		}
+		endpoint.logger(ctx).Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, "unable to check idempotency key")
+	}
+
+	if result.Operation != operation || !bytes.Equal(result.BucketName, bucketName) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "idempotency key was used for a different request")
+	}
+
+	mon.Meter("bucket_idempotent_replay", monkit.NewSeriesTag("op", operation)).Mark(1)
+	return result.Response, nil
+}
+
+// storeIdempotent stores the response of a successful request for replaying retries.
+// The request has already succeeded, so failures are only logged.
+func (endpoint *Endpoint) storeIdempotent(ctx context.Context, projectID uuid.UUID, key []byte, operation string, bucketName []byte, response []byte) {
+	now := time.Now()
+	err := endpoint.buckets.StoreIdempotencyResult(ctx, projectID, key, buckets.IdempotencyResult{
+		Operation:  operation,
+		BucketName: bucketName,
+		Response:   response,
+		CreatedAt:  now,
+	}, now.Add(-endpoint.config.IdempotencyKeyTTL))
+	if err != nil {
+		endpoint.logger(ctx).Warn("unable to store idempotency key result",
+			zap.Stringer("Project ID", projectID),
+			zap.String("operation", operation),
+			zap.Error(err))
+	}
+}
+
+// idempotentCreateResponse is the stored form of BucketCreateResponse.
+type idempotentCreateResponse struct {
+	Bucket            []byte
+	ObjectLockEnabled bool
+	DefaultRetention  buckets.DefaultRetention
+}
+
+func encodeCreateResponse(resp *BucketCreateResponse) ([]byte, error) {
+	bucket, err := pb.Marshal(resp.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(idempotentCreateResponse{
+		Bucket:            bucket,
+		ObjectLockEnabled: resp.ObjectLockEnabled,
+		DefaultRetention:  resp.DefaultRetention,
+	})
+}
+
+func decodeCreateResponse(data []byte) (*BucketCreateResponse, error) {
+	var stored idempotentCreateResponse
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	bucket := &pb.Bucket{}
+	if err := pb.Unmarshal(stored.Bucket, bucket); err != nil {
+		return nil, err
+	}
+	return &BucketCreateResponse{
+		Bucket:            bucket,
+		ObjectLockEnabled: stored.ObjectLockEnabled,
+		DefaultRetention:  stored.DefaultRetention,
+	}, nil
+}
+
+// idempotentDeleteResponse is the stored form of BucketDeleteResponse.
+type idempotentDeleteResponse struct {
+	Response            []byte
+	FreedBytes          int64
+	AbortedUploadsCount int64
+}
+
+func encodeDeleteResponse(resp *BucketDeleteResponse) ([]byte, error) {
+	response, err := pb.Marshal(resp.BucketDeleteResponse)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(idempotentDeleteResponse{
+		Response:            response,
+		FreedBytes:          resp.FreedBytes,
+		AbortedUploadsCount: resp.AbortedUploadsCount,
+	})
+}
+
+func decodeDeleteResponse(data []byte) (*BucketDeleteResponse, error) {
+	var stored idempotentDeleteResponse
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, err
+	}
+	response := &pb.BucketDeleteResponse{}
+	if err := pb.Unmarshal(stored.Response, response); err != nil {
+		return nil, err
+	}
+	return &BucketDeleteResponse{
+		BucketDeleteResponse: response,
+		FreedBytes:           stored.FreedBytes,
+		AbortedUploadsCount:  stored.AbortedUploadsCount,
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/drpc/drpcmetadata"
+)
+
+// TraceIDMetadataKey is the drpc metadata key, which clients of GetBucket, CreateBucket,
+// DeleteBucket and ListBuckets send the trace id of the request in, since
+// pb.RequestHeader has no field for it.
+const TraceIDMetadataKey = "storj-trace-id"
+
+// maxTraceIDLength is the maximum length of a trace id sent by a client.
+const maxTraceIDLength = 128
+
+// traceIDKey is the context key of the trace id of a bucket request.
+type traceIDKey struct{}
+
+// TraceIDFromContext returns the trace id of the bucket request, which is handled with ctx.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	traceID, ok := ctx.Value(traceIDKey{}).(string)
+	return traceID, ok
+}
+
+// withTraceID attaches the trace id of a bucket request to ctx and to its monkit span,
+// so it's included in the logs of the endpoint and in the traces of the request.
+// The trace id is taken from the request, from the drpc metadata or, when neither
+// has one, it's generated.
+func withTraceID(ctx context.Context, traceID string) (_ context.Context, _ string, err error) {
+	if traceID == "" {
+		if metadata, ok := drpcmetadata.Get(ctx); ok {
+			traceID = metadata[TraceIDMetadataKey]
+		}
+	}
+	if traceID == "" {
+		if existing, ok := TraceIDFromContext(ctx); ok {
+			traceID = existing
+		}
+	}
+
+	if traceID == "" {
+		id, err := uuid.New()
+		if err != nil {
+			return nil, "", rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		traceID = id.String()
+	} else if err := validateTraceID(traceID); err != nil {
+		return nil, "", err
+	}
+
+	if span := monkit.SpanFromCtx(ctx); span != nil {
+		span.Annotate("trace_id", traceID)
+	}
+	return context.WithValue(ctx, traceIDKey{}, traceID), traceID, nil
+}
+
+// validateTraceID checks that the trace id sent by a client is safe to log.
+func validateTraceID(traceID string) error {
+	if len(traceID) > maxTraceIDLength {
+		return rpcstatus.Errorf(rpcstatus.InvalidArgument, "trace id is longer than %d bytes", maxTraceIDLength)
+	}
+	for _, r := range traceID {
+		if r < '!' || r > '~' {
+			return rpcstatus.Error(rpcstatus.InvalidArgument, "trace id must contain only printable ASCII characters without spaces")
+		}
+	}
+	return nil
+}
+
+// logger returns the logger of the endpoint, which includes the trace id of the
+// bucket request handled with ctx, if there's one.
+func (endpoint *Endpoint) logger(ctx context.Context) *zap.Logger {
+	if traceID, ok := TraceIDFromContext(ctx); ok {
+		return endpoint.log.With(zap.String("Trace ID", traceID))
+	}
+	return endpoint.log
+}
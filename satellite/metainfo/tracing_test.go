@@ -0,0 +1,74 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/drpc/drpcmetadata"
+)
+
+func TestWithTraceID(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// the trace id of the request is used
+	traceCtx, traceID, err := withTraceID(ctx, "request-trace")
+	require.NoError(t, err)
+	require.Equal(t, "request-trace", traceID)
+	fromCtx, ok := TraceIDFromContext(traceCtx)
+	require.True(t, ok)
+	require.Equal(t, "request-trace", fromCtx)
+
+	// nested calls keep the trace id of the request
+	_, traceID, err = withTraceID(traceCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, "request-trace", traceID)
+
+	// protocol requests send it in the drpc metadata
+	_, traceID, err = withTraceID(drpcmetadata.Add(ctx, TraceIDMetadataKey, "metadata-trace"), "")
+	require.NoError(t, err)
+	require.Equal(t, "metadata-trace", traceID)
+
+	// it's generated when absent
+	_, traceID, err = withTraceID(ctx, "")
+	require.NoError(t, err)
+	_, err = uuid.FromString(traceID)
+	require.NoError(t, err)
+
+	for _, invalid := range []string{
+		strings.Repeat("a", maxTraceIDLength+1),
+		"with space",
+		"with\nnewline",
+	} {
+		_, _, err = withTraceID(ctx, invalid)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), invalid)
+	}
+}
+
+func TestEndpointLoggerTraceID(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	core, logs := observer.New(zap.DebugLevel)
+	endpoint := &Endpoint{log: zap.New(core)}
+
+	endpoint.logger(ctx).Info("without trace")
+
+	traceCtx, traceID, err := withTraceID(ctx, "")
+	require.NoError(t, err)
+	endpoint.logger(traceCtx).Error("internal")
+
+	entries := logs.All()
+	require.Len(t, entries, 2)
+	require.NotContains(t, entries[0].ContextMap(), "Trace ID")
+	require.Equal(t, traceID, entries[1].ContextMap()["Trace ID"])
+}
@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"context"
 	"crypto/subtle"
+	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"time"
@@ -22,6 +24,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/metabase"
@@ -56,13 +59,22 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHe
 
 	err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
 	if err != nil {
-		endpoint.log.Debug("unauthorized request", zap.Error(err))
+		endpoint.logger(ctx).Debug("unauthorized request", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
 	return keyInfo, nil
 }
 
+// hideBucketExistence replaces the PermissionDenied error of a request for the bucket
+// with the error of a missing bucket, when HideBucketExistence is enabled.
+func (endpoint *Endpoint) hideBucketExistence(err error, bucketName []byte) error {
+	if endpoint.config.HideBucketExistence && errs2.IsRPC(err, rpcstatus.PermissionDenied) {
+		return rpcstatus.Error(rpcstatus.NotFound, storj.ErrBucketNotFound.New("%s", bucketName).Error())
+	}
+	return err
+}
+
 type verifyPermission struct {
 	action          macaroon.Action
 	actionPermitted *bool
@@ -101,7 +113,7 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 			*p.actionPermitted = err == nil
 		}
 		if err != nil && !p.optional {
-			endpoint.log.Debug("unauthorized request", zap.Error(err))
+			endpoint.logger(ctx).Debug("unauthorized request", zap.Error(err))
 			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 		}
 	}
@@ -114,18 +126,18 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 
 	key, err := getAPIKey(ctx, header)
 	if err != nil {
-		endpoint.log.Debug("invalid request", zap.Error(err))
+		endpoint.logger(ctx).Debug("invalid request", zap.Error(err))
 		return nil, nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Invalid API credentials")
 	}
 
 	keyInfo, err := endpoint.apiKeys.GetByHead(ctx, key.Head())
 	if err != nil {
-		endpoint.log.Debug("unauthorized request", zap.Error(err))
+		endpoint.logger(ctx).Debug("unauthorized request", zap.Error(err))
 		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 	}
 
 	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
-		endpoint.log.Debug("rate check failed", zap.Error(err))
+		endpoint.logger(ctx).Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
 	}
 
@@ -187,7 +199,7 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	}
 
 	if !limiter.(*rate.Limiter).Allow() {
-		endpoint.log.Warn("too many requests for project",
+		endpoint.logger(ctx).Warn("too many requests for project",
 			zap.Stringer("projectID", projectID),
 			zap.Float64("rate limit", float64(limiter.(*rate.Limiter).Limit())),
 			zap.Float64("burst limit", float64(limiter.(*rate.Limiter).Burst())))
@@ -200,6 +212,83 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	return nil
 }
 
+// validateRedundancyScheme checks that the redundancy scheme is usable, in particular
+// that its stripe size, which is the default encryption block size of the buckets,
+// is positive and fits the block size of the encryption parameters.
+func validateRedundancyScheme(rs *pb.RedundancyScheme) error {
+	switch {
+	case rs == nil:
+		return ErrInvalidRedundancyScheme.New("missing")
+	case rs.MinReq <= 0:
+		return ErrInvalidRedundancyScheme.New("required shares must be positive, got %d", rs.MinReq)
+	case rs.ErasureShareSize <= 0:
+		return ErrInvalidRedundancyScheme.New("erasure share size must be positive, got %d", rs.ErasureShareSize)
+	case rs.Total < rs.MinReq:
+		return ErrInvalidRedundancyScheme.New("total shares %d are less than the required shares %d", rs.Total, rs.MinReq)
+	case stripeSize(rs) > math.MaxInt32:
+		return ErrInvalidRedundancyScheme.New("stripe size of %d required shares of %d bytes overflows the encryption block size",
+			rs.MinReq, rs.ErasureShareSize)
+	}
+	return nil
+}
+
+// stripeSize returns the size of a stripe of the redundancy scheme.
+func stripeSize(rs *pb.RedundancyScheme) int64 {
+	return int64(rs.ErasureShareSize) * int64(rs.MinReq)
+}
+
+// BucketNameErrorCode identifies the rule an invalid bucket name breaks.
+type BucketNameErrorCode int
+
+const (
+	// BucketNameTooShort is the code for names shorter than 3 characters.
+	BucketNameTooShort BucketNameErrorCode = iota + 1
+	// BucketNameTooLong is the code for names longer than 63 characters.
+	BucketNameTooLong
+	// BucketNameIllegalCharacter is the code for names containing a character,
+	// which isn't allowed at all or isn't allowed at its position.
+	BucketNameIllegalCharacter
+	// BucketNameReserved is the code for names formatted as an IP address or
+	// using a reserved prefix or suffix.
+	BucketNameReserved
+)
+
+// String implements fmt.Stringer.
+func (code BucketNameErrorCode) String() string {
+	switch code {
+	case BucketNameTooShort:
+		return "TooShort"
+	case BucketNameTooLong:
+		return "TooLong"
+	case BucketNameIllegalCharacter:
+		return "IllegalCharacter"
+	case BucketNameReserved:
+		return "Reserved"
+	default:
+		return fmt.Sprintf("BucketNameErrorCode(%d)", int(code))
+	}
+}
+
+// BucketNameError is the cause of the InvalidArgument error returned for an invalid
+// bucket name. The code is included in the error message, so it's available to clients.
+type BucketNameError struct {
+	Code    BucketNameErrorCode
+	Message string
+}
+
+// Error implements the error interface.
+func (err *BucketNameError) Error() string {
+	return fmt.Sprintf("invalid bucket name (%s): %s", err.Code, err.Message)
+}
+
+// bucketNameError returns the BucketNameError with the code and message.
+func bucketNameError(code BucketNameErrorCode, format string, args ...interface{}) error {
+	return Error.Wrap(&BucketNameError{Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// validateBucket checks the bucket name of a request. When CaseInsensitiveNames is
+// enabled, the normalized name is checked, so that names, which are only valid in
+// lowercase, are accepted. The name itself isn't modified.
 func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -207,8 +296,16 @@ func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (er
 		return Error.Wrap(storj.ErrNoBucket.New(""))
 	}
 
-	if len(bucket) < 3 || len(bucket) > 63 {
-		return Error.New("bucket name must be at least 3 and no more than 63 characters long")
+	if endpoint.config.CaseInsensitiveNames {
+		bucket = buckets.NormalizeName(bucket)
+	}
+
+	if endpoint.config.S3CompatibleNames {
+		return validateS3BucketName(bucket)
+	}
+
+	if err := validateBucketNameLength(bucket, "bucket name must be at least 3 and no more than 63 characters long"); err != nil {
+		return err
 	}
 
 	// Regexp not used because benchmark shows it will be slower for valid bucket names
@@ -222,28 +319,138 @@ func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (er
 	}
 
 	if ipRegexp.MatchString(string(bucket)) {
-		return Error.New("bucket name cannot be formatted as an IP address")
+		return bucketNameError(BucketNameReserved, "bucket name cannot be formatted as an IP address")
+	}
+
+	return nil
+}
+
+// validateCostCenter checks that the cost center is one of Config.BucketCostCenters,
+// empty means no cost center and is always valid.
+func (endpoint *Endpoint) validateCostCenter(costCenter string) error {
+	if costCenter == "" {
+		return nil
+	}
+	for _, allowed := range endpoint.config.BucketCostCenters {
+		if costCenter == allowed {
+			return nil
+		}
+	}
+	return rpcstatus.Errorf(rpcstatus.InvalidArgument, "cost center %q isn't allowed", costCenter)
+}
+
+// resolveBucketName returns the name of the bucket, which the bucket name of a request
+// refers to. It's unchanged, unless bucket names match case-insensitively.
+func (endpoint *Endpoint) resolveBucketName(ctx context.Context, bucket []byte, projectID uuid.UUID) (_ []byte, err error) {
+	names, err := endpoint.resolveBucketNames(ctx, [][]byte{bucket}, projectID)
+	if err != nil {
+		return nil, err
 	}
+	return names[0], nil
+}
+
+// resolveBucketNames returns the names of the buckets, which the bucket names of a
+// request refer to, with a single query. They're unchanged, unless bucket names match
+// case-insensitively.
+func (endpoint *Endpoint) resolveBucketNames(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store, ok := endpoint.bucketStore.(caseInsensitiveBucketStore)
+	if !ok {
+		return bucketNames, nil
+	}
+
+	names, err := store.GetCaseInsensitiveBucketNames(ctx, bucketNames, projectID)
+	if err != nil {
+		endpoint.logger(ctx).Error("unable to resolve bucket names", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return names, nil
+}
 
+// validateBucketNotReserved checks that a new bucket name doesn't start with a reserved prefix.
+// It isn't part of validateBucket, so the existing buckets with such names keep working.
+func (endpoint *Endpoint) validateBucketNotReserved(bucket []byte) error {
+	if endpoint.config.CaseInsensitiveNames {
+		bucket = buckets.NormalizeName(bucket)
+	}
+	for _, prefix := range endpoint.config.ReservedBucketPrefixes {
+		if prefix != "" && bytes.HasPrefix(bucket, []byte(prefix)) {
+			return bucketNameError(BucketNameReserved, "bucket name cannot start with the reserved prefix %q", prefix)
+		}
+	}
+	return nil
+}
+
+// validateBucketNameLength checks that the bucket name is between 3 and 63 characters long,
+// returning message with the TooShort or TooLong code otherwise.
+func validateBucketNameLength(bucket []byte, message string) error {
+	switch {
+	case len(bucket) < 3:
+		return bucketNameError(BucketNameTooShort, "%s", message)
+	case len(bucket) > 63:
+		return bucketNameError(BucketNameTooLong, "%s", message)
+	}
 	return nil
 }
 
 func validateBucketLabel(label []byte) error {
 	if len(label) == 0 {
-		return Error.New("bucket label cannot be empty")
+		return bucketNameError(BucketNameIllegalCharacter, "bucket label cannot be empty")
 	}
 
 	if !isLowerLetter(label[0]) && !isDigit(label[0]) {
-		return Error.New("bucket label must start with a lowercase letter or number")
+		return bucketNameError(BucketNameIllegalCharacter, "bucket label must start with a lowercase letter or number")
 	}
 
 	if label[0] == '-' || label[len(label)-1] == '-' {
-		return Error.New("bucket label cannot start or end with a hyphen")
+		return bucketNameError(BucketNameIllegalCharacter, "bucket label cannot start or end with a hyphen")
 	}
 
 	for i := 1; i < len(label)-1; i++ {
 		if !isLowerLetter(label[i]) && !isDigit(label[i]) && (label[i] != '-') && (label[i] != '.') {
-			return Error.New("bucket name must contain only lowercase letters, numbers or hyphens")
+			return bucketNameError(BucketNameIllegalCharacter, "bucket name must contain only lowercase letters, numbers or hyphens")
+		}
+	}
+
+	return nil
+}
+
+// validateS3BucketName checks the bucket name against the S3 bucket naming rules,
+// see https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html.
+func validateS3BucketName(bucket []byte) error {
+	if err := validateBucketNameLength(bucket, "bucket name must be between 3 and 63 characters long"); err != nil {
+		return err
+	}
+
+	for _, r := range bucket {
+		if !isLowerLetter(r) && !isDigit(r) && r != '.' && r != '-' {
+			return bucketNameError(BucketNameIllegalCharacter, "bucket name can consist only of lowercase letters, numbers, dots and hyphens")
+		}
+	}
+
+	first, last := bucket[0], bucket[len(bucket)-1]
+	if !(isLowerLetter(first) || isDigit(first)) || !(isLowerLetter(last) || isDigit(last)) {
+		return bucketNameError(BucketNameIllegalCharacter, "bucket name must begin and end with a letter or number")
+	}
+
+	if bytes.Contains(bucket, []byte("..")) {
+		return bucketNameError(BucketNameIllegalCharacter, "bucket name must not contain two adjacent periods")
+	}
+
+	if ipRegexp.Match(bucket) {
+		return bucketNameError(BucketNameReserved, "bucket name must not be formatted as an IP address")
+	}
+
+	for _, prefix := range []string{"xn--", "sthree-"} {
+		if bytes.HasPrefix(bucket, []byte(prefix)) {
+			return bucketNameError(BucketNameReserved, "bucket name must not start with the prefix %q", prefix)
+		}
+	}
+
+	for _, suffix := range []string{"-s3alias", "--ol-s3"} {
+		if bytes.HasSuffix(bucket, []byte(suffix)) {
+			return bucketNameError(BucketNameReserved, "bucket name must not end with the suffix %q", suffix)
 		}
 	}
 
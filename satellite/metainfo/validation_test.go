@@ -5,6 +5,8 @@ package metainfo
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,8 +15,12 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 )
@@ -231,3 +237,174 @@ func TestEndpoint_validateAuthN(t *testing.T) {
 		assert.Equal(t, tt.wantCanDelete, canDelete, i)
 	}
 }
+
+func TestEndpoint_validateBucketS3CompatibleNames(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	storjEndpoint := Endpoint{log: zaptest.NewLogger(t)}
+	s3Endpoint := Endpoint{log: zaptest.NewLogger(t), config: Config{S3CompatibleNames: true}}
+
+	for _, tt := range []struct {
+		name       string
+		bucket     string
+		storjValid bool
+		errText    string
+	}{
+		{name: "valid", bucket: "my-bucket.1", storjValid: true},
+		{name: "shortest", bucket: "abc", storjValid: true},
+		{name: "longest", bucket: strings.Repeat("a", 63), storjValid: true},
+		{name: "too short", bucket: "ab", errText: "between 3 and 63 characters"},
+		{name: "too long", bucket: strings.Repeat("a", 64), errText: "between 3 and 63 characters"},
+		{name: "uppercase", bucket: "MyBucket", errText: "only of lowercase letters, numbers, dots and hyphens"},
+		{name: "uppercase at the end", bucket: "bucketA", storjValid: true, errText: "only of lowercase letters, numbers, dots and hyphens"},
+		{name: "underscore", bucket: "my_bucket", errText: "only of lowercase letters, numbers, dots and hyphens"},
+		{name: "starts with hyphen", bucket: "-bucket", errText: "begin and end with a letter or number"},
+		{name: "ends with dot", bucket: "bucket.", errText: "begin and end with a letter or number"},
+		{name: "adjacent periods", bucket: "my..bucket", errText: "two adjacent periods"},
+		{name: "ip address", bucket: "192.168.5.4", errText: "formatted as an IP address"},
+		{name: "xn-- prefix", bucket: "xn--bucket", storjValid: true, errText: `prefix "xn--"`},
+		{name: "sthree- prefix", bucket: "sthree-bucket", storjValid: true, errText: `prefix "sthree-"`},
+		{name: "sthree-configurator prefix", bucket: "sthree-configurator", storjValid: true, errText: `prefix "sthree-"`},
+		{name: "-s3alias suffix", bucket: "bucket-s3alias", storjValid: true, errText: `suffix "-s3alias"`},
+		{name: "--ol-s3 suffix", bucket: "bucket--ol-s3", storjValid: true, errText: `suffix "--ol-s3"`},
+	} {
+		err := storjEndpoint.validateBucket(ctx, []byte(tt.bucket))
+		assert.Equal(t, tt.storjValid, err == nil, "storj rules: %s", tt.name)
+
+		err = s3Endpoint.validateBucket(ctx, []byte(tt.bucket))
+		if tt.errText == "" {
+			assert.NoError(t, err, tt.name)
+		} else if assert.Error(t, err, tt.name) {
+			assert.Contains(t, err.Error(), tt.errText, tt.name)
+		}
+	}
+}
+
+func TestEndpoint_validateBucketCaseInsensitiveNames(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	sensitive := Endpoint{log: zaptest.NewLogger(t)}
+	insensitive := Endpoint{log: zaptest.NewLogger(t), config: Config{CaseInsensitiveNames: true}}
+
+	bucket := []byte("MyBucket")
+	require.Error(t, sensitive.validateBucket(ctx, bucket))
+	require.Equal(t, []byte("MyBucket"), bucket)
+
+	// the name is validated in its normalized form, but kept as it is
+	require.NoError(t, insensitive.validateBucket(ctx, bucket))
+	require.Equal(t, []byte("MyBucket"), bucket)
+
+	// the names are still validated after normalizing them
+	require.Error(t, insensitive.validateBucket(ctx, []byte("My_Bucket")))
+}
+
+func TestEndpoint_validateBucketErrorCodes(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	storjEndpoint := &Endpoint{log: zaptest.NewLogger(t)}
+	s3Endpoint := &Endpoint{log: zaptest.NewLogger(t), config: Config{S3CompatibleNames: true}}
+
+	for _, tt := range []struct {
+		name     string
+		bucket   string
+		endpoint *Endpoint
+		code     BucketNameErrorCode
+	}{
+		{name: "too short", bucket: "ab", endpoint: storjEndpoint, code: BucketNameTooShort},
+		{name: "too long", bucket: strings.Repeat("a", 64), endpoint: storjEndpoint, code: BucketNameTooLong},
+		{name: "uppercase", bucket: "testBUCKET", endpoint: storjEndpoint, code: BucketNameIllegalCharacter},
+		{name: "slash", bucket: "test/bucket", endpoint: storjEndpoint, code: BucketNameIllegalCharacter},
+		{name: "starts with hyphen", bucket: "-testbucket", endpoint: storjEndpoint, code: BucketNameIllegalCharacter},
+		{name: "empty label", bucket: "a..b", endpoint: storjEndpoint, code: BucketNameIllegalCharacter},
+		{name: "ip address", bucket: "192.168.1.234", endpoint: storjEndpoint, code: BucketNameReserved},
+		{name: "s3 too short", bucket: "ab", endpoint: s3Endpoint, code: BucketNameTooShort},
+		{name: "s3 too long", bucket: strings.Repeat("a", 64), endpoint: s3Endpoint, code: BucketNameTooLong},
+		{name: "s3 underscore", bucket: "my_bucket", endpoint: s3Endpoint, code: BucketNameIllegalCharacter},
+		{name: "s3 ends with dot", bucket: "bucket.", endpoint: s3Endpoint, code: BucketNameIllegalCharacter},
+		{name: "s3 adjacent periods", bucket: "my..bucket", endpoint: s3Endpoint, code: BucketNameIllegalCharacter},
+		{name: "s3 ip address", bucket: "192.168.5.4", endpoint: s3Endpoint, code: BucketNameReserved},
+		{name: "s3 reserved prefix", bucket: "xn--bucket", endpoint: s3Endpoint, code: BucketNameReserved},
+		{name: "s3 reserved suffix", bucket: "bucket-s3alias", endpoint: s3Endpoint, code: BucketNameReserved},
+	} {
+		err := rpcstatus.Wrap(rpcstatus.InvalidArgument, tt.endpoint.validateBucket(ctx, []byte(tt.bucket)))
+		require.Error(t, err, tt.name)
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err), tt.name)
+
+		var nameErr *BucketNameError
+		require.True(t, errors.As(err, &nameErr), tt.name)
+		require.Equal(t, tt.code, nameErr.Code, tt.name)
+		require.Contains(t, err.Error(), "invalid bucket name ("+tt.code.String()+")", tt.name)
+	}
+
+	// an empty name isn't a BucketNameError
+	err := storjEndpoint.validateBucket(ctx, nil)
+	require.True(t, storj.ErrNoBucket.Has(err))
+	var nameErr *BucketNameError
+	require.False(t, errors.As(err, &nameErr))
+}
+
+func TestEndpoint_validateBucketNotReserved(t *testing.T) {
+	endpoint := &Endpoint{config: Config{ReservedBucketPrefixes: []string{"storj-", "sys-"}}}
+
+	for _, tt := range []struct {
+		bucket string
+		prefix string
+	}{
+		{bucket: "storj-bucket", prefix: "storj-"},
+		{bucket: "sys-", prefix: "sys-"},
+		{bucket: "storjbucket"},
+		{bucket: "my-storj-bucket"},
+		{bucket: "system-bucket"},
+		{bucket: "bucket"},
+	} {
+		err := endpoint.validateBucketNotReserved([]byte(tt.bucket))
+		if tt.prefix == "" {
+			require.NoError(t, err, tt.bucket)
+			continue
+		}
+
+		var nameErr *BucketNameError
+		require.True(t, errors.As(err, &nameErr), tt.bucket)
+		require.Equal(t, BucketNameReserved, nameErr.Code, tt.bucket)
+		require.Contains(t, err.Error(), `"`+tt.prefix+`"`, tt.bucket)
+	}
+
+	// nothing is reserved without prefixes
+	require.NoError(t, (&Endpoint{}).validateBucketNotReserved([]byte("storj-bucket")))
+}
+
+func TestValidateRedundancyScheme(t *testing.T) {
+	valid := pb.RedundancyScheme{MinReq: 29, RepairThreshold: 35, SuccessThreshold: 80, Total: 110, ErasureShareSize: 256}
+	require.NoError(t, validateRedundancyScheme(&valid))
+
+	for name, change := range map[string]func(rs *pb.RedundancyScheme){
+		"zero required shares":     func(rs *pb.RedundancyScheme) { rs.MinReq = 0 },
+		"negative required shares": func(rs *pb.RedundancyScheme) { rs.MinReq = -1 },
+		"zero share size":          func(rs *pb.RedundancyScheme) { rs.ErasureShareSize = 0 },
+		"fewer total shares":       func(rs *pb.RedundancyScheme) { rs.Total = rs.MinReq - 1 },
+		"overflowing stripe size": func(rs *pb.RedundancyScheme) {
+			// the product overflows int32 to a positive value
+			rs.MinReq, rs.Total, rs.ErasureShareSize = 110, 110, 1<<25+1
+		},
+	} {
+		rs := valid
+		change(&rs)
+		err := validateRedundancyScheme(&rs)
+		require.True(t, ErrInvalidRedundancyScheme.Has(err), name)
+
+		_, err = convertBucketToProto(buckets.Bucket{Name: []byte("bucket")}, &rs, 64*memory.MiB)
+		require.True(t, ErrInvalidRedundancyScheme.Has(err), name)
+	}
+
+	bucket, err := convertBucketToProto(buckets.Bucket{Name: []byte("bucket")}, &valid, 64*memory.MiB)
+	require.NoError(t, err)
+	require.EqualValues(t, 29*256, bucket.DefaultEncryptionParameters.BlockSize)
+
+	_, err = NewEndpoint(zaptest.NewLogger(t), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, Config{
+		RS: RSConfig{ErasureShareSize: 256, Min: 0, Repair: 35, Success: 80, Total: 110},
+	})
+	require.True(t, ErrInvalidRedundancyScheme.Has(err))
+}
@@ -5,16 +5,21 @@ package satellite
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
 	"net"
-	"net/mail"
 	"net/smtp"
+	"time"
 
 	hw "github.com/jtolds/monkit-hw/v2"
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/identity"
 	"storj.io/private/debug"
+	"storj.io/storj/private/jitter"
 	"storj.io/storj/private/post"
 	"storj.io/storj/private/post/oauth2"
 	"storj.io/storj/private/server"
@@ -34,15 +39,20 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/emailreminders"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/mailgun"
+	"storj.io/storj/satellite/mailservice/ses"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/bucketlimitmonitor"
+	"storj.io/storj/satellite/metainfo/bucketsweeper"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
@@ -82,6 +92,10 @@ type DB interface {
 	// TestingMigrateToLatest initializes the database for testplanet.
 	TestingMigrateToLatest(ctx context.Context) error
 
+	// WithReadOnly marks ctx, so that reads which tolerate replication lag
+	// may be served by a read replica, when one is configured.
+	WithReadOnly(ctx context.Context) context.Context
+
 	// PeerIdentities returns a storage for peer identities
 	PeerIdentities() overlay.PeerIdentities
 	// OverlayCache returns database for caching overlay information
@@ -123,6 +137,8 @@ type DB interface {
 	Revocation() revocation.DB
 	// NodeAPIVersion tracks nodes observed api usage
 	NodeAPIVersion() nodeapiversion.DB
+	// EmailSuppressions returns database for the email suppression lists of projects.
+	EmailSuppressions() mailservice.SuppressionDB
 }
 
 // Config is the global config satellite.
@@ -148,8 +164,13 @@ type Config struct {
 
 	GarbageCollection gc.Config
 
+	ChoreJitter jitter.Config
+
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	BucketSweeper   bucketsweeper.Config
+
+	BucketLimitMonitor bucketlimitmonitor.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
@@ -179,12 +200,20 @@ type Config struct {
 	Analytics analytics.Config
 }
 
-func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, error) {
+func setupMailService(log *zap.Logger, db DB, config Config) (*mailservice.Service, error) {
 	// TODO(yar): test multiple satellites using same OAUTH credentials
 	mailConfig := config.Mail
 
-	// validate from mail address
-	from, err := mail.ParseAddress(mailConfig.From)
+	// validate from and reply-to mail addresses
+	from, err := mailConfig.ParseFrom()
+	if err != nil {
+		return nil, err
+	}
+	replyTo, err := mailConfig.ParseReplyTo()
+	if err != nil {
+		return nil, err
+	}
+	sendLogLevel, err := mailConfig.Log.ParseLevel()
 	if err != nil {
 		return nil, err
 	}
@@ -195,17 +224,118 @@ func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, err
 		return nil, err
 	}
 
-	var sender mailservice.Sender
-	switch mailConfig.AuthType {
+	tlsConfig, err := mailConfig.TLS.Load(host)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, simulated, err := newMailSender(log, mailConfig, mailConfig.AuthType, from, host, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mailConfig.FallbackAuthTypes) > 0 {
+		senders := []mailservice.NamedSender{{Name: mailConfig.AuthType, Sender: sender}}
+		for _, authType := range mailConfig.FallbackAuthTypes {
+			fallback, simulatedFallback, err := newMailSender(log, mailConfig, authType, from, host, tlsConfig)
+			if err != nil {
+				return nil, err
+			}
+			if simulatedFallback {
+				return nil, errs.New("unsupported fallback mail auth type %q", authType)
+			}
+			senders = append(senders, mailservice.NamedSender{Name: authType, Sender: fallback})
+		}
+		sender = mailservice.NewFallbackSender(log.Named("mail:fallback"), senders...)
+	}
+
+	// simulated emails don't reach anyone, so they may run without templates
+	if !simulated {
+		if err := mailservice.ValidateTemplates(mailConfig.TemplatePath, consoleql.EmailTemplates()...); err != nil {
+			return nil, err
+		}
+	}
+
+	signer, err := mailConfig.DKIM.Load()
+	if err != nil {
+		return nil, err
+	}
+	if signer != nil {
+		sender = mailservice.NewDKIMSender(sender, signer)
+	}
+
+	if mailConfig.MaxRetries > 0 {
+		sender = mailservice.NewRetrySender(log.Named("mail:retry"), sender, mailConfig.MaxRetries)
+	}
+
+	suppressions := mailservice.NewSuppressionSender(sender, db.EmailSuppressions(), mailConfig.Suppression)
+	sender = suppressions
+
+	if mailConfig.RateLimit.Burst > 0 {
+		sender = mailservice.NewRateLimitSender(sender, mailConfig.RateLimit)
+	}
+
+	service, err := mailservice.New(
+		log.Named("mail:service"),
+		sender,
+		mailConfig.TemplatePath,
+	)
+	if err != nil {
+		return nil, err
+	}
+	service.ReplyTo = replyTo
+	service.Suppressions = suppressions
+	service.Bounces, err = newBounceReceiver(log.Named("mail:bounces"), mailConfig.Bounces, suppressions)
+	if err != nil {
+		return nil, err
+	}
+	service.SendLogLevel = sendLogLevel
+	service.LogFullAddresses = mailConfig.Log.FullAddresses
+	service.FallbackLocales = mailConfig.FallbackLocales
+	service.Queue = mailConfig.Queue
+
+	return service, nil
+}
+
+// newBounceReceiver creates the receiver of the notifications of the configured
+// bounce provider, it returns nil when none is configured.
+func newBounceReceiver(log *zap.Logger, config mailservice.BounceConfig, suppressions *mailservice.SuppressionSender) (*mailservice.BounceReceiver, error) {
+	var provider mailservice.BounceProvider
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case "ses":
+		sesProvider, err := ses.NewBounceProvider(config.SESTopicARN)
+		if err != nil {
+			return nil, err
+		}
+		provider = sesProvider
+	case "mailgun":
+		mailgunProvider, err := mailgun.NewBounceProvider(config.MailgunSigningKey)
+		if err != nil {
+			return nil, err
+		}
+		provider = mailgunProvider
+	default:
+		return nil, errs.New("unsupported mail bounce provider %q", config.Provider)
+	}
+	return mailservice.NewBounceReceiver(log, provider, suppressions), nil
+}
+
+// newMailSender creates the sender for the mail auth type, the unknown auth types
+// create a simulated sender.
+func newMailSender(log *zap.Logger, mailConfig mailservice.Config, authType string, from *post.Address, host string, tlsConfig *tls.Config) (sender mailservice.Sender, simulated bool, err error) {
+	switch authType {
 	case "oauth2":
 		creds := oauth2.Credentials{
 			ClientID:     mailConfig.ClientID,
 			ClientSecret: mailConfig.ClientSecret,
 			TokenURI:     mailConfig.TokenURI,
 		}
-		token, err := oauth2.RefreshToken(context.TODO(), creds, mailConfig.RefreshToken)
+		// the token endpoint may be briefly unavailable, which shouldn't prevent the startup
+		token, err := oauth2.RefreshTokenWithRetry(context.TODO(), creds, mailConfig.RefreshToken, 4, time.Second)
 		if err != nil {
-			return nil, err
+			return nil, false, fmt.Errorf("unable to refresh mail oauth2 token: %w", err)
 		}
 
 		sender = &post.SMTPSender{
@@ -215,12 +345,46 @@ func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, err
 				Storage:   oauth2.NewTokenStore(creds, *token),
 			},
 			ServerAddress: mailConfig.SMTPServerAddress,
+			TLSConfig:     tlsConfig,
+			ForceSTARTTLS: mailConfig.TLS.ForceSTARTTLS,
+			PoolSize:      mailConfig.PoolSize,
+			DialTimeout:   mailConfig.DialTimeout,
+			SendTimeout:   mailConfig.SendTimeout,
+		}
+	case "xoauth2":
+		tokens, err := mailConfig.XOAUTH2.TokenSource()
+		if err != nil {
+			return nil, false, err
+		}
+
+		username := mailConfig.Login
+		if username == "" {
+			username = from.Address
+		}
+
+		sender = &post.SMTPSender{
+			From: *from,
+			Auth: &post.XOAUTH2Auth{
+				Username: username,
+				Tokens:   tokens,
+			},
+			ServerAddress: mailConfig.SMTPServerAddress,
+			TLSConfig:     tlsConfig,
+			ForceSTARTTLS: mailConfig.TLS.ForceSTARTTLS,
+			PoolSize:      mailConfig.PoolSize,
+			DialTimeout:   mailConfig.DialTimeout,
+			SendTimeout:   mailConfig.SendTimeout,
 		}
 	case "plain":
 		sender = &post.SMTPSender{
 			From:          *from,
 			Auth:          smtp.PlainAuth("", mailConfig.Login, mailConfig.Password, host),
 			ServerAddress: mailConfig.SMTPServerAddress,
+			TLSConfig:     tlsConfig,
+			ForceSTARTTLS: mailConfig.TLS.ForceSTARTTLS,
+			PoolSize:      mailConfig.PoolSize,
+			DialTimeout:   mailConfig.DialTimeout,
+			SendTimeout:   mailConfig.SendTimeout,
 		}
 	case "login":
 		sender = &post.SMTPSender{
@@ -230,14 +394,79 @@ func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, err
 				Password: mailConfig.Password,
 			},
 			ServerAddress: mailConfig.SMTPServerAddress,
+			TLSConfig:     tlsConfig,
+			ForceSTARTTLS: mailConfig.TLS.ForceSTARTTLS,
+			PoolSize:      mailConfig.PoolSize,
+			DialTimeout:   mailConfig.DialTimeout,
+			SendTimeout:   mailConfig.SendTimeout,
+		}
+	case "cram-md5":
+		if mailConfig.Login == "" || mailConfig.Password == "" {
+			return nil, false, errs.New("cram-md5 auth requires mail login and password to be set")
+		}
+
+		sender = &post.SMTPSender{
+			From:          *from,
+			Auth:          smtp.CRAMMD5Auth(mailConfig.Login, mailConfig.Password),
+			ServerAddress: mailConfig.SMTPServerAddress,
+			TLSConfig:     tlsConfig,
+			ForceSTARTTLS: mailConfig.TLS.ForceSTARTTLS,
+			PoolSize:      mailConfig.PoolSize,
+			DialTimeout:   mailConfig.DialTimeout,
+			SendTimeout:   mailConfig.SendTimeout,
+		}
+	case "ses":
+		sesSender, err := ses.New(*from, mailConfig.SESRegion, mailConfig.SESAccessKeyID, mailConfig.SESSecretAccessKey)
+		if err != nil {
+			return nil, false, err
+		}
+		sender = sesSender
+	case "mailgun":
+		mailgunSender, err := mailgun.New(*from, mailConfig.MailgunDomain, mailConfig.MailgunAPIKey)
+		if err != nil {
+			return nil, false, err
 		}
+		sender = mailgunSender
 	default:
-		sender = simulate.NewDefaultLinkClicker(log.Named("mail:linkclicker"))
+		return simulate.NewDefaultLinkClicker(log.Named("mail:linkclicker")), true, nil
 	}
 
-	return mailservice.New(
-		log.Named("mail:service"),
-		sender,
-		mailConfig.TemplatePath,
-	)
+	return sender, false, nil
+}
+
+// closeMailService returns the function closing the mail service on shutdown,
+// which still sends the queued emails for at most drainTimeout.
+func closeMailService(service *mailservice.Service, drainTimeout time.Duration) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+		defer cancel()
+		return service.Close(ctx)
+	}
+}
+
+// mailHealthCheckTimeout limits how long the mail service health check may take.
+const mailHealthCheckTimeout = 30 * time.Second
+
+// mailServiceDebugButtons returns the debug panel buttons of the mail service,
+// they allow checking the mail server configuration without sending mail.
+func mailServiceDebugButtons(service *mailservice.Service) *debug.ButtonGroup {
+	return &debug.ButtonGroup{
+		Name: "Mail Service",
+		Buttons: []*debug.Button{
+			{
+				Name: "Health Check",
+				Call: func(w io.Writer) error {
+					ctx, cancel := context.WithTimeout(context.Background(), mailHealthCheckTimeout)
+					defer cancel()
+
+					if err := service.HealthCheck(ctx); err != nil {
+						_, _ = fmt.Fprintln(w, "Failed:", err)
+						return err
+					}
+					_, _ = fmt.Fprintln(w, "OK")
+					return nil
+				},
+			},
+		},
+	}
 }
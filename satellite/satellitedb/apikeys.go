@@ -180,6 +180,10 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 		optional.UserAgent = dbx.ApiKey_UserAgent(info.UserAgent)
 	}
 
+	if !info.CreatedBy.IsZero() {
+		optional.CreatedBy = dbx.ApiKey_CreatedBy(info.CreatedBy[:])
+	}
+
 	dbKey, err := keys.methods.Create_ApiKey(
 		ctx,
 		dbx.ApiKey_Id(id[:]),
@@ -249,6 +253,13 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 		result.UserAgent = key.UserAgent
 	}
 
+	if key.CreatedBy != nil {
+		result.CreatedBy, err = uuid.FromBytes(key.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
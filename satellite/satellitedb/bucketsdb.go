@@ -4,13 +4,22 @@
 package satellitedb
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"time"
+
+	pgxerrcode "github.com/jackc/pgerrcode"
+	"github.com/zeebo/errs"
 
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -24,6 +33,48 @@ type bucketsDB struct {
 func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ storj.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return db.CreateBucketBy(ctx, bucket, uuid.UUID{}, buckets.CreateSettings{})
+}
+
+// CreateBucketBy creates a new bucket with the settings, recording the user, who created it.
+func (db *bucketsDB) CreateBucketBy(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (_ storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return createBucket(ctx, db.db, bucket, createdBy, settings, nil)
+}
+
+// CreateCaseInsensitiveBucket creates a new bucket like CreateBucketBy, which is also matched by
+// its normalized name. It fails, when a bucket already has that normalized name or is named like it.
+func (db *bucketsDB) CreateCaseInsensitiveBucket(ctx context.Context, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings) (created storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	normalized := buckets.NormalizeName([]byte(bucket.Name))
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		// buckets, which were created before names matched case-insensitively, have
+		// no normalized name, the bucket named like the normalized name is taken.
+		var exists bool
+		err := tx.Tx.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM bucket_metainfos
+				WHERE project_id = $1 AND name = $2
+			)
+		`, bucket.ProjectID, normalized).Scan(&exists)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		if exists {
+			return buckets.ErrBucketAlreadyExists.New("%s", bucket.Name)
+		}
+
+		created, err = createBucket(ctx, tx, bucket, createdBy, settings, normalized)
+		return err
+	})
+	return created, err
+}
+
+// createBucket inserts the bucket together with its settings, its normalized name is
+// only stored when it's set.
+func createBucket(ctx context.Context, methods dbx.Methods, bucket storj.Bucket, createdBy uuid.UUID, settings buckets.CreateSettings, normalized []byte) (_ storj.Bucket, err error) {
 	optionalFields := dbx.BucketMetainfo_Create_Fields{}
 	if !bucket.PartnerID.IsZero() || bucket.UserAgent != nil {
 		optionalFields = dbx.BucketMetainfo_Create_Fields{
@@ -32,8 +83,39 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 		}
 	}
 	optionalFields.Placement = dbx.BucketMetainfo_Placement(int(bucket.Placement))
+	if !createdBy.IsZero() {
+		optionalFields.CreatedBy = dbx.BucketMetainfo_CreatedBy(createdBy[:])
+	}
+	if normalized != nil {
+		optionalFields.NameNormalized = dbx.BucketMetainfo_NameNormalized(normalized)
+	}
+	if settings.ObjectLockEnabled {
+		optionalFields.ObjectLockEnabled = dbx.BucketMetainfo_ObjectLockEnabled(true)
+		optionalFields.DefaultRetentionMode = dbx.BucketMetainfo_DefaultRetentionMode(int(settings.DefaultRetention.Mode))
+		optionalFields.DefaultRetentionDays = dbx.BucketMetainfo_DefaultRetentionDays(settings.DefaultRetention.Days)
+	}
+	if len(settings.Tags) > 0 {
+		encoded, err := json.Marshal(settings.Tags)
+		if err != nil {
+			return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+		}
+		optionalFields.Tags = dbx.BucketMetainfo_Tags(encoded)
+	}
+	if len(settings.CORS) > 0 {
+		encoded, err := json.Marshal(settings.CORS)
+		if err != nil {
+			return storj.Bucket{}, storj.ErrBucket.Wrap(err)
+		}
+		optionalFields.Cors = dbx.BucketMetainfo_Cors(encoded)
+	}
+	if settings.DefaultObjectTTL > 0 {
+		optionalFields.DefaultObjectTtl = dbx.BucketMetainfo_DefaultObjectTtl(int64((settings.DefaultObjectTTL + time.Second - 1) / time.Second))
+	}
+	if settings.CostCenter != "" {
+		optionalFields.CostCenter = dbx.BucketMetainfo_CostCenter(settings.CostCenter)
+	}
 
-	row, err := db.db.Create_BucketMetainfo(ctx,
+	row, err := methods.Create_BucketMetainfo(ctx,
 		dbx.BucketMetainfo_Id(bucket.ID[:]),
 		dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]),
 		dbx.BucketMetainfo_Name([]byte(bucket.Name)),
@@ -50,6 +132,9 @@ func (db *bucketsDB) CreateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 		optionalFields,
 	)
 	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return storj.Bucket{}, buckets.ErrBucketAlreadyExists.New("%s", bucket.Name)
+		}
 		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
 	}
 
@@ -73,16 +158,21 @@ func (db *bucketsDB) GetBucket(ctx context.Context, bucketName []byte, projectID
 		}
 		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
 	}
+	if dbxBucket.DeletedAt != nil {
+		return storj.Bucket{}, storj.ErrBucketNotFound.New("%s", bucketName)
+	}
 	return convertDBXtoBucket(dbxBucket)
 }
 
 // GetBucketPlacement returns with the placement constraint identifier.
 func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (placement storj.PlacementConstraint, err error) {
 	defer mon.Task()(&ctx)(&err)
-	dbxPlacement, err := db.db.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx,
-		dbx.BucketMetainfo_ProjectId(projectID[:]),
-		dbx.BucketMetainfo_Name(bucketName),
-	)
+
+	var dbxPlacement *int
+	err = db.db.QueryRowContext(ctx, `
+		SELECT placement FROM bucket_metainfos
+		WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+	`, projectID, bucketName).Scan(&dbxPlacement)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return storj.EveryCountry, storj.ErrBucketNotFound.New("%s", bucketName)
@@ -90,17 +180,18 @@ func (db *bucketsDB) GetBucketPlacement(ctx context.Context, bucketName []byte,
 		return storj.EveryCountry, storj.ErrBucket.Wrap(err)
 	}
 	placement = storj.EveryCountry
-	if dbxPlacement.Placement != nil {
-		placement = storj.PlacementConstraint(*dbxPlacement.Placement)
+	if dbxPlacement != nil {
+		placement = storj.PlacementConstraint(*dbxPlacement)
 	}
 
 	return placement, nil
 }
 
 // GetMinimalBucket returns existing bucket with minimal number of fields.
+// It's served by the read replica when ctx is marked with dbreplica.WithReadOnly.
 func (db *bucketsDB) GetMinimalBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.Bucket, err error) {
 	defer mon.Task()(&ctx)(&err)
-	row, err := db.db.Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx,
+	row, err := db.db.reader(ctx).Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx,
 		dbx.BucketMetainfo_ProjectId(projectID[:]),
 		dbx.BucketMetainfo_Name(bucketName),
 	)
@@ -110,23 +201,169 @@ func (db *bucketsDB) GetMinimalBucket(ctx context.Context, bucketName []byte, pr
 		}
 		return buckets.Bucket{}, storj.ErrBucket.Wrap(err)
 	}
-	return buckets.Bucket{
+	if row.DeletedAt != nil {
+		return buckets.Bucket{}, storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	bucket := buckets.Bucket{
 		Name:      bucketName,
 		CreatedAt: row.CreatedAt,
-	}, nil
+		DefaultEncryptionParameters: storj.EncryptionParameters{
+			CipherSuite: storj.CipherSuite(row.DefaultEncryptionCipherSuite),
+			BlockSize:   int32(row.DefaultEncryptionBlockSize),
+		},
+		DefaultSegmentSize: memory.Size(row.DefaultSegmentSize),
+	}
+	if row.Placement != nil {
+		bucket.Placement = storj.PlacementConstraint(*row.Placement)
+	}
+	if row.ObjectLockEnabled != nil {
+		bucket.ObjectLockEnabled = *row.ObjectLockEnabled
+	}
+	if row.DefaultRetentionMode != nil {
+		bucket.DefaultRetention.Mode = buckets.RetentionMode(*row.DefaultRetentionMode)
+	}
+	if row.DefaultRetentionDays != nil {
+		bucket.DefaultRetention.Days = *row.DefaultRetentionDays
+	}
+	if row.Tags != nil {
+		bucket.Tags, err = decodeBucketTags(*row.Tags)
+		if err != nil {
+			return buckets.Bucket{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+	if row.Cors != nil {
+		bucket.CORS, err = decodeBucketCORS(*row.Cors)
+		if err != nil {
+			return buckets.Bucket{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+	if row.DefaultObjectTtl != nil {
+		bucket.DefaultObjectTTL = time.Duration(*row.DefaultObjectTtl) * time.Second
+	}
+	if row.CreatedBy != nil {
+		bucket.CreatedBy, err = uuid.FromBytes(*row.CreatedBy)
+		if err != nil {
+			return buckets.Bucket{}, storj.ErrBucket.Wrap(err)
+		}
+	}
+	bucket.Logging = convertDBXtoBucketLogging(row.LoggingTargetBucket, row.LoggingTargetPrefix)
+	if row.Frozen != nil {
+		bucket.Frozen = *row.Frozen
+	}
+	if row.CostCenter != nil {
+		bucket.CostCenter = *row.CostCenter
+	}
+	bucket.LastModified = bucketLastModified(row.CreatedAt, row.LastModified)
+	return bucket, nil
+}
+
+// GetCaseInsensitiveBucketNames returns the names of the buckets, which bucketNames refer to, when bucket
+// names match case-insensitively. That's the bucket with exactly that name, otherwise the bucket with
+// the same normalized name, otherwise the bucket named like the normalized name. A name, which doesn't
+// refer to any bucket, is returned unchanged. Soft-deleted buckets are included.
+func (db *bucketsDB) GetCaseInsensitiveBucketNames(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	names := make([][]byte, len(bucketNames))
+	if len(bucketNames) == 0 {
+		return names, nil
+	}
+
+	candidates := make([][]byte, 0, 2*len(bucketNames))
+	normalized := make([][]byte, len(bucketNames))
+	for i, name := range bucketNames {
+		normalized[i] = buckets.NormalizeName(name)
+		candidates = append(candidates, name, normalized[i])
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT name, name_normalized FROM bucket_metainfos
+		WHERE project_id = $1 AND (name = ANY($2::BYTEA[]) OR name_normalized = ANY($3::BYTEA[]))
+	`, projectID, pgutil.ByteaArray(candidates), pgutil.ByteaArray(normalized))
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	existing := map[string]struct{}{}
+	byNormalized := map[string][]byte{}
+	for rows.Next() {
+		var name, nameNormalized []byte
+		if err := rows.Scan(&name, &nameNormalized); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		existing[string(name)] = struct{}{}
+		if nameNormalized != nil {
+			byNormalized[string(nameNormalized)] = name
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+
+	for i, name := range bucketNames {
+		if _, ok := existing[string(name)]; ok {
+			names[i] = name
+		} else if match, ok := byNormalized[string(normalized[i])]; ok {
+			names[i] = match
+		} else if _, ok := existing[string(normalized[i])]; ok {
+			names[i] = normalized[i]
+		} else {
+			names[i] = name
+		}
+	}
+	return names, nil
 }
 
 // HasBucket returns if a bucket exists.
 func (db *bucketsDB) HasBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (exists bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	exists, err = db.db.Has_BucketMetainfo_By_ProjectId_And_Name(ctx,
-		dbx.BucketMetainfo_ProjectId(projectID[:]),
-		dbx.BucketMetainfo_Name(bucketName),
-	)
+	err = db.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM bucket_metainfos
+			WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+		)
+	`, projectID, bucketName).Scan(&exists)
 	return exists, storj.ErrBucket.Wrap(err)
 }
 
+// HasBuckets returns whether each of the buckets exists, in the order of bucketNames.
+func (db *bucketsDB) HasBuckets(ctx context.Context, bucketNames [][]byte, projectID uuid.UUID) (exists []bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exists = make([]bool, len(bucketNames))
+	if len(bucketNames) == 0 {
+		return exists, nil
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT name FROM bucket_metainfos
+		WHERE project_id = $1 AND name = ANY($2::BYTEA[]) AND deleted_at IS NULL
+	`, projectID, pgutil.ByteaArray(bucketNames))
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	found := make(map[string]struct{}, len(bucketNames))
+	for rows.Next() {
+		var name []byte
+		if err := rows.Scan(&name); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		found[string(name)] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+
+	for i, name := range bucketNames {
+		_, exists[i] = found[string(name)]
+	}
+	return exists, nil
+}
+
 // GetBucketID returns an existing bucket id.
 func (db *bucketsDB) GetBucketID(ctx context.Context, bucket metabase.BucketLocation) (_ uuid.UUID, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -162,6 +399,7 @@ func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 	}
 
 	updateFields.Placement = dbx.BucketMetainfo_Placement(int(bucket.Placement))
+	updateFields.LastModified = dbx.BucketMetainfo_LastModified(time.Now())
 
 	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx, dbx.BucketMetainfo_ProjectId(bucket.ProjectID[:]), dbx.BucketMetainfo_Name([]byte(bucket.Name)), updateFields)
 	if err != nil {
@@ -170,24 +408,416 @@ func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket storj.Bucket) (_ s
 	return convertDBXtoBucket(dbxBucket)
 }
 
-// DeleteBucket deletes a bucket.
-func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+// UpdateBucketObjectLock updates the object lock configuration of a bucket.
+func (db *bucketsDB) UpdateBucketObjectLock(ctx context.Context, bucketName []byte, projectID uuid.UUID, enabled bool, retention buckets.DefaultRetention) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	deleted, err := db.db.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
 		dbx.BucketMetainfo_ProjectId(projectID[:]),
 		dbx.BucketMetainfo_Name(bucketName),
+		dbx.BucketMetainfo_Update_Fields{
+			ObjectLockEnabled:    dbx.BucketMetainfo_ObjectLockEnabled(enabled),
+			DefaultRetentionMode: dbx.BucketMetainfo_DefaultRetentionMode(int(retention.Mode)),
+			DefaultRetentionDays: dbx.BucketMetainfo_DefaultRetentionDays(retention.Days),
+			LastModified:         dbx.BucketMetainfo_LastModified(time.Now()),
+		},
 	)
 	if err != nil {
 		return storj.ErrBucket.Wrap(err)
 	}
-	if !deleted {
+	if dbxBucket == nil {
 		return storj.ErrBucketNotFound.New("%s", bucketName)
 	}
 	return nil
 }
 
+// UpdateBucketTags replaces the tags of an existing bucket.
+func (db *bucketsDB) UpdateBucketTags(ctx context.Context, bucketName []byte, projectID uuid.UUID, tags map[string]string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.BucketMetainfo_Update_Fields{
+		Tags:         dbx.BucketMetainfo_Tags_Null(),
+		LastModified: dbx.BucketMetainfo_LastModified(time.Now()),
+	}
+	if len(tags) > 0 {
+		encoded, err := json.Marshal(tags)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		update.Tags = dbx.BucketMetainfo_Tags(encoded)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil || dbxBucket.DeletedAt != nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// decodeBucketTags decodes the tags stored by UpdateBucketTags.
+func decodeBucketTags(data []byte) (map[string]string, error) {
+	var tags map[string]string
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// UpdateBucketCORS replaces the CORS rules of an existing bucket.
+func (db *bucketsDB) UpdateBucketCORS(ctx context.Context, bucketName []byte, projectID uuid.UUID, rules []buckets.CORSRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.BucketMetainfo_Update_Fields{
+		Cors:         dbx.BucketMetainfo_Cors_Null(),
+		LastModified: dbx.BucketMetainfo_LastModified(time.Now()),
+	}
+	if len(rules) > 0 {
+		encoded, err := json.Marshal(rules)
+		if err != nil {
+			return storj.ErrBucket.Wrap(err)
+		}
+		update.Cors = dbx.BucketMetainfo_Cors(encoded)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil || dbxBucket.DeletedAt != nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// UpdateBucketDefaultObjectTTL sets the default object TTL of an existing bucket.
+func (db *bucketsDB) UpdateBucketDefaultObjectTTL(ctx context.Context, bucketName []byte, projectID uuid.UUID, ttl time.Duration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.BucketMetainfo_Update_Fields{
+		DefaultObjectTtl: dbx.BucketMetainfo_DefaultObjectTtl_Null(),
+		LastModified:     dbx.BucketMetainfo_LastModified(time.Now()),
+	}
+	if ttl > 0 {
+		update.DefaultObjectTtl = dbx.BucketMetainfo_DefaultObjectTtl(int64((ttl + time.Second - 1) / time.Second))
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil || dbxBucket.DeletedAt != nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// UpdateBucketCostCenter sets the cost center of an existing bucket, empty removes it.
+func (db *bucketsDB) UpdateBucketCostCenter(ctx context.Context, bucketName []byte, projectID uuid.UUID, costCenter string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.BucketMetainfo_Update_Fields{
+		CostCenter:   dbx.BucketMetainfo_CostCenter_Null(),
+		LastModified: dbx.BucketMetainfo_LastModified(time.Now()),
+	}
+	if costCenter != "" {
+		update.CostCenter = dbx.BucketMetainfo_CostCenter(costCenter)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil || dbxBucket.DeletedAt != nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// UpdateBucketFrozen sets whether an existing bucket is frozen.
+// Soft-deleted buckets aren't updated.
+func (db *bucketsDB) UpdateBucketFrozen(ctx context.Context, bucketName []byte, projectID uuid.UUID, frozen bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE bucket_metainfos SET frozen = $3, last_modified = $4
+		WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+	`, projectID, bucketName, frozen, time.Now())
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if affected == 0 {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// UpdateBucketLogging replaces the access logging configuration of an existing bucket.
+func (db *bucketsDB) UpdateBucketLogging(ctx context.Context, bucketName []byte, projectID uuid.UUID, logging buckets.Logging) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.BucketMetainfo_Update_Fields{
+		LoggingTargetBucket: dbx.BucketMetainfo_LoggingTargetBucket_Null(),
+		LoggingTargetPrefix: dbx.BucketMetainfo_LoggingTargetPrefix_Null(),
+		LastModified:        dbx.BucketMetainfo_LastModified(time.Now()),
+	}
+	if logging.Enabled() {
+		update.LoggingTargetBucket = dbx.BucketMetainfo_LoggingTargetBucket(logging.TargetBucket)
+		update.LoggingTargetPrefix = dbx.BucketMetainfo_LoggingTargetPrefix(logging.TargetPrefix)
+	}
+
+	dbxBucket, err := db.db.Update_BucketMetainfo_By_ProjectId_And_Name(ctx,
+		dbx.BucketMetainfo_ProjectId(projectID[:]),
+		dbx.BucketMetainfo_Name(bucketName),
+		update,
+	)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if dbxBucket == nil || dbxBucket.DeletedAt != nil {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// EnqueueAccessLog stores the access log record until it's delivered.
+func (db *bucketsDB) EnqueueAccessLog(ctx context.Context, record buckets.AccessLogRecord) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_access_logs (
+			id, project_id, bucket_name, target_bucket, target_prefix, operation, object_key, api_key_id, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, record.ID, record.ProjectID, record.BucketName, record.TargetBucket, record.TargetPrefix,
+		record.Operation, record.ObjectKey, record.APIKeyID, record.CreatedAt)
+	return storj.ErrBucket.Wrap(err)
+}
+
+// ListAccessLogs returns at most limit of the oldest access log records.
+func (db *bucketsDB) ListAccessLogs(ctx context.Context, limit int) (_ []buckets.AccessLogRecord, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, project_id, bucket_name, target_bucket, target_prefix, operation, object_key, api_key_id, created_at
+		FROM bucket_access_logs
+		ORDER BY created_at, id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var records []buckets.AccessLogRecord
+	for rows.Next() {
+		var record buckets.AccessLogRecord
+		err := rows.Scan(&record.ID, &record.ProjectID, &record.BucketName, &record.TargetBucket, &record.TargetPrefix,
+			&record.Operation, &record.ObjectKey, &record.APIKeyID, &record.CreatedAt)
+		if err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		records = append(records, record)
+	}
+	return records, storj.ErrBucket.Wrap(rows.Err())
+}
+
+// DeleteAccessLogs removes the delivered access log records.
+func (db *bucketsDB) DeleteAccessLogs(ctx context.Context, ids []uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_access_logs
+		WHERE id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(ids))
+	return storj.ErrBucket.Wrap(err)
+}
+
+// InsertAuditRecord stores the audit record of a bucket mutation.
+func (db *bucketsDB) InsertAuditRecord(ctx context.Context, record buckets.AuditRecord) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_audit_logs (
+			id, project_id, bucket_name, action, api_key_id, api_key_head, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, record.ID, record.ProjectID, record.BucketName, record.Action,
+		record.APIKeyID, record.APIKeyHead, record.CreatedAt)
+	return storj.ErrBucket.Wrap(err)
+}
+
+// ListAuditRecords returns at most limit of the latest audit records of the project.
+func (db *bucketsDB) ListAuditRecords(ctx context.Context, projectID uuid.UUID, limit int) (_ []buckets.AuditRecord, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, project_id, bucket_name, action, api_key_id, api_key_head, created_at
+		FROM bucket_audit_logs
+		WHERE project_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, projectID, limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var records []buckets.AuditRecord
+	for rows.Next() {
+		var record buckets.AuditRecord
+		err := rows.Scan(&record.ID, &record.ProjectID, &record.BucketName, &record.Action,
+			&record.APIKeyID, &record.APIKeyHead, &record.CreatedAt)
+		if err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		records = append(records, record)
+	}
+	return records, storj.ErrBucket.Wrap(rows.Err())
+}
+
+// decodeBucketCORS decodes the CORS rules stored by UpdateBucketCORS.
+func decodeBucketCORS(data []byte) ([]buckets.CORSRule, error) {
+	var rules []buckets.CORSRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// DeleteBucket deletes a bucket. Access logging into the bucket is disabled.
+func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		deleted, err := tx.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+		)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		return clearBucketLoggingTarget(ctx, tx, projectID, bucketName)
+	})
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return err
+		}
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// clearBucketLoggingTarget disables access logging into the target bucket, so the accesses
+// of the other buckets aren't logged into a bucket, which is deleted or moved, or into a new
+// bucket, which reuses its name.
+func clearBucketLoggingTarget(ctx context.Context, tx *dbx.Tx, projectID uuid.UUID, targetBucket []byte) error {
+	_, err := tx.Tx.ExecContext(ctx, `
+		UPDATE bucket_metainfos SET logging_target_bucket = NULL, logging_target_prefix = NULL, last_modified = $3
+		WHERE project_id = $1 AND logging_target_bucket = $2
+	`, projectID, targetBucket, time.Now())
+	return err
+}
+
+// ListBucketLoggingSources returns the names of the buckets of the project, which log
+// their accesses into the target bucket.
+func (db *bucketsDB) ListBucketLoggingSources(ctx context.Context, projectID uuid.UUID, targetBucket []byte) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT name FROM bucket_metainfos
+		WHERE project_id = $1 AND logging_target_bucket = $2
+	`, projectID, targetBucket)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var names [][]byte
+	for rows.Next() {
+		var name []byte
+		if err := rows.Scan(&name); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		names = append(names, name)
+	}
+	return names, storj.ErrBucket.Wrap(rows.Err())
+}
+
 // ListBuckets returns a list of buckets for a project.
-func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storj.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error) {
+// It's served by the read replica when ctx is marked with dbreplica.WithReadOnly.
+func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storj.BucketList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBuckets, more, err := db.listBuckets(ctx, projectID, listOpts, allowedBuckets)
+	if err != nil {
+		return bucketList, err
+	}
+
+	bucketList.More = more
+	bucketList.Items = make([]storj.Bucket, 0, len(dbxBuckets))
+	for _, dbxBucket := range dbxBuckets {
+		item, err := convertDBXtoBucket(dbxBucket)
+		if err != nil {
+			return storj.BucketList{}, storj.ErrBucket.Wrap(err)
+		}
+		bucketList.Items = append(bucketList.Items, item)
+	}
+	return bucketList, nil
+}
+
+// ListMinimalBuckets returns a list of buckets for a project with the fields of GetMinimalBucket.
+// It's served by the read replica when ctx is marked with dbreplica.WithReadOnly.
+func (db *bucketsDB) ListMinimalBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList buckets.MinimalBucketList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBuckets, more, err := db.listBuckets(ctx, projectID, listOpts, allowedBuckets)
+	if err != nil {
+		return bucketList, err
+	}
+
+	bucketList.More = more
+	bucketList.Items = make([]buckets.Bucket, 0, len(dbxBuckets))
+	for _, dbxBucket := range dbxBuckets {
+		item, err := convertDBXtoMinimalBucket(dbxBucket)
+		if err != nil {
+			return buckets.MinimalBucketList{}, storj.ErrBucket.Wrap(err)
+		}
+		bucketList.Items = append(bucketList.Items, item)
+	}
+	return bucketList, nil
+}
+
+// listBuckets returns the allowed, not soft-deleted buckets for a project, and whether there are more.
+func (db *bucketsDB) listBuckets(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, allowedBuckets macaroon.AllowedBuckets) (items []*dbx.BucketMetainfo, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	const defaultListLimit = 10000
@@ -196,12 +826,21 @@ func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listO
 	}
 	limit := listOpts.Limit + 1 // add one to detect More
 
+	if listOpts.OrderBy == buckets.OrderByName && listOpts.Prefix != "" && listOpts.Cursor < listOpts.Prefix {
+		// buckets before the prefix can't match it, so start listing from the prefix
+		listOpts.Cursor = listOpts.Prefix
+		listOpts.Direction = storj.Forward
+	}
+
 	for {
 		var dbxBuckets []*dbx.BucketMetainfo
-		switch listOpts.Direction {
+		switch {
+		case listOpts.OrderBy == buckets.OrderByCreatedAt:
+			dbxBuckets, err = db.listBucketsByCreatedAt(ctx, projectID, listOpts, limit)
+
 		// For simplictiy we are only supporting the forward direction for listing buckets
-		case storj.Forward:
-			dbxBuckets, err = db.db.Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx,
+		case listOpts.OrderBy == buckets.OrderByName && listOpts.Direction == storj.Forward:
+			dbxBuckets, err = db.db.reader(ctx).Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_OrderBy_Asc_Name(ctx,
 				dbx.BucketMetainfo_ProjectId(projectID[:]),
 				dbx.BucketMetainfo_Name([]byte(listOpts.Cursor)),
 				limit,
@@ -209,58 +848,149 @@ func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listO
 			)
 
 		// After is only called by BucketListOptions.NextPage and is the paginated Forward direction
-		case storj.After:
-			dbxBuckets, err = db.db.Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx,
+		case listOpts.OrderBy == buckets.OrderByName && listOpts.Direction == storj.After:
+			dbxBuckets, err = db.db.reader(ctx).Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy_Asc_Name(ctx,
 				dbx.BucketMetainfo_ProjectId(projectID[:]),
 				dbx.BucketMetainfo_Name([]byte(listOpts.Cursor)),
 				limit,
 				0,
 			)
+		case listOpts.OrderBy == buckets.OrderByName:
+			return nil, false, errors.New("unknown list direction")
 		default:
-			return bucketList, errors.New("unknown list direction")
+			return nil, false, errors.New("unknown list order")
 		}
 		if err != nil {
-			return bucketList, storj.ErrBucket.Wrap(err)
+			return nil, false, storj.ErrBucket.Wrap(err)
+		}
+
+		// When buckets are ordered by name, once a bucket doesn't match the prefix,
+		// none of the following buckets will match either.
+		prefixExhausted := false
+		if listOpts.Prefix != "" && listOpts.OrderBy == buckets.OrderByName {
+			for i, dbxBucket := range dbxBuckets {
+				if !bytes.HasPrefix(dbxBucket.Name, []byte(listOpts.Prefix)) {
+					dbxBuckets = dbxBuckets[:i]
+					prefixExhausted = true
+					break
+				}
+			}
 		}
 
-		bucketList.More = len(dbxBuckets) > listOpts.Limit
-		if bucketList.More {
+		more = !prefixExhausted && len(dbxBuckets) > listOpts.Limit
+		if more {
 			// If there are more buckets than listOpts.limit returned,
 			// then remove the extra buckets so that we do not return
 			// more then the limit
 			dbxBuckets = dbxBuckets[0:listOpts.Limit]
 		}
 
-		if bucketList.Items == nil {
-			bucketList.Items = make([]storj.Bucket, 0, len(dbxBuckets))
-		}
-
 		for _, dbxBucket := range dbxBuckets {
+			if len(items) == listOpts.Limit {
+				// the page is already full with the buckets of the previous fetches,
+				// the rest of them are listed by the next page
+				more = true
+				break
+			}
+			if dbxBucket.DeletedAt != nil {
+				continue
+			}
+			if !bytes.HasPrefix(dbxBucket.Name, []byte(listOpts.Prefix)) {
+				continue
+			}
+			if !listOpts.ModifiedSince.IsZero() && !bucketLastModified(dbxBucket.CreatedAt, dbxBucket.LastModified).After(listOpts.ModifiedSince) {
+				continue
+			}
 			// Check that the bucket is allowed to be viewed
-			_, bucketAllowed := allowedBuckets.Buckets[string(dbxBucket.Name)]
-			if bucketAllowed || allowedBuckets.All {
-				item, err := convertDBXtoBucket(dbxBucket)
-				if err != nil {
-					return bucketList, storj.ErrBucket.Wrap(err)
-				}
-				bucketList.Items = append(bucketList.Items, item)
+			if buckets.IsAllowed(allowedBuckets, dbxBucket.Name) {
+				items = append(items, dbxBucket)
 			}
 		}
 
-		if len(bucketList.Items) < listOpts.Limit && bucketList.More {
-			// If we filtered out disallowed buckets, then get more buckets
+		if len(items) < listOpts.Limit && more {
+			// If we filtered out disallowed or unmodified buckets, then get more buckets
 			// out of database so that we return `limit` number of buckets
-			listOpts = storj.BucketListOptions{
-				Cursor:    string(dbxBuckets[len(dbxBuckets)-1].Name),
-				Limit:     listOpts.Limit,
-				Direction: storj.After,
+			last := dbxBuckets[len(dbxBuckets)-1]
+			listOpts = buckets.ListOptions{
+				BucketListOptions: storj.BucketListOptions{
+					Cursor:    string(last.Name),
+					Limit:     listOpts.Limit,
+					Direction: storj.After,
+				},
+				Prefix:          listOpts.Prefix,
+				OrderBy:         listOpts.OrderBy,
+				CursorCreatedAt: last.CreatedAt,
+				ModifiedSince:   listOpts.ModifiedSince,
 			}
 			continue
 		}
 		break
 	}
 
-	return bucketList, nil
+	return items, more, nil
+}
+
+// listBucketsByCreatedAt returns at most limit buckets of the project following the cursor,
+// ordered by creation time, newest first, and by name for buckets created at the same time.
+func (db *bucketsDB) listBucketsByCreatedAt(ctx context.Context, projectID uuid.UUID, listOpts buckets.ListOptions, limit int) (_ []*dbx.BucketMetainfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	const columns = `
+		id, project_id, name, partner_id, user_agent, path_cipher, created_at,
+		default_segment_size, default_encryption_cipher_suite, default_encryption_block_size,
+		default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares,
+		default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares,
+		placement, object_lock_enabled, default_retention_mode, default_retention_days, deleted_at, tags, cors, default_object_ttl, created_by,
+		logging_target_bucket, logging_target_prefix, frozen, last_modified`
+
+	var nameComparison string
+	switch listOpts.Direction {
+	case storj.Forward:
+		nameComparison = ">="
+	case storj.After:
+		nameComparison = ">"
+	default:
+		return nil, errors.New("unknown list direction")
+	}
+
+	var query string
+	var args []interface{}
+	if listOpts.Cursor == "" {
+		query = `SELECT ` + columns + ` FROM bucket_metainfos
+			WHERE project_id = $1
+			ORDER BY created_at DESC, name ASC
+			LIMIT $2`
+		args = []interface{}{projectID, limit}
+	} else {
+		query = `SELECT ` + columns + ` FROM bucket_metainfos
+			WHERE project_id = $1
+				AND (created_at < $2 OR (created_at = $2 AND name ` + nameComparison + ` $3))
+			ORDER BY created_at DESC, name ASC
+			LIMIT $4`
+		args = []interface{}{projectID, listOpts.CursorCreatedAt, []byte(listOpts.Cursor), limit}
+	}
+
+	rows, err := db.db.reader(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var dbxBuckets []*dbx.BucketMetainfo
+	for rows.Next() {
+		b := &dbx.BucketMetainfo{}
+		err := rows.Scan(&b.Id, &b.ProjectId, &b.Name, &b.PartnerId, &b.UserAgent, &b.PathCipher, &b.CreatedAt,
+			&b.DefaultSegmentSize, &b.DefaultEncryptionCipherSuite, &b.DefaultEncryptionBlockSize,
+			&b.DefaultRedundancyAlgorithm, &b.DefaultRedundancyShareSize, &b.DefaultRedundancyRequiredShares,
+			&b.DefaultRedundancyRepairShares, &b.DefaultRedundancyOptimalShares, &b.DefaultRedundancyTotalShares,
+			&b.Placement, &b.ObjectLockEnabled, &b.DefaultRetentionMode, &b.DefaultRetentionDays, &b.DeletedAt, &b.Tags, &b.Cors, &b.DefaultObjectTtl, &b.CreatedBy,
+			&b.LoggingTargetBucket, &b.LoggingTargetPrefix, &b.Frozen, &b.LastModified)
+		if err != nil {
+			return nil, err
+		}
+		dbxBuckets = append(dbxBuckets, b)
+	}
+	return dbxBuckets, rows.Err()
 }
 
 // CountBuckets returns the number of buckets a project currently has.
@@ -272,6 +1002,401 @@ func (db *bucketsDB) CountBuckets(ctx context.Context, projectID uuid.UUID) (cou
 	return int(count64), nil
 }
 
+// CountBucketsUpTo returns the number of buckets a project currently has, counting at most limit buckets.
+func (db *bucketsDB) CountBucketsUpTo(ctx context.Context, projectID uuid.UUID, limit int) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the limited subquery stops scanning the primary key index after limit buckets
+	err = db.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT 1 FROM bucket_metainfos WHERE project_id = $1 LIMIT $2
+		) AS buckets
+	`, projectID, limit).Scan(&count)
+	if err != nil {
+		return -1, storj.ErrBucket.Wrap(err)
+	}
+	return count, nil
+}
+
+// ListProjectBucketCounts returns at most limit projects with an id after cursor, ordered by id,
+// together with their bucket counts and bucket limits.
+func (db *bucketsDB) ListProjectBucketCounts(ctx context.Context, cursor uuid.UUID, limit int) (_ []buckets.ProjectBucketCount, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT projects.id, projects.max_buckets,
+			(SELECT COUNT(*) FROM bucket_metainfos WHERE bucket_metainfos.project_id = projects.id)
+		FROM projects
+		WHERE projects.id > $1
+		ORDER BY projects.id
+		LIMIT $2
+	`, cursor, limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var counts []buckets.ProjectBucketCount
+	for rows.Next() {
+		var count buckets.ProjectBucketCount
+		var maxBuckets sql.NullInt64
+		if err := rows.Scan(&count.ProjectID, &maxBuckets, &count.Count); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		if maxBuckets.Valid {
+			max := int(maxBuckets.Int64)
+			count.MaxBuckets = &max
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	return counts, nil
+}
+
+// SoftDeleteBucket marks a bucket as deleted, hiding it until it's restored or removed.
+// The CORS rules of the bucket are dropped and access logging into the bucket is disabled,
+// like when the bucket is removed.
+func (db *bucketsDB) SoftDeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID, deletedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE bucket_metainfos SET deleted_at = $3, cors = NULL
+			WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+		`, projectID, bucketName, deletedAt)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		return clearBucketLoggingTarget(ctx, tx, projectID, bucketName)
+	})
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			return err
+		}
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// RestoreBucket clears the deletion mark of a bucket soft-deleted after deletedAfter.
+func (db *bucketsDB) RestoreBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID, deletedAfter time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE bucket_metainfos SET deleted_at = NULL, last_modified = $4
+		WHERE project_id = $1 AND name = $2 AND deleted_at > $3
+	`, projectID, bucketName, deletedAfter, time.Now())
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if affected == 0 {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// ListSoftDeletedBuckets returns at most limit buckets soft-deleted before deletedBefore.
+func (db *bucketsDB) ListSoftDeletedBuckets(ctx context.Context, deletedBefore time.Time, limit int) (_ []metabase.BucketLocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT project_id, name FROM bucket_metainfos
+		WHERE deleted_at < $1
+		LIMIT $2
+	`, deletedBefore, limit)
+	if err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var locations []metabase.BucketLocation
+	for rows.Next() {
+		var location metabase.BucketLocation
+		var name []byte
+		if err := rows.Scan(&location.ProjectID, &name); err != nil {
+			return nil, storj.ErrBucket.Wrap(err)
+		}
+		location.BucketName = string(name)
+		locations = append(locations, location)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storj.ErrBucket.Wrap(err)
+	}
+	return locations, nil
+}
+
+// DeleteSoftDeletedBucket removes a bucket, when it was soft-deleted before deletedBefore.
+func (db *bucketsDB) DeleteSoftDeletedBucket(ctx context.Context, bucket metabase.BucketLocation, deletedBefore time.Time) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM bucket_metainfos
+		WHERE project_id = $1 AND name = $2 AND deleted_at < $3
+	`, bucket.ProjectID, []byte(bucket.BucketName), deletedBefore)
+	if err != nil {
+		return false, storj.ErrBucket.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, storj.ErrBucket.Wrap(err)
+	}
+	return affected > 0, nil
+}
+
+// TestingSetBucketCreatedAt sets the creation time of a bucket.
+func (db *bucketsDB) TestingSetBucketCreatedAt(ctx context.Context, bucketName []byte, projectID uuid.UUID, createdAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE bucket_metainfos SET created_at = $3
+		WHERE project_id = $1 AND name = $2
+	`, projectID, bucketName, createdAt)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	if affected == 0 {
+		return storj.ErrBucketNotFound.New("%s", bucketName)
+	}
+	return nil
+}
+
+// StartBucketRename renames the bucket and records the pending rename of its objects.
+// The bucket attribution moves to the new name together with the bucket, and so does
+// access logging into the bucket. A case-insensitive bucket remains case-insensitive.
+func (db *bucketsDB) StartBucketRename(ctx context.Context, projectID uuid.UUID, oldName, newName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE bucket_metainfos SET
+				name = $3,
+				name_normalized = CASE WHEN name_normalized IS NULL THEN NULL ELSE $5 END,
+				last_modified = $4
+			WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+		`, projectID, oldName, newName, time.Now(), buckets.NormalizeName(newName))
+		if err != nil {
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return buckets.ErrBucketAlreadyExists.New("%s", newName)
+			}
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return storj.ErrBucketNotFound.New("%s", oldName)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE bucket_metainfos SET logging_target_bucket = $3, last_modified = $4
+			WHERE project_id = $1 AND logging_target_bucket = $2
+		`, projectID, oldName, newName, time.Now())
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE value_attributions SET bucket_name = $3
+			WHERE project_id = $1 AND bucket_name = $2
+		`, projectID, oldName, newName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO bucket_renames (project_id, old_name, new_name, created_at)
+			VALUES ($1, $2, $3, now())
+		`, projectID, oldName, newName)
+		return err
+	})
+	if err != nil {
+		if buckets.ErrBucketAlreadyExists.Has(err) || storj.ErrBucketNotFound.Has(err) {
+			return err
+		}
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// GetBucketRename returns the pending rename of the bucket with the old name.
+func (db *bucketsDB) GetBucketRename(ctx context.Context, projectID uuid.UUID, oldName []byte) (_ buckets.Rename, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rename buckets.Rename
+	err = db.db.QueryRowContext(ctx, `
+		SELECT old_name, new_name, created_at
+		FROM bucket_renames
+		WHERE project_id = $1 AND old_name = $2
+	`, projectID, oldName).Scan(&rename.OldName, &rename.NewName, &rename.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Rename{}, buckets.ErrBucketRenameNotFound.New("%s", oldName)
+		}
+		return buckets.Rename{}, storj.ErrBucket.Wrap(err)
+	}
+	return rename, nil
+}
+
+// FinishBucketRename removes the record of the pending rename of the bucket with the old name.
+func (db *bucketsDB) FinishBucketRename(ctx context.Context, projectID uuid.UUID, oldName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_renames
+		WHERE project_id = $1 AND old_name = $2
+	`, projectID, oldName)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// StartBucketTransfer moves the bucket to the new project and records the pending transfer of its objects.
+// The bucket attribution moves to the new project together with the bucket. Access logging into
+// or from the bucket is disabled, since the logging target must be in the same project.
+func (db *bucketsDB) StartBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte, newProjectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE bucket_metainfos SET project_id = $3, logging_target_bucket = NULL, logging_target_prefix = NULL, last_modified = $4
+			WHERE project_id = $1 AND name = $2 AND deleted_at IS NULL
+		`, projectID, bucketName, newProjectID, time.Now())
+		if err != nil {
+			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
+				return buckets.ErrBucketAlreadyExists.New("%s", bucketName)
+			}
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return storj.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		err = clearBucketLoggingTarget(ctx, tx, projectID, bucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE value_attributions SET project_id = $3
+			WHERE project_id = $1 AND bucket_name = $2
+		`, projectID, bucketName, newProjectID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO bucket_transfers (project_id, bucket_name, new_project_id, created_at)
+			VALUES ($1, $2, $3, now())
+		`, projectID, bucketName, newProjectID)
+		return err
+	})
+	if err != nil {
+		if buckets.ErrBucketAlreadyExists.Has(err) || storj.ErrBucketNotFound.Has(err) {
+			return err
+		}
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// GetBucketTransfer returns the pending transfer of the bucket from the project.
+func (db *bucketsDB) GetBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte) (_ buckets.Transfer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	transfer := buckets.Transfer{ProjectID: projectID}
+	err = db.db.QueryRowContext(ctx, `
+		SELECT bucket_name, new_project_id, created_at
+		FROM bucket_transfers
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName).Scan(&transfer.BucketName, &transfer.NewProjectID, &transfer.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Transfer{}, buckets.ErrBucketTransferNotFound.New("%s", bucketName)
+		}
+		return buckets.Transfer{}, storj.ErrBucket.Wrap(err)
+	}
+	return transfer, nil
+}
+
+// FinishBucketTransfer removes the record of the pending transfer of the bucket from the project.
+func (db *bucketsDB) FinishBucketTransfer(ctx context.Context, projectID uuid.UUID, bucketName []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_transfers
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, bucketName)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+	return nil
+}
+
+// GetIdempotencyResult returns the result stored for the idempotency key after createdAfter.
+func (db *bucketsDB) GetIdempotencyResult(ctx context.Context, projectID uuid.UUID, key []byte, createdAfter time.Time) (_ buckets.IdempotencyResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var result buckets.IdempotencyResult
+	err = db.db.QueryRowContext(ctx, `
+		SELECT operation, bucket_name, response, created_at
+		FROM bucket_idempotency_keys
+		WHERE project_id = $1 AND idempotency_key = $2 AND created_at > $3
+	`, projectID, key, createdAfter).Scan(&result.Operation, &result.BucketName, &result.Response, &result.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.IdempotencyResult{}, buckets.ErrIdempotencyKeyNotFound.New("")
+		}
+		return buckets.IdempotencyResult{}, storj.ErrBucket.Wrap(err)
+	}
+	return result, nil
+}
+
+// StoreIdempotencyResult stores the result for the idempotency key, unless a result was
+// already stored for it after createdAfter. Results of the project stored before are removed.
+func (db *bucketsDB) StoreIdempotencyResult(ctx context.Context, projectID uuid.UUID, key []byte, result buckets.IdempotencyResult, createdAfter time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM bucket_idempotency_keys
+		WHERE project_id = $1 AND created_at <= $2
+	`, projectID, createdAfter)
+	if err != nil {
+		return storj.ErrBucket.Wrap(err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_idempotency_keys (
+			project_id, idempotency_key, operation, bucket_name, response, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (project_id, idempotency_key) DO NOTHING
+	`, projectID, key, result.Operation, result.BucketName, result.Response, result.CreatedAt)
+	return storj.ErrBucket.Wrap(err)
+}
+
 func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err error) {
 	id, err := uuid.FromBytes(dbxBucket.Id)
 	if err != nil {
@@ -321,3 +1446,75 @@ func convertDBXtoBucket(dbxBucket *dbx.BucketMetainfo) (bucket storj.Bucket, err
 
 	return bucket, nil
 }
+
+func convertDBXtoMinimalBucket(dbxBucket *dbx.BucketMetainfo) (bucket buckets.Bucket, err error) {
+	bucket = buckets.Bucket{
+		Name:      dbxBucket.Name,
+		CreatedAt: dbxBucket.CreatedAt,
+		DefaultEncryptionParameters: storj.EncryptionParameters{
+			CipherSuite: storj.CipherSuite(dbxBucket.DefaultEncryptionCipherSuite),
+			BlockSize:   int32(dbxBucket.DefaultEncryptionBlockSize),
+		},
+		DefaultSegmentSize: memory.Size(dbxBucket.DefaultSegmentSize),
+	}
+	if dbxBucket.Placement != nil {
+		bucket.Placement = storj.PlacementConstraint(*dbxBucket.Placement)
+	}
+	if dbxBucket.ObjectLockEnabled != nil {
+		bucket.ObjectLockEnabled = *dbxBucket.ObjectLockEnabled
+	}
+	if dbxBucket.DefaultRetentionMode != nil {
+		bucket.DefaultRetention.Mode = buckets.RetentionMode(*dbxBucket.DefaultRetentionMode)
+	}
+	if dbxBucket.DefaultRetentionDays != nil {
+		bucket.DefaultRetention.Days = *dbxBucket.DefaultRetentionDays
+	}
+	if dbxBucket.Tags != nil {
+		bucket.Tags, err = decodeBucketTags(*dbxBucket.Tags)
+		if err != nil {
+			return buckets.Bucket{}, err
+		}
+	}
+	if dbxBucket.Cors != nil {
+		bucket.CORS, err = decodeBucketCORS(*dbxBucket.Cors)
+		if err != nil {
+			return buckets.Bucket{}, err
+		}
+	}
+	if dbxBucket.DefaultObjectTtl != nil {
+		bucket.DefaultObjectTTL = time.Duration(*dbxBucket.DefaultObjectTtl) * time.Second
+	}
+	if dbxBucket.CreatedBy != nil {
+		bucket.CreatedBy, err = uuid.FromBytes(*dbxBucket.CreatedBy)
+		if err != nil {
+			return buckets.Bucket{}, err
+		}
+	}
+	bucket.Logging = convertDBXtoBucketLogging(dbxBucket.LoggingTargetBucket, dbxBucket.LoggingTargetPrefix)
+	if dbxBucket.Frozen != nil {
+		bucket.Frozen = *dbxBucket.Frozen
+	}
+	bucket.LastModified = bucketLastModified(dbxBucket.CreatedAt, dbxBucket.LastModified)
+	return bucket, nil
+}
+
+// bucketLastModified returns when the bucket was last modified. Buckets, which
+// weren't modified since they were created, have no last_modified set.
+func bucketLastModified(createdAt time.Time, lastModified *time.Time) time.Time {
+	if lastModified != nil {
+		return *lastModified
+	}
+	return createdAt
+}
+
+// convertDBXtoBucketLogging returns the access logging configuration stored in the nullable columns.
+func convertDBXtoBucketLogging(targetBucket *[]byte, targetPrefix *string) buckets.Logging {
+	var logging buckets.Logging
+	if targetBucket != nil {
+		logging.TargetBucket = *targetBucket
+	}
+	if targetPrefix != nil {
+		logging.TargetPrefix = *targetPrefix
+	}
+	return logging
+}
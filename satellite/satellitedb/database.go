@@ -14,6 +14,7 @@ import (
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
+	"storj.io/storj/private/dbreplica"
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
@@ -49,6 +51,10 @@ type satelliteDBCollection struct {
 type satelliteDB struct {
 	*dbx.DB
 
+	// replica serves reads of contexts marked with dbreplica.WithReadOnly,
+	// it's nil when no read replica is configured.
+	replica *dbx.DB
+
 	migrationDB tagsql.DB
 
 	opts   Options
@@ -73,6 +79,11 @@ type Options struct {
 	// How many storage node rollups to save/read in one batch.
 	SaveRollupBatchSize int
 	ReadRollupBatchSize int
+
+	// ReadReplicaURL is the connection string of a read replica of the
+	// default database. Only the queries which are known to be safe against
+	// replication lag are routed to it, see dbreplica.WithReadOnly.
+	ReadReplicaURL string
 }
 
 var _ dbx.DBMethods = &satelliteDB{}
@@ -106,9 +117,61 @@ func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 		dbc.dbs[key] = db
 	}
 
+	if opts.ReadReplicaURL != "" {
+		replica, err := openReplica(ctx, log, opts.ReadReplicaURL, opts)
+		if err != nil {
+			return nil, err
+		}
+		dbc.dbs[""].replica = replica
+	}
+
 	return dbc, nil
 }
 
+func openReplica(ctx context.Context, log *zap.Logger, databaseURL string, opts Options) (*dbx.DB, error) {
+	driver, source, impl, err := dbutil.SplitConnStr(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	if impl != dbutil.Postgres && impl != dbutil.Cockroach {
+		return nil, Error.New("unsupported driver %q", driver)
+	}
+
+	source, err = pgutil.CheckApplicationName(source, opts.ApplicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	replica, err := dbx.Open(driver, source)
+	if err != nil {
+		return nil, Error.New("failed opening read replica via DBX at %q: %v",
+			source, err)
+	}
+	log.Debug("Connected to read replica:", zap.String("db source", source))
+
+	dbutil.Configure(ctx, replica.DB, "satellitedb:replica", mon)
+
+	return replica, nil
+}
+
+// reader returns the database to use for reads, which is the read replica
+// when ctx is marked as read-only and a replica is configured.
+func (db *satelliteDB) reader(ctx context.Context) *dbx.DB {
+	if db.replica != nil && dbreplica.IsReadOnly(ctx) {
+		mon.Counter("satellitedb_read_replica_queries").Inc(1)
+		return db.replica
+	}
+	return db.DB
+}
+
+// Close closes the database and the read replica.
+func (db *satelliteDB) Close() error {
+	if db.replica == nil {
+		return db.DB.Close()
+	}
+	return errs.Combine(db.DB.Close(), db.replica.Close())
+}
+
 func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options, override string) (*satelliteDB, error) {
 	driver, source, impl, err := dbutil.SplitConnStr(databaseURL)
 	if err != nil {
@@ -291,11 +354,22 @@ func (dbc *satelliteDBCollection) NodeAPIVersion() nodeapiversion.DB {
 	return &nodeAPIVersionDB{db: dbc.getByName("nodeapiversion")}
 }
 
+// EmailSuppressions returns database for the email suppression lists of projects.
+func (dbc *satelliteDBCollection) EmailSuppressions() mailservice.SuppressionDB {
+	return &emailSuppressionsDB{db: dbc.getByName("emailsuppressions")}
+}
+
 // Buckets returns database for interacting with buckets.
 func (dbc *satelliteDBCollection) Buckets() buckets.DB {
 	return &bucketsDB{db: dbc.getByName("buckets")}
 }
 
+// WithReadOnly marks ctx, so that reads which tolerate replication lag
+// may be served by the read replica.
+func (dbc *satelliteDBCollection) WithReadOnly(ctx context.Context) context.Context {
+	return dbreplica.WithReadOnly(ctx)
+}
+
 // CheckVersion confirms all databases are at the desired version.
 func (dbc *satelliteDBCollection) CheckVersion(ctx context.Context) error {
 	var eg errs.Group
@@ -347,6 +347,28 @@ CREATE TABLE billing_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE bucket_access_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	target_bucket bytea NOT NULL,
+	target_prefix text NOT NULL,
+	operation text NOT NULL,
+	object_key bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_audit_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	action text NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -369,6 +391,22 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_idempotency_keys (
+	project_id bytea NOT NULL,
+	idempotency_key bytea NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	response bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, idempotency_key )
+);
+CREATE TABLE bucket_renames (
+	project_id bytea NOT NULL,
+	old_name bytea NOT NULL,
+	new_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, old_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -383,6 +421,13 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_transfers (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	new_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -426,6 +471,12 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_suppressions (
+	project_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -561,6 +612,7 @@ CREATE TABLE projects (
 	user_agent bytea,
 	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
+	default_redundancy_scheme text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE project_bandwidth_daily_rollups (
@@ -796,6 +848,7 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	user_agent bytea,
+	created_by bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
@@ -819,8 +872,23 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	object_lock_enabled boolean,
+	default_retention_mode integer,
+	default_retention_days integer,
+	deleted_at timestamp with time zone,
+	tags bytea,
+	cors bytea,
+	default_object_ttl bigint,
+	created_by bytea,
+	logging_target_bucket bytea,
+	logging_target_prefix text,
+	frozen boolean,
+	last_modified timestamp with time zone,
+	cost_center text,
+	name_normalized bytea,
 	PRIMARY KEY ( id ),
-	UNIQUE ( project_id, name )
+	UNIQUE ( project_id, name ),
+	UNIQUE ( project_id, name_normalized )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -848,6 +916,8 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX bucket_access_logs_created_at_index ON bucket_access_logs ( created_at ) ;
+CREATE INDEX bucket_audit_logs_project_id_created_at_index ON bucket_audit_logs ( project_id, created_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
@@ -998,6 +1068,28 @@ CREATE TABLE billing_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE bucket_access_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	target_bucket bytea NOT NULL,
+	target_prefix text NOT NULL,
+	operation text NOT NULL,
+	object_key bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_audit_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	action text NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1020,6 +1112,22 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_idempotency_keys (
+	project_id bytea NOT NULL,
+	idempotency_key bytea NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	response bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, idempotency_key )
+);
+CREATE TABLE bucket_renames (
+	project_id bytea NOT NULL,
+	old_name bytea NOT NULL,
+	new_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, old_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1034,6 +1142,13 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_transfers (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	new_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -1077,6 +1192,12 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_suppressions (
+	project_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1212,6 +1333,7 @@ CREATE TABLE projects (
 	user_agent bytea,
 	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
+	default_redundancy_scheme text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE project_bandwidth_daily_rollups (
@@ -1447,6 +1569,7 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	user_agent bytea,
+	created_by bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
@@ -1470,8 +1593,23 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	object_lock_enabled boolean,
+	default_retention_mode integer,
+	default_retention_days integer,
+	deleted_at timestamp with time zone,
+	tags bytea,
+	cors bytea,
+	default_object_ttl bigint,
+	created_by bytea,
+	logging_target_bucket bytea,
+	logging_target_prefix text,
+	frozen boolean,
+	last_modified timestamp with time zone,
+	cost_center text,
+	name_normalized bytea,
 	PRIMARY KEY ( id ),
-	UNIQUE ( project_id, name )
+	UNIQUE ( project_id, name ),
+	UNIQUE ( project_id, name_normalized )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -1499,6 +1637,8 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX bucket_access_logs_created_at_index ON bucket_access_logs ( created_at ) ;
+CREATE INDEX bucket_audit_logs_project_id_created_at_index ON bucket_audit_logs ( project_id, created_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
@@ -1971,1743 +2111,2451 @@ func (f BillingTransaction_CreatedAt_Field) value() interface{} {
 
 func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type BucketAccessLog struct {
+	Id           []byte
+	ProjectId    []byte
+	BucketName   []byte
+	TargetBucket []byte
+	TargetPrefix string
+	Operation    string
+	ObjectKey    []byte
+	ApiKeyId     []byte
+	CreatedAt    time.Time
 }
 
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
+func (BucketAccessLog) _Table() string { return "bucket_access_logs" }
 
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
+type BucketAccessLog_Update_Fields struct {
 }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type BucketAccessLog_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func BucketAccessLog_Id(v []byte) BucketAccessLog_Id_Field {
+	return BucketAccessLog_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f BucketAccessLog_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketAccessLog_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type BucketAccessLog_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func BucketAccessLog_ProjectId(v []byte) BucketAccessLog_ProjectId_Field {
+	return BucketAccessLog_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f BucketAccessLog_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketAccessLog_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type BucketAccessLog_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func BucketAccessLog_BucketName(v []byte) BucketAccessLog_BucketName_Field {
+	return BucketAccessLog_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f BucketAccessLog_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketAccessLog_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type BucketAccessLog_TargetBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func BucketAccessLog_TargetBucket(v []byte) BucketAccessLog_TargetBucket_Field {
+	return BucketAccessLog_TargetBucket_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f BucketAccessLog_TargetBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BucketAccessLog_TargetBucket_Field) _Column() string { return "target_bucket" }
 
-type BucketBandwidthRollup_Action_Field struct {
+type BucketAccessLog_TargetPrefix_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func BucketAccessLog_TargetPrefix(v string) BucketAccessLog_TargetPrefix_Field {
+	return BucketAccessLog_TargetPrefix_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func (f BucketAccessLog_TargetPrefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (BucketAccessLog_TargetPrefix_Field) _Column() string { return "target_prefix" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type BucketAccessLog_Operation_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func BucketAccessLog_Operation(v string) BucketAccessLog_Operation_Field {
+	return BucketAccessLog_Operation_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f BucketAccessLog_Operation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (BucketAccessLog_Operation_Field) _Column() string { return "operation" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type BucketAccessLog_ObjectKey_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func BucketAccessLog_ObjectKey(v []byte) BucketAccessLog_ObjectKey_Field {
+	return BucketAccessLog_ObjectKey_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f BucketAccessLog_ObjectKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (BucketAccessLog_ObjectKey_Field) _Column() string { return "object_key" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type BucketAccessLog_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func BucketAccessLog_ApiKeyId(v []byte) BucketAccessLog_ApiKeyId_Field {
+	return BucketAccessLog_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f BucketAccessLog_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
-
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
-
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
-}
+func (BucketAccessLog_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type BucketAccessLog_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func BucketAccessLog_CreatedAt(v time.Time) BucketAccessLog_CreatedAt_Field {
+	return BucketAccessLog_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f BucketAccessLog_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketAccessLog_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type BucketAuditLog struct {
+	Id         []byte
+	ProjectId  []byte
+	BucketName []byte
+	Action     string
+	ApiKeyId   []byte
+	ApiKeyHead []byte
+	CreatedAt  time.Time
+}
+
+func (BucketAuditLog) _Table() string { return "bucket_audit_logs" }
+
+type BucketAuditLog_Update_Fields struct {
+}
+
+type BucketAuditLog_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func BucketAuditLog_Id(v []byte) BucketAuditLog_Id_Field {
+	return BucketAuditLog_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f BucketAuditLog_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketAuditLog_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type BucketAuditLog_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func BucketAuditLog_ProjectId(v []byte) BucketAuditLog_ProjectId_Field {
+	return BucketAuditLog_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f BucketAuditLog_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketAuditLog_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type BucketAuditLog_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func BucketAuditLog_BucketName(v []byte) BucketAuditLog_BucketName_Field {
+	return BucketAuditLog_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f BucketAuditLog_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BucketAuditLog_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type BucketAuditLog_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func BucketAuditLog_Action(v string) BucketAuditLog_Action_Field {
+	return BucketAuditLog_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f BucketAuditLog_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (BucketAuditLog_Action_Field) _Column() string { return "action" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type BucketAuditLog_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func BucketAuditLog_ApiKeyId(v []byte) BucketAuditLog_ApiKeyId_Field {
+	return BucketAuditLog_ApiKeyId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+func (f BucketAuditLog_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+func (BucketAuditLog_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type BucketBandwidthRollupArchive_Allocated_Field struct {
+type BucketAuditLog_ApiKeyHead_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func BucketAuditLog_ApiKeyHead(v []byte) BucketAuditLog_ApiKeyHead_Field {
+	return BucketAuditLog_ApiKeyHead_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f BucketAuditLog_ApiKeyHead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (BucketAuditLog_ApiKeyHead_Field) _Column() string { return "api_key_head" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type BucketAuditLog_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func BucketAuditLog_CreatedAt(v time.Time) BucketAuditLog_CreatedAt_Field {
+	return BucketAuditLog_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f BucketAuditLog_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (BucketAuditLog_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	TotalBytes          uint64
-	Inline              uint64
-	Remote              uint64
-	TotalSegmentsCount  uint
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
-
-type BucketStorageTally_Create_Fields struct {
-	TotalBytes         BucketStorageTally_TotalBytes_Field
-	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
-}
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-type BucketStorageTally_Update_Fields struct {
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketStorageTally_TotalBytes_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
-	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type BucketStorageTally_Inline_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type BucketStorageTally_Remote_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketStorageTally_TotalSegmentsCount_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
-	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
+}
+
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
+
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
+}
+
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_ObjectCount_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_MetadataSize_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction struct {
-	Id              string
-	UserId          []byte
-	Address         string
-	AmountNumeric   int64
-	ReceivedNumeric int64
-	Status          int
-	Key             string
-	Timeout         int
-	CreatedAt       time.Time
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+}
 
-type CoinpaymentsTransaction_Update_Fields struct {
-	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
-	Status          CoinpaymentsTransaction_Status_Field
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type CoinpaymentsTransaction_Id_Field struct {
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_AmountNumeric_Field struct {
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
-	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+type BucketIdempotencyKey struct {
+	ProjectId      []byte
+	IdempotencyKey []byte
+	Operation      string
+	BucketName     []byte
+	Response       []byte
+	CreatedAt      time.Time
+}
+
+func (BucketIdempotencyKey) _Table() string { return "bucket_idempotency_keys" }
+
+type BucketIdempotencyKey_Update_Fields struct {
+}
+
+type BucketIdempotencyKey_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
-	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+func BucketIdempotencyKey_ProjectId(v []byte) BucketIdempotencyKey_ProjectId_Field {
+	return BucketIdempotencyKey_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+func (f BucketIdempotencyKey_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+func (BucketIdempotencyKey_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketIdempotencyKey_IdempotencyKey_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketIdempotencyKey_IdempotencyKey(v []byte) BucketIdempotencyKey_IdempotencyKey_Field {
+	return BucketIdempotencyKey_IdempotencyKey_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketIdempotencyKey_IdempotencyKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketIdempotencyKey_IdempotencyKey_Field) _Column() string { return "idempotency_key" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketIdempotencyKey_Operation_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketIdempotencyKey_Operation(v string) BucketIdempotencyKey_Operation_Field {
+	return BucketIdempotencyKey_Operation_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketIdempotencyKey_Operation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketIdempotencyKey_Operation_Field) _Column() string { return "operation" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketIdempotencyKey_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketIdempotencyKey_BucketName(v []byte) BucketIdempotencyKey_BucketName_Field {
+	return BucketIdempotencyKey_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketIdempotencyKey_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketIdempotencyKey_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketIdempotencyKey_Response_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketIdempotencyKey_Response(v []byte) BucketIdempotencyKey_Response_Field {
+	return BucketIdempotencyKey_Response_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketIdempotencyKey_Response_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketIdempotencyKey_Response_Field) _Column() string { return "response" }
 
-type Coupon struct {
-	Id             []byte
-	UserId         []byte
-	Amount         int64
-	Description    string
-	Type           int
-	Status         int
-	Duration       int64
-	BillingPeriods *int64
-	CouponCodeName *string
-	CreatedAt      time.Time
+type BucketIdempotencyKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (Coupon) _Table() string { return "coupons" }
+func BucketIdempotencyKey_CreatedAt(v time.Time) BucketIdempotencyKey_CreatedAt_Field {
+	return BucketIdempotencyKey_CreatedAt_Field{_set: true, _value: v}
+}
 
-type Coupon_Create_Fields struct {
-	BillingPeriods Coupon_BillingPeriods_Field
-	CouponCodeName Coupon_CouponCodeName_Field
+func (f BucketIdempotencyKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Coupon_Update_Fields struct {
-	Status Coupon_Status_Field
+func (BucketIdempotencyKey_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketRename struct {
+	ProjectId []byte
+	OldName   []byte
+	NewName   []byte
+	CreatedAt time.Time
 }
 
-type Coupon_Id_Field struct {
+func (BucketRename) _Table() string { return "bucket_renames" }
+
+type BucketRename_Update_Fields struct {
+}
+
+type BucketRename_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Coupon_Id(v []byte) Coupon_Id_Field {
-	return Coupon_Id_Field{_set: true, _value: v}
+func BucketRename_ProjectId(v []byte) BucketRename_ProjectId_Field {
+	return BucketRename_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Id_Field) value() interface{} {
+func (f BucketRename_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Id_Field) _Column() string { return "id" }
+func (BucketRename_ProjectId_Field) _Column() string { return "project_id" }
 
-type Coupon_UserId_Field struct {
+type BucketRename_OldName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Coupon_UserId(v []byte) Coupon_UserId_Field {
-	return Coupon_UserId_Field{_set: true, _value: v}
+func BucketRename_OldName(v []byte) BucketRename_OldName_Field {
+	return BucketRename_OldName_Field{_set: true, _value: v}
 }
 
-func (f Coupon_UserId_Field) value() interface{} {
+func (f BucketRename_OldName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_UserId_Field) _Column() string { return "user_id" }
+func (BucketRename_OldName_Field) _Column() string { return "old_name" }
 
-type Coupon_Amount_Field struct {
+type BucketRename_NewName_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Coupon_Amount(v int64) Coupon_Amount_Field {
-	return Coupon_Amount_Field{_set: true, _value: v}
+func BucketRename_NewName(v []byte) BucketRename_NewName_Field {
+	return BucketRename_NewName_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Amount_Field) value() interface{} {
+func (f BucketRename_NewName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Amount_Field) _Column() string { return "amount" }
+func (BucketRename_NewName_Field) _Column() string { return "new_name" }
 
-type Coupon_Description_Field struct {
+type BucketRename_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Coupon_Description(v string) Coupon_Description_Field {
-	return Coupon_Description_Field{_set: true, _value: v}
+func BucketRename_CreatedAt(v time.Time) BucketRename_CreatedAt_Field {
+	return BucketRename_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Description_Field) value() interface{} {
+func (f BucketRename_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Description_Field) _Column() string { return "description" }
+func (BucketRename_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Coupon_Type_Field struct {
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	TotalBytes          uint64
+	Inline              uint64
+	Remote              uint64
+	TotalSegmentsCount  uint
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
+}
+
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
+
+type BucketStorageTally_Create_Fields struct {
+	TotalBytes         BucketStorageTally_TotalBytes_Field
+	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
+}
+
+type BucketStorageTally_Update_Fields struct {
+}
+
+type BucketStorageTally_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Coupon_Type(v int) Coupon_Type_Field {
-	return Coupon_Type_Field{_set: true, _value: v}
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Type_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Type_Field) _Column() string { return "type" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Coupon_Status_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Coupon_Status(v int) Coupon_Status_Field {
-	return Coupon_Status_Field{_set: true, _value: v}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Status_Field) value() interface{} {
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Status_Field) _Column() string { return "status" }
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type Coupon_Duration_Field struct {
+type BucketStorageTally_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Coupon_Duration(v int64) Coupon_Duration_Field {
-	return Coupon_Duration_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Duration_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Duration_Field) _Column() string { return "duration" }
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type Coupon_BillingPeriods_Field struct {
+type BucketStorageTally_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value uint64
 }
 
-func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
+func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
+	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
 }
 
-func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
-	if v == nil {
-		return Coupon_BillingPeriods_Null()
+func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Coupon_BillingPeriods(*v)
+	return f._value
 }
 
-func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+
+type BucketStorageTally_Inline_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+}
 
-func (f Coupon_BillingPeriods_Field) value() interface{} {
+func (f BucketStorageTally_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
 
-type Coupon_CouponCodeName_Field struct {
+type BucketStorageTally_Remote_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value uint64
 }
 
-func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
 }
 
-func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
-	if v == nil {
-		return Coupon_CouponCodeName_Null()
+func (f BucketStorageTally_Remote_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Coupon_CouponCodeName(*v)
+	return f._value
 }
 
-func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+
+type BucketStorageTally_TotalSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
+	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+}
 
-func (f Coupon_CouponCodeName_Field) value() interface{} {
+func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
+func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
 
-type Coupon_CreatedAt_Field struct {
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint
 }
 
-func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
-	return Coupon_CreatedAt_Field{_set: true, _value: v}
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f Coupon_CreatedAt_Field) value() interface{} {
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
 
-type CouponCode struct {
-	Id             []byte
-	Name           string
-	Amount         int64
-	Description    string
-	Type           int
-	BillingPeriods *int64
-	CreatedAt      time.Time
+type BucketStorageTally_InlineSegmentsCount_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (CouponCode) _Table() string { return "coupon_codes" }
-
-type CouponCode_Create_Fields struct {
-	BillingPeriods CouponCode_BillingPeriods_Field
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
 }
 
-type CouponCode_Update_Fields struct {
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type CouponCode_Id_Field struct {
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
+
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func CouponCode_Id(v []byte) CouponCode_Id_Field {
-	return CouponCode_Id_Field{_set: true, _value: v}
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Id_Field) value() interface{} {
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Id_Field) _Column() string { return "id" }
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
 
-type CouponCode_Name_Field struct {
+type BucketStorageTally_MetadataSize_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint64
 }
 
-func CouponCode_Name(v string) CouponCode_Name_Field {
-	return CouponCode_Name_Field{_set: true, _value: v}
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Name_Field) value() interface{} {
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Name_Field) _Column() string { return "name" }
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
 
-type CouponCode_Amount_Field struct {
+type BucketTransfer struct {
+	ProjectId    []byte
+	BucketName   []byte
+	NewProjectId []byte
+	CreatedAt    time.Time
+}
+
+func (BucketTransfer) _Table() string { return "bucket_transfers" }
+
+type BucketTransfer_Update_Fields struct {
+}
+
+type BucketTransfer_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func CouponCode_Amount(v int64) CouponCode_Amount_Field {
-	return CouponCode_Amount_Field{_set: true, _value: v}
+func BucketTransfer_ProjectId(v []byte) BucketTransfer_ProjectId_Field {
+	return BucketTransfer_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Amount_Field) value() interface{} {
+func (f BucketTransfer_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Amount_Field) _Column() string { return "amount" }
+func (BucketTransfer_ProjectId_Field) _Column() string { return "project_id" }
 
-type CouponCode_Description_Field struct {
+type BucketTransfer_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CouponCode_Description(v string) CouponCode_Description_Field {
-	return CouponCode_Description_Field{_set: true, _value: v}
+func BucketTransfer_BucketName(v []byte) BucketTransfer_BucketName_Field {
+	return BucketTransfer_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Description_Field) value() interface{} {
+func (f BucketTransfer_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Description_Field) _Column() string { return "description" }
+func (BucketTransfer_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CouponCode_Type_Field struct {
+type BucketTransfer_NewProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CouponCode_Type(v int) CouponCode_Type_Field {
-	return CouponCode_Type_Field{_set: true, _value: v}
+func BucketTransfer_NewProjectId(v []byte) BucketTransfer_NewProjectId_Field {
+	return BucketTransfer_NewProjectId_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Type_Field) value() interface{} {
+func (f BucketTransfer_NewProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Type_Field) _Column() string { return "type" }
+func (BucketTransfer_NewProjectId_Field) _Column() string { return "new_project_id" }
 
-type CouponCode_BillingPeriods_Field struct {
-	_set   bool
-	_null  bool
-	_value *int64
-}
-
-func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
-}
-
-func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
-	if v == nil {
-		return CouponCode_BillingPeriods_Null()
-	}
-	return CouponCode_BillingPeriods(*v)
-}
-
-func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
-}
-
-func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f CouponCode_BillingPeriods_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
-
-type CouponCode_CreatedAt_Field struct {
+type BucketTransfer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
-	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+func BucketTransfer_CreatedAt(v time.Time) BucketTransfer_CreatedAt_Field {
+	return BucketTransfer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_CreatedAt_Field) value() interface{} {
+func (f BucketTransfer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketTransfer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type CouponUsage struct {
-	CouponId []byte
-	Amount   int64
-	Status   int
-	Period   time.Time
+type CoinpaymentsTransaction struct {
+	Id              string
+	UserId          []byte
+	Address         string
+	AmountNumeric   int64
+	ReceivedNumeric int64
+	Status          int
+	Key             string
+	Timeout         int
+	CreatedAt       time.Time
 }
 
-func (CouponUsage) _Table() string { return "coupon_usages" }
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
 
-type CouponUsage_Update_Fields struct {
-	Status CouponUsage_Status_Field
+type CoinpaymentsTransaction_Update_Fields struct {
+	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
+	Status          CoinpaymentsTransaction_Status_Field
 }
 
-type CouponUsage_CouponId_Field struct {
+type CoinpaymentsTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
-	return CouponUsage_CouponId_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_CouponId_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
 
-type CouponUsage_Amount_Field struct {
+type CoinpaymentsTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
-	return CouponUsage_Amount_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Amount_Field) value() interface{} {
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type CouponUsage_Status_Field struct {
+type CoinpaymentsTransaction_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func CouponUsage_Status(v int) CouponUsage_Status_Field {
-	return CouponUsage_Status_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Status_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Status_Field) _Column() string { return "status" }
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
 
-type CouponUsage_Period_Field struct {
+type CoinpaymentsTransaction_AmountNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
-	return CouponUsage_Period_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
+	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Period_Field) value() interface{} {
+func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Period_Field) _Column() string { return "period" }
-
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
-}
-
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
-
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
-}
+func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
 
-type GracefulExitProgress_NodeId_Field struct {
+type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
+	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type CoinpaymentsTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type CoinpaymentsTransaction_Key_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type CoinpaymentsTransaction_Timeout_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type CoinpaymentsTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitSegmentTransfer struct {
-	NodeId              []byte
-	StreamId            []byte
-	Position            uint64
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
+type Coupon struct {
+	Id             []byte
+	UserId         []byte
+	Amount         int64
+	Description    string
+	Type           int
+	Status         int
+	Duration       int64
+	BillingPeriods *int64
+	CouponCodeName *string
+	CreatedAt      time.Time
 }
 
-func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+func (Coupon) _Table() string { return "coupons" }
 
-type GracefulExitSegmentTransfer_Create_Fields struct {
-	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type Coupon_Create_Fields struct {
+	BillingPeriods Coupon_BillingPeriods_Field
+	CouponCodeName Coupon_CouponCodeName_Field
 }
 
-type GracefulExitSegmentTransfer_Update_Fields struct {
-	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+type Coupon_Update_Fields struct {
+	Status Coupon_Status_Field
 }
 
-type GracefulExitSegmentTransfer_NodeId_Field struct {
+type Coupon_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
-	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+func Coupon_Id(v []byte) Coupon_Id_Field {
+	return Coupon_Id_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+func (f Coupon_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+func (Coupon_Id_Field) _Column() string { return "id" }
 
-type GracefulExitSegmentTransfer_StreamId_Field struct {
+type Coupon_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
-	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+func Coupon_UserId(v []byte) Coupon_UserId_Field {
+	return Coupon_UserId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+func (f Coupon_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+func (Coupon_UserId_Field) _Column() string { return "user_id" }
 
-type GracefulExitSegmentTransfer_Position_Field struct {
+type Coupon_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
-	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+func Coupon_Amount(v int64) Coupon_Amount_Field {
+	return Coupon_Amount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+func (f Coupon_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+func (Coupon_Amount_Field) _Column() string { return "amount" }
 
-type GracefulExitSegmentTransfer_PieceNum_Field struct {
+type Coupon_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
-	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+func Coupon_Description(v string) Coupon_Description_Field {
+	return Coupon_Description_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+func (f Coupon_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+func (Coupon_Description_Field) _Column() string { return "description" }
 
-type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+type Coupon_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
-}
-
-func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RootPieceId_Null()
-	}
-	return GracefulExitSegmentTransfer_RootPieceId(v)
-}
-
-func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+	_value int
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func Coupon_Type(v int) Coupon_Type_Field {
+	return Coupon_Type_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+func (f Coupon_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (Coupon_Type_Field) _Column() string { return "type" }
 
-type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+type Coupon_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int
 }
 
-func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
-	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+func Coupon_Status(v int) Coupon_Status_Field {
+	return Coupon_Status_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+func (f Coupon_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (Coupon_Status_Field) _Column() string { return "status" }
 
-type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+type Coupon_Duration_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
-	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+func Coupon_Duration(v int64) Coupon_Duration_Field {
+	return Coupon_Duration_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+func (f Coupon_Duration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+func (Coupon_Duration_Field) _Column() string { return "duration" }
 
-type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+type Coupon_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_RequestedAt_Null()
+		return Coupon_BillingPeriods_Null()
 	}
-	return GracefulExitSegmentTransfer_RequestedAt(*v)
+	return Coupon_BillingPeriods(*v)
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+func (f Coupon_BillingPeriods_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
 
-type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+type Coupon_CouponCodeName_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *string
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+		return Coupon_CouponCodeName_Null()
 	}
-	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+	return Coupon_CouponCodeName(*v)
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+func (f Coupon_CouponCodeName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
 
-type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+type Coupon_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
+	return Coupon_CreatedAt_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+func (f Coupon_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponCode struct {
+	Id             []byte
+	Name           string
+	Amount         int64
+	Description    string
+	Type           int
+	BillingPeriods *int64
+	CreatedAt      time.Time
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (CouponCode) _Table() string { return "coupon_codes" }
+
+type CouponCode_Create_Fields struct {
+	BillingPeriods CouponCode_BillingPeriods_Field
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+type CouponCode_Update_Fields struct {
+}
+
+type CouponCode_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CouponCode_Id(v []byte) CouponCode_Id_Field {
+	return CouponCode_Id_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (CouponCode_Id_Field) _Column() string { return "id" }
 
-type GracefulExitSegmentTransfer_FailedCount_Field struct {
+type CouponCode_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+func CouponCode_Name(v string) CouponCode_Name_Field {
+	return CouponCode_Name_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FailedCount_Null()
+func (f CouponCode_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FailedCount(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+func (CouponCode_Name_Field) _Column() string { return "name" }
+
+type CouponCode_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CouponCode_Amount(v int64) CouponCode_Amount_Field {
+	return CouponCode_Amount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+func (f CouponCode_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+func (CouponCode_Amount_Field) _Column() string { return "amount" }
 
-type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+type CouponCode_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+func CouponCode_Description(v string) CouponCode_Description_Field {
+	return CouponCode_Description_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_FinishedAt_Null()
+func (f CouponCode_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_FinishedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+func (CouponCode_Description_Field) _Column() string { return "description" }
+
+type CouponCode_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CouponCode_Type(v int) CouponCode_Type_Field {
+	return CouponCode_Type_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+func (f CouponCode_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+func (CouponCode_Type_Field) _Column() string { return "type" }
 
-type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+type CouponCode_BillingPeriods_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int64
 }
 
-func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
-	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
+	if v == nil {
+		return CouponCode_BillingPeriods_Null()
+	}
+	return CouponCode_BillingPeriods(*v)
+}
+
+func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
+}
+
+func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f CouponCode_BillingPeriods_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
+
+type CouponCode_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
+	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponUsage struct {
+	CouponId []byte
+	Amount   int64
+	Status   int
+	Period   time.Time
+}
+
+func (CouponUsage) _Table() string { return "coupon_usages" }
+
+type CouponUsage_Update_Fields struct {
+	Status CouponUsage_Status_Field
+}
+
+type CouponUsage_CouponId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
+	return CouponUsage_CouponId_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_CouponId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+
+type CouponUsage_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
+	return CouponUsage_Amount_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+
+type CouponUsage_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CouponUsage_Status(v int) CouponUsage_Status_Field {
+	return CouponUsage_Status_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Status_Field) _Column() string { return "status" }
+
+type CouponUsage_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
+	return CouponUsage_Period_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Period_Field) _Column() string { return "period" }
+
+type EmailSuppression struct {
+	ProjectId []byte
+	Email     string
+	CreatedAt time.Time
+}
+
+func (EmailSuppression) _Table() string { return "email_suppressions" }
+
+type EmailSuppression_Update_Fields struct {
+}
+
+type EmailSuppression_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func EmailSuppression_ProjectId(v []byte) EmailSuppression_ProjectId_Field {
+	return EmailSuppression_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f EmailSuppression_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailSuppression_ProjectId_Field) _Column() string { return "project_id" }
+
+type EmailSuppression_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func EmailSuppression_Email(v string) EmailSuppression_Email_Field {
+	return EmailSuppression_Email_Field{_set: true, _value: v}
+}
+
+func (f EmailSuppression_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailSuppression_Email_Field) _Column() string { return "email" }
+
+type EmailSuppression_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func EmailSuppression_CreatedAt(v time.Time) EmailSuppression_CreatedAt_Field {
+	return EmailSuppression_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f EmailSuppression_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EmailSuppression_CreatedAt_Field) _Column() string { return "created_at" }
+
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitSegmentTransfer struct {
+	NodeId              []byte
+	StreamId            []byte
+	Position            uint64
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
+}
+
+func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+
+type GracefulExitSegmentTransfer_Create_Fields struct {
+	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_Update_Fields struct {
+	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
+	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitSegmentTransfer_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
+	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+
+type GracefulExitSegmentTransfer_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
+	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+
+type GracefulExitSegmentTransfer_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
+	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RootPieceId_Null()
+	}
+	return GracefulExitSegmentTransfer_RootPieceId(v)
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+
+type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
+	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
+	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RequestedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_RequestedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitSegmentTransfer_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FailedCount_Null()
+	}
+	return GracefulExitSegmentTransfer_FailedCount(*v)
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+
+type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FinishedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_FinishedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
+	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
@@ -5599,6 +6447,7 @@ type Project struct {
 	UserAgent      []byte
 	OwnerId        []byte
 	CreatedAt      time.Time
+	DefaultRedundancyScheme *string
 }
 
 func (Project) _Table() string { return "projects" }
@@ -5612,6 +6461,7 @@ type Project_Create_Fields struct {
 	MaxBuckets     Project_MaxBuckets_Field
 	PartnerId      Project_PartnerId_Field
 	UserAgent      Project_UserAgent_Field
+	DefaultRedundancyScheme Project_DefaultRedundancyScheme_Field
 }
 
 type Project_Update_Fields struct {
@@ -5623,6 +6473,7 @@ type Project_Update_Fields struct {
 	RateLimit      Project_RateLimit_Field
 	BurstLimit     Project_BurstLimit_Field
 	MaxBuckets     Project_MaxBuckets_Field
+	DefaultRedundancyScheme Project_DefaultRedundancyScheme_Field
 }
 
 type Project_Id_Field struct {
@@ -5976,6 +6827,38 @@ func (f Project_CreatedAt_Field) value() interface{} {
 
 func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
+type Project_DefaultRedundancyScheme_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Project_DefaultRedundancyScheme(v string) Project_DefaultRedundancyScheme_Field {
+	return Project_DefaultRedundancyScheme_Field{_set: true, _value: &v}
+}
+
+func Project_DefaultRedundancyScheme_Raw(v *string) Project_DefaultRedundancyScheme_Field {
+	if v == nil {
+		return Project_DefaultRedundancyScheme_Null()
+	}
+	return Project_DefaultRedundancyScheme(*v)
+}
+
+func Project_DefaultRedundancyScheme_Null() Project_DefaultRedundancyScheme_Field {
+	return Project_DefaultRedundancyScheme_Field{_set: true, _null: true}
+}
+
+func (f Project_DefaultRedundancyScheme_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_DefaultRedundancyScheme_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_DefaultRedundancyScheme_Field) _Column() string { return "default_redundancy_scheme" }
+
 type ProjectBandwidthDailyRollup struct {
 	ProjectId       []byte
 	IntervalDay     time.Time
@@ -6476,701 +7359,1007 @@ type Reputation_Update_Fields struct {
 type Reputation_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value []byte
+}
+
+func Reputation_Id(v []byte) Reputation_Id_Field {
+	return Reputation_Id_Field{_set: true, _value: v}
+}
+
+func (f Reputation_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_Id_Field) _Column() string { return "id" }
+
+type Reputation_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
+	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Reputation_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
+	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Reputation_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Reputation_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _value: &v}
+}
+
+func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
+	if v == nil {
+		return Reputation_VettedAt_Null()
+	}
+	return Reputation_VettedAt(*v)
+}
+
+func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
+	return Reputation_VettedAt_Field{_set: true, _null: true}
+}
+
+func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Reputation_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Reputation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
+	return Reputation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Reputation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Reputation_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
+	return Reputation_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Reputation_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Reputation_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
+	if v == nil {
+		return Reputation_Disqualified_Null()
+	}
+	return Reputation_Disqualified(*v)
+}
+
+func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
+	return Reputation_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Reputation_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Reputation_DisqualificationReason_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
+}
+
+func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
+	if v == nil {
+		return Reputation_DisqualificationReason_Null()
+	}
+	return Reputation_DisqualificationReason(*v)
+}
+
+func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
+	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f Reputation_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Reputation_DisqualificationReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+
+type Reputation_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
+}
+
+func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Reputation_UnknownAuditSuspended_Null()
+	}
+	return Reputation_UnknownAuditSuspended(*v)
+}
+
+func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
+	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+
+type Reputation_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
 }
 
-func Reputation_Id(v []byte) Reputation_Id_Field {
-	return Reputation_Id_Field{_set: true, _value: v}
+func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
 }
 
-func (f Reputation_Id_Field) value() interface{} {
+func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
+	if v == nil {
+		return Reputation_OfflineSuspended_Null()
+	}
+	return Reputation_OfflineSuspended(*v)
+}
+
+func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
+	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f Reputation_OfflineSuspended_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Reputation_OfflineSuspended_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Id_Field) _Column() string { return "id" }
+func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
 
-type Reputation_AuditSuccessCount_Field struct {
+type Reputation_UnderReview_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func Reputation_AuditSuccessCount(v int64) Reputation_AuditSuccessCount_Field {
-	return Reputation_AuditSuccessCount_Field{_set: true, _value: v}
+func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _value: &v}
 }
 
-func (f Reputation_AuditSuccessCount_Field) value() interface{} {
+func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
+	if v == nil {
+		return Reputation_UnderReview_Null()
+	}
+	return Reputation_UnderReview(*v)
+}
+
+func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
+	return Reputation_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Reputation_UnderReview_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
 
-type Reputation_TotalAuditCount_Field struct {
+type Reputation_OnlineScore_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func Reputation_TotalAuditCount(v int64) Reputation_TotalAuditCount_Field {
-	return Reputation_TotalAuditCount_Field{_set: true, _value: v}
+func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
+	return Reputation_OnlineScore_Field{_set: true, _value: v}
 }
 
-func (f Reputation_TotalAuditCount_Field) value() interface{} {
+func (f Reputation_OnlineScore_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
 
-type Reputation_VettedAt_Field struct {
+type Reputation_AuditHistory_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Reputation_VettedAt(v time.Time) Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _value: &v}
+func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
+	return Reputation_AuditHistory_Field{_set: true, _value: v}
 }
 
-func Reputation_VettedAt_Raw(v *time.Time) Reputation_VettedAt_Field {
-	if v == nil {
-		return Reputation_VettedAt_Null()
+func (f Reputation_AuditHistory_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_VettedAt(*v)
+	return f._value
 }
 
-func Reputation_VettedAt_Null() Reputation_VettedAt_Field {
-	return Reputation_VettedAt_Field{_set: true, _null: true}
+func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
+
+type Reputation_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
 }
 
-func (f Reputation_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
+	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
+}
 
-func (f Reputation_VettedAt_Field) value() interface{} {
+func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_VettedAt_Field) _Column() string { return "vetted_at" }
+func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
 
-type Reputation_CreatedAt_Field struct {
+type Reputation_AuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func Reputation_CreatedAt(v time.Time) Reputation_CreatedAt_Field {
-	return Reputation_CreatedAt_Field{_set: true, _value: v}
+func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
+	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f Reputation_CreatedAt_Field) value() interface{} {
+func (f Reputation_AuditReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_CreatedAt_Field) _Column() string { return "created_at" }
+func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
 
-type Reputation_UpdatedAt_Field struct {
+type Reputation_UnknownAuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value float64
 }
 
-func Reputation_UpdatedAt(v time.Time) Reputation_UpdatedAt_Field {
-	return Reputation_UpdatedAt_Field{_set: true, _value: v}
+func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
+	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UpdatedAt_Field) value() interface{} {
+func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
+	return "unknown_audit_reputation_alpha"
+}
 
-type Reputation_Disqualified_Field struct {
+type Reputation_UnknownAuditReputationBeta_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value float64
 }
 
-func Reputation_Disqualified(v time.Time) Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _value: &v}
+func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
+	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
 }
 
-func Reputation_Disqualified_Raw(v *time.Time) Reputation_Disqualified_Field {
-	if v == nil {
-		return Reputation_Disqualified_Null()
+func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_Disqualified(*v)
+	return f._value
 }
 
-func Reputation_Disqualified_Null() Reputation_Disqualified_Field {
-	return Reputation_Disqualified_Field{_set: true, _null: true}
+func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
+	return "unknown_audit_reputation_beta"
 }
 
-func (f Reputation_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
+}
 
-func (f Reputation_Disqualified_Field) value() interface{} {
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
+}
+
+type ResetPasswordToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_Disqualified_Field) _Column() string { return "disqualified" }
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
 
-type Reputation_DisqualificationReason_Field struct {
+type ResetPasswordToken_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func Reputation_DisqualificationReason(v int) Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _value: &v}
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
 }
 
-func Reputation_DisqualificationReason_Raw(v *int) Reputation_DisqualificationReason_Field {
-	if v == nil {
-		return Reputation_DisqualificationReason_Null()
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Reputation_DisqualificationReason(*v)
+	return f._value
 }
 
-func Reputation_DisqualificationReason_Null() Reputation_DisqualificationReason_Field {
-	return Reputation_DisqualificationReason_Field{_set: true, _null: true}
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+
+type ResetPasswordToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Reputation_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Reputation_DisqualificationReason_Field) value() interface{} {
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Reputation_UnknownAuditSuspended_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type Revocation struct {
+	Revoked  []byte
+	ApiKeyId []byte
 }
 
-func Reputation_UnknownAuditSuspended(v time.Time) Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _value: &v}
-}
+func (Revocation) _Table() string { return "revocations" }
 
-func Reputation_UnknownAuditSuspended_Raw(v *time.Time) Reputation_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Reputation_UnknownAuditSuspended_Null()
-	}
-	return Reputation_UnknownAuditSuspended(*v)
+type Revocation_Update_Fields struct {
 }
 
-func Reputation_UnknownAuditSuspended_Null() Reputation_UnknownAuditSuspended_Field {
-	return Reputation_UnknownAuditSuspended_Field{_set: true, _null: true}
+type Revocation_Revoked_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
+	return Revocation_Revoked_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditSuspended_Field) value() interface{} {
+func (f Revocation_Revoked_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (Revocation_Revoked_Field) _Column() string { return "revoked" }
 
-type Reputation_OfflineSuspended_Field struct {
+type Revocation_ApiKeyId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func Reputation_OfflineSuspended(v time.Time) Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _value: &v}
+func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
+	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+}
+
+func (f Revocation_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type SegmentPendingAudits struct {
+	NodeId            []byte
+	StreamId          []byte
+	Position          uint64
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
 }
 
-func Reputation_OfflineSuspended_Raw(v *time.Time) Reputation_OfflineSuspended_Field {
-	if v == nil {
-		return Reputation_OfflineSuspended_Null()
-	}
-	return Reputation_OfflineSuspended(*v)
+func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
+
+type SegmentPendingAudits_Update_Fields struct {
+	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
 }
 
-func Reputation_OfflineSuspended_Null() Reputation_OfflineSuspended_Field {
-	return Reputation_OfflineSuspended_Field{_set: true, _null: true}
+type SegmentPendingAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Reputation_OfflineSuspended_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
+	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OfflineSuspended_Field) value() interface{} {
+func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
 
-type Reputation_UnderReview_Field struct {
+type SegmentPendingAudits_StreamId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Reputation_UnderReview(v time.Time) Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _value: &v}
-}
-
-func Reputation_UnderReview_Raw(v *time.Time) Reputation_UnderReview_Field {
-	if v == nil {
-		return Reputation_UnderReview_Null()
-	}
-	return Reputation_UnderReview(*v)
+	_value []byte
 }
 
-func Reputation_UnderReview_Null() Reputation_UnderReview_Field {
-	return Reputation_UnderReview_Field{_set: true, _null: true}
+func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
+	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Reputation_UnderReview_Field) value() interface{} {
+func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnderReview_Field) _Column() string { return "under_review" }
+func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
 
-type Reputation_OnlineScore_Field struct {
+type SegmentPendingAudits_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value uint64
 }
 
-func Reputation_OnlineScore(v float64) Reputation_OnlineScore_Field {
-	return Reputation_OnlineScore_Field{_set: true, _value: v}
+func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
+	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
 }
 
-func (f Reputation_OnlineScore_Field) value() interface{} {
+func (f SegmentPendingAudits_Position_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_OnlineScore_Field) _Column() string { return "online_score" }
+func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
 
-type Reputation_AuditHistory_Field struct {
+type SegmentPendingAudits_PieceId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Reputation_AuditHistory(v []byte) Reputation_AuditHistory_Field {
-	return Reputation_AuditHistory_Field{_set: true, _value: v}
+func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
+	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditHistory_Field) value() interface{} {
+func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditHistory_Field) _Column() string { return "audit_history" }
+func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
 
-type Reputation_AuditReputationAlpha_Field struct {
+type SegmentPendingAudits_StripeIndex_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Reputation_AuditReputationAlpha(v float64) Reputation_AuditReputationAlpha_Field {
-	return Reputation_AuditReputationAlpha_Field{_set: true, _value: v}
+func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
+	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationAlpha_Field) value() interface{} {
+func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
 
-type Reputation_AuditReputationBeta_Field struct {
+type SegmentPendingAudits_ShareSize_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Reputation_AuditReputationBeta(v float64) Reputation_AuditReputationBeta_Field {
-	return Reputation_AuditReputationBeta_Field{_set: true, _value: v}
+func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
+	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
 }
 
-func (f Reputation_AuditReputationBeta_Field) value() interface{} {
+func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
 
-type Reputation_UnknownAuditReputationAlpha_Field struct {
+type SegmentPendingAudits_ExpectedShareHash_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Reputation_UnknownAuditReputationAlpha(v float64) Reputation_UnknownAuditReputationAlpha_Field {
-	return Reputation_UnknownAuditReputationAlpha_Field{_set: true, _value: v}
+func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
+	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationAlpha_Field) value() interface{} {
+func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationAlpha_Field) _Column() string {
-	return "unknown_audit_reputation_alpha"
-}
+func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
 
-type Reputation_UnknownAuditReputationBeta_Field struct {
+type SegmentPendingAudits_ReverifyCount_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Reputation_UnknownAuditReputationBeta(v float64) Reputation_UnknownAuditReputationBeta_Field {
-	return Reputation_UnknownAuditReputationBeta_Field{_set: true, _value: v}
+func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
+	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
 }
 
-func (f Reputation_UnknownAuditReputationBeta_Field) value() interface{} {
+func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Reputation_UnknownAuditReputationBeta_Field) _Column() string {
-	return "unknown_audit_reputation_beta"
-}
+func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
-type ResetPasswordToken struct {
-	Secret    []byte
-	OwnerId   []byte
-	CreatedAt time.Time
+type StoragenodeBandwidthRollup struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
 
-type ResetPasswordToken_Update_Fields struct {
-	OwnerId ResetPasswordToken_OwnerId_Field
+type StoragenodeBandwidthRollup_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
 }
 
-type ResetPasswordToken_Secret_Field struct {
+type StoragenodeBandwidthRollup_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollup_Allocated_Field
+	Settled   StoragenodeBandwidthRollup_Settled_Field
+}
+
+type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
-	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
+	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_Secret_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type ResetPasswordToken_OwnerId_Field struct {
+type StoragenodeBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
-	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
+	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type ResetPasswordToken_CreatedAt_Field struct {
+type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint
 }
 
-func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
-	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
+type StoragenodeBandwidthRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value uint
 }
 
-func (Revocation) _Table() string { return "revocations" }
+func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
+	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+}
 
-type Revocation_Update_Fields struct {
+func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Revocation_Revoked_Field struct {
+func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+
+type StoragenodeBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *uint64
 }
 
-func Revocation_Revoked(v []byte) Revocation_Revoked_Field {
-	return Revocation_Revoked_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f Revocation_Revoked_Field) value() interface{} {
+func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollup_Allocated_Null()
+	}
+	return StoragenodeBandwidthRollup_Allocated(*v)
+}
+
+func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
+	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+}
+
+func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_Revoked_Field) _Column() string { return "revoked" }
+func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type Revocation_ApiKeyId_Field struct {
+type StoragenodeBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func Revocation_ApiKeyId(v []byte) Revocation_ApiKeyId_Field {
-	return Revocation_ApiKeyId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
+	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f Revocation_ApiKeyId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
-
-type SegmentPendingAudits struct {
-	NodeId            []byte
-	StreamId          []byte
-	Position          uint64
-	PieceId           []byte
-	StripeIndex       int64
-	ShareSize         int64
-	ExpectedShareHash []byte
-	ReverifyCount     int64
-}
-
-func (SegmentPendingAudits) _Table() string { return "segment_pending_audits" }
+func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type SegmentPendingAudits_Update_Fields struct {
-	ReverifyCount SegmentPendingAudits_ReverifyCount_Field
+type StoragenodeBandwidthRollupArchive struct {
+	StoragenodeId   []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Allocated       *uint64
+	Settled         uint64
 }
 
-type SegmentPendingAudits_NodeId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func (StoragenodeBandwidthRollupArchive) _Table() string {
+	return "storagenode_bandwidth_rollup_archives"
 }
 
-func SegmentPendingAudits_NodeId(v []byte) SegmentPendingAudits_NodeId_Field {
-	return SegmentPendingAudits_NodeId_Field{_set: true, _value: v}
+type StoragenodeBandwidthRollupArchive_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
 }
 
-func (f SegmentPendingAudits_NodeId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StoragenodeBandwidthRollupArchive_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
 }
 
-func (SegmentPendingAudits_NodeId_Field) _Column() string { return "node_id" }
-
-type SegmentPendingAudits_StreamId_Field struct {
+type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func SegmentPendingAudits_StreamId(v []byte) SegmentPendingAudits_StreamId_Field {
-	return SegmentPendingAudits_StreamId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StreamId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StreamId_Field) _Column() string { return "stream_id" }
+func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
+	return "storagenode_id"
+}
 
-type SegmentPendingAudits_Position_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func SegmentPendingAudits_Position(v uint64) SegmentPendingAudits_Position_Field {
-	return SegmentPendingAudits_Position_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_Position_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_Position_Field) _Column() string { return "position" }
+func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
+	return "interval_start"
+}
 
-type SegmentPendingAudits_PieceId_Field struct {
+type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func SegmentPendingAudits_PieceId(v []byte) SegmentPendingAudits_PieceId_Field {
-	return SegmentPendingAudits_PieceId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_PieceId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type SegmentPendingAudits_StripeIndex_Field struct {
+type StoragenodeBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint
 }
 
-func SegmentPendingAudits_StripeIndex(v int64) SegmentPendingAudits_StripeIndex_Field {
-	return SegmentPendingAudits_StripeIndex_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
+	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_StripeIndex_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type SegmentPendingAudits_ShareSize_Field struct {
+type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *uint64
 }
 
-func SegmentPendingAudits_ShareSize(v int64) SegmentPendingAudits_ShareSize_Field {
-	return SegmentPendingAudits_ShareSize_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
 }
 
-func (f SegmentPendingAudits_ShareSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+	if v == nil {
+		return StoragenodeBandwidthRollupArchive_Allocated_Null()
 	}
-	return f._value
+	return StoragenodeBandwidthRollupArchive_Allocated(*v)
 }
 
-func (SegmentPendingAudits_ShareSize_Field) _Column() string { return "share_size" }
-
-type SegmentPendingAudits_ExpectedShareHash_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
+	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
 }
 
-func SegmentPendingAudits_ExpectedShareHash(v []byte) SegmentPendingAudits_ExpectedShareHash_Field {
-	return SegmentPendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f SegmentPendingAudits_ExpectedShareHash_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type SegmentPendingAudits_ReverifyCount_Field struct {
+type StoragenodeBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func SegmentPendingAudits_ReverifyCount(v int64) SegmentPendingAudits_ReverifyCount_Field {
-	return SegmentPendingAudits_ReverifyCount_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
+	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f SegmentPendingAudits_ReverifyCount_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (SegmentPendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type StoragenodeBandwidthRollup struct {
+type StoragenodeBandwidthRollupPhase2 struct {
 	StoragenodeId   []byte
 	IntervalStart   time.Time
 	IntervalSeconds uint
@@ -7179,3326 +8368,3550 @@ type StoragenodeBandwidthRollup struct {
 	Settled         uint64
 }
 
-func (StoragenodeBandwidthRollup) _Table() string { return "storagenode_bandwidth_rollups" }
+func (StoragenodeBandwidthRollupPhase2) _Table() string {
+	return "storagenode_bandwidth_rollups_phase2"
+}
 
-type StoragenodeBandwidthRollup_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
+type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
 }
 
-type StoragenodeBandwidthRollup_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollup_Allocated_Field
-	Settled   StoragenodeBandwidthRollup_Settled_Field
+type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
+	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
 }
 
-type StoragenodeBandwidthRollup_StoragenodeId_Field struct {
+type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeBandwidthRollup_StoragenodeId(v []byte) StoragenodeBandwidthRollup_StoragenodeId_Field {
-	return StoragenodeBandwidthRollup_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
+	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_StoragenodeId_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
 
-type StoragenodeBandwidthRollup_IntervalStart_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StoragenodeBandwidthRollup_IntervalStart(v time.Time) StoragenodeBandwidthRollup_IntervalStart_Field {
-	return StoragenodeBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type StoragenodeBandwidthRollup_IntervalSeconds_Field struct {
+type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
 	_value uint
 }
 
-func StoragenodeBandwidthRollup_IntervalSeconds(v uint) StoragenodeBandwidthRollup_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
+	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
+	return "interval_seconds"
+}
 
-type StoragenodeBandwidthRollup_Action_Field struct {
+type StoragenodeBandwidthRollupPhase2_Action_Field struct {
 	_set   bool
 	_null  bool
 	_value uint
 }
 
-func StoragenodeBandwidthRollup_Action(v uint) StoragenodeBandwidthRollup_Action_Field {
-	return StoragenodeBandwidthRollup_Action_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
+	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Action_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
 
-type StoragenodeBandwidthRollup_Allocated_Field struct {
+type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
 	_set   bool
 	_null  bool
 	_value *uint64
 }
 
-func StoragenodeBandwidthRollup_Allocated(v uint64) StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _value: &v}
+func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
 }
 
-func StoragenodeBandwidthRollup_Allocated_Raw(v *uint64) StoragenodeBandwidthRollup_Allocated_Field {
+func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
 	if v == nil {
-		return StoragenodeBandwidthRollup_Allocated_Null()
+		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
 	}
-	return StoragenodeBandwidthRollup_Allocated(*v)
+	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
 }
 
-func StoragenodeBandwidthRollup_Allocated_Null() StoragenodeBandwidthRollup_Allocated_Field {
-	return StoragenodeBandwidthRollup_Allocated_Field{_set: true, _null: true}
+func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
+	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) isnull() bool {
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f StoragenodeBandwidthRollup_Allocated_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
 
-type StoragenodeBandwidthRollup_Settled_Field struct {
+type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func StoragenodeBandwidthRollup_Settled(v uint64) StoragenodeBandwidthRollup_Settled_Field {
-	return StoragenodeBandwidthRollup_Settled_Field{_set: true, _value: v}
+func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
+	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollup_Settled_Field) value() interface{} {
+func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollup_Settled_Field) _Column() string { return "settled" }
+func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
 
-type StoragenodeBandwidthRollupArchive struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodePayment struct {
+	Id        int64
+	CreatedAt time.Time
+	NodeId    []byte
+	Period    string
+	Amount    int64
+	Receipt   *string
+	Notes     *string
+}
+
+func (StoragenodePayment) _Table() string { return "storagenode_payments" }
+
+type StoragenodePayment_Create_Fields struct {
+	Receipt StoragenodePayment_Receipt_Field
+	Notes   StoragenodePayment_Notes_Field
+}
+
+type StoragenodePayment_Update_Fields struct {
+}
+
+type StoragenodePayment_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
+	return StoragenodePayment_Id_Field{_set: true, _value: v}
+}
+
+func (f StoragenodePayment_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodePayment_Id_Field) _Column() string { return "id" }
+
+type StoragenodePayment_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (StoragenodeBandwidthRollupArchive) _Table() string {
-	return "storagenode_bandwidth_rollup_archives"
+func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
+	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollupArchive_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
+func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollupArchive_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupArchive_Allocated_Field
-	Settled   StoragenodeBandwidthRollupArchive_Settled_Field
-}
+func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollupArchive_StoragenodeId_Field struct {
+type StoragenodePayment_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StoragenodeBandwidthRollupArchive_StoragenodeId(v []byte) StoragenodeBandwidthRollupArchive_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupArchive_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
+	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_StoragenodeId_Field) value() interface{} {
+func (f StoragenodePayment_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_StoragenodeId_Field) _Column() string {
-	return "storagenode_id"
-}
+func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupArchive_IntervalStart_Field struct {
+type StoragenodePayment_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalStart(v time.Time) StoragenodeBandwidthRollupArchive_IntervalStart_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
+	return StoragenodePayment_Period_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f StoragenodePayment_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalStart_Field) _Column() string {
-	return "interval_start"
-}
+func (StoragenodePayment_Period_Field) _Column() string { return "period" }
 
-type StoragenodeBandwidthRollupArchive_IntervalSeconds_Field struct {
+type StoragenodePayment_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func StoragenodeBandwidthRollupArchive_IntervalSeconds(v uint) StoragenodeBandwidthRollupArchive_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
+	return StoragenodePayment_Amount_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodePayment_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
 
-type StoragenodeBandwidthRollupArchive_Action_Field struct {
+type StoragenodePayment_Receipt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value *string
 }
 
-func StoragenodeBandwidthRollupArchive_Action(v uint) StoragenodeBandwidthRollupArchive_Action_Field {
-	return StoragenodeBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Action_Field) value() interface{} {
+func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
+	if v == nil {
+		return StoragenodePayment_Receipt_Null()
+	}
+	return StoragenodePayment_Receipt(*v)
+}
+
+func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
+	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+}
+
+func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f StoragenodePayment_Receipt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
 
-type StoragenodeBandwidthRollupArchive_Allocated_Field struct {
+type StoragenodePayment_Notes_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value *string
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated(v uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _value: &v}
+func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupArchive_Allocated_Field {
+func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
 	if v == nil {
-		return StoragenodeBandwidthRollupArchive_Allocated_Null()
+		return StoragenodePayment_Notes_Null()
 	}
-	return StoragenodeBandwidthRollupArchive_Allocated(*v)
+	return StoragenodePayment_Notes(*v)
 }
 
-func StoragenodeBandwidthRollupArchive_Allocated_Null() StoragenodeBandwidthRollupArchive_Allocated_Field {
-	return StoragenodeBandwidthRollupArchive_Allocated_Field{_set: true, _null: true}
+func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
+	return StoragenodePayment_Notes_Field{_set: true, _null: true}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StoragenodeBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f StoragenodePayment_Notes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
 
-type StoragenodeBandwidthRollupArchive_Settled_Field struct {
+type StoragenodePaystub struct {
+	Period         string
+	NodeId         []byte
+	CreatedAt      time.Time
+	Codes          string
+	UsageAtRest    float64
+	UsageGet       int64
+	UsagePut       int64
+	UsageGetRepair int64
+	UsagePutRepair int64
+	UsageGetAudit  int64
+	CompAtRest     int64
+	CompGet        int64
+	CompPut        int64
+	CompGetRepair  int64
+	CompPutRepair  int64
+	CompGetAudit   int64
+	SurgePercent   int64
+	Held           int64
+	Owed           int64
+	Disposed       int64
+	Paid           int64
+	Distributed    int64
+}
+
+func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
+
+type StoragenodePaystub_Update_Fields struct {
+}
+
+type StoragenodePaystub_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value string
 }
 
-func StoragenodeBandwidthRollupArchive_Settled(v uint64) StoragenodeBandwidthRollupArchive_Settled_Field {
-	return StoragenodeBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
+	return StoragenodePaystub_Period_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f StoragenodePaystub_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
 
-type StoragenodeBandwidthRollupPhase2 struct {
-	StoragenodeId   []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Allocated       *uint64
-	Settled         uint64
+type StoragenodePaystub_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (StoragenodeBandwidthRollupPhase2) _Table() string {
-	return "storagenode_bandwidth_rollups_phase2"
+func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
+	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
 }
 
-type StoragenodeBandwidthRollupPhase2_Create_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
+func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StoragenodeBandwidthRollupPhase2_Update_Fields struct {
-	Allocated StoragenodeBandwidthRollupPhase2_Allocated_Field
-	Settled   StoragenodeBandwidthRollupPhase2_Settled_Field
-}
+func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodeBandwidthRollupPhase2_StoragenodeId_Field struct {
+type StoragenodePaystub_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StoragenodeBandwidthRollupPhase2_StoragenodeId(v []byte) StoragenodeBandwidthRollupPhase2_StoragenodeId_Field {
-	return StoragenodeBandwidthRollupPhase2_StoragenodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
+	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) value() interface{} {
+func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalStart_Field struct {
+type StoragenodePaystub_Codes_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalStart(v time.Time) StoragenodeBandwidthRollupPhase2_IntervalStart_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalStart_Field{_set: true, _value: v}
+func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
+	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalStart_Field) value() interface{} {
+func (f StoragenodePaystub_Codes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalStart_Field) _Column() string { return "interval_start" }
+func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
 
-type StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field struct {
+type StoragenodePaystub_UsageAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value float64
 }
 
-func StoragenodeBandwidthRollupPhase2_IntervalSeconds(v uint) StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field {
-	return StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
+	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) value() interface{} {
+func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_IntervalSeconds_Field) _Column() string {
-	return "interval_seconds"
-}
+func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
 
-type StoragenodeBandwidthRollupPhase2_Action_Field struct {
+type StoragenodePaystub_UsageGet_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Action(v uint) StoragenodeBandwidthRollupPhase2_Action_Field {
-	return StoragenodeBandwidthRollupPhase2_Action_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
+	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Action_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Action_Field) _Column() string { return "action" }
+func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
 
-type StoragenodeBandwidthRollupPhase2_Allocated_Field struct {
+type StoragenodePaystub_UsagePut_Field struct {
 	_set   bool
 	_null  bool
-	_value *uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated(v uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _value: &v}
+func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
+	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Raw(v *uint64) StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	if v == nil {
-		return StoragenodeBandwidthRollupPhase2_Allocated_Null()
+func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodeBandwidthRollupPhase2_Allocated(*v)
+	return f._value
 }
 
-func StoragenodeBandwidthRollupPhase2_Allocated_Null() StoragenodeBandwidthRollupPhase2_Allocated_Field {
-	return StoragenodeBandwidthRollupPhase2_Allocated_Field{_set: true, _null: true}
+func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+
+type StoragenodePaystub_UsageGetRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
+	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Allocated_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Allocated_Field) _Column() string { return "allocated" }
+func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
 
-type StoragenodeBandwidthRollupPhase2_Settled_Field struct {
+type StoragenodePaystub_UsagePutRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func StoragenodeBandwidthRollupPhase2_Settled(v uint64) StoragenodeBandwidthRollupPhase2_Settled_Field {
-	return StoragenodeBandwidthRollupPhase2_Settled_Field{_set: true, _value: v}
+func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
+	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeBandwidthRollupPhase2_Settled_Field) value() interface{} {
+func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeBandwidthRollupPhase2_Settled_Field) _Column() string { return "settled" }
-
-type StoragenodePayment struct {
-	Id        int64
-	CreatedAt time.Time
-	NodeId    []byte
-	Period    string
-	Amount    int64
-	Receipt   *string
-	Notes     *string
-}
-
-func (StoragenodePayment) _Table() string { return "storagenode_payments" }
-
-type StoragenodePayment_Create_Fields struct {
-	Receipt StoragenodePayment_Receipt_Field
-	Notes   StoragenodePayment_Notes_Field
-}
-
-type StoragenodePayment_Update_Fields struct {
-}
+func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
 
-type StoragenodePayment_Id_Field struct {
+type StoragenodePaystub_UsageGetAudit_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePayment_Id(v int64) StoragenodePayment_Id_Field {
-	return StoragenodePayment_Id_Field{_set: true, _value: v}
+func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
+	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Id_Field) value() interface{} {
+func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Id_Field) _Column() string { return "id" }
+func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
 
-type StoragenodePayment_CreatedAt_Field struct {
+type StoragenodePaystub_CompAtRest_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StoragenodePayment_CreatedAt(v time.Time) StoragenodePayment_CreatedAt_Field {
-	return StoragenodePayment_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
+	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
 
-type StoragenodePayment_NodeId_Field struct {
+type StoragenodePaystub_CompGet_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodePayment_NodeId(v []byte) StoragenodePayment_NodeId_Field {
-	return StoragenodePayment_NodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
+	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_NodeId_Field) value() interface{} {
+func (f StoragenodePaystub_CompGet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
 
-type StoragenodePayment_Period_Field struct {
+type StoragenodePaystub_CompPut_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StoragenodePayment_Period(v string) StoragenodePayment_Period_Field {
-	return StoragenodePayment_Period_Field{_set: true, _value: v}
+func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
+	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Period_Field) value() interface{} {
+func (f StoragenodePaystub_CompPut_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Period_Field) _Column() string { return "period" }
+func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
 
-type StoragenodePayment_Amount_Field struct {
+type StoragenodePaystub_CompGetRepair_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePayment_Amount(v int64) StoragenodePayment_Amount_Field {
-	return StoragenodePayment_Amount_Field{_set: true, _value: v}
+func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
+	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePayment_Amount_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Amount_Field) _Column() string { return "amount" }
+func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
 
-type StoragenodePayment_Receipt_Field struct {
+type StoragenodePaystub_CompPutRepair_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int64
 }
 
-func StoragenodePayment_Receipt(v string) StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _value: &v}
+func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
+	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
 }
 
-func StoragenodePayment_Receipt_Raw(v *string) StoragenodePayment_Receipt_Field {
-	if v == nil {
-		return StoragenodePayment_Receipt_Null()
+func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodePayment_Receipt(*v)
+	return f._value
 }
 
-func StoragenodePayment_Receipt_Null() StoragenodePayment_Receipt_Field {
-	return StoragenodePayment_Receipt_Field{_set: true, _null: true}
+func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+
+type StoragenodePaystub_CompGetAudit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f StoragenodePayment_Receipt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
+	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+}
 
-func (f StoragenodePayment_Receipt_Field) value() interface{} {
+func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Receipt_Field) _Column() string { return "receipt" }
+func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
 
-type StoragenodePayment_Notes_Field struct {
+type StoragenodePaystub_SurgePercent_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int64
 }
 
-func StoragenodePayment_Notes(v string) StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _value: &v}
+func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
+	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
 }
 
-func StoragenodePayment_Notes_Raw(v *string) StoragenodePayment_Notes_Field {
-	if v == nil {
-		return StoragenodePayment_Notes_Null()
+func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return StoragenodePayment_Notes(*v)
+	return f._value
 }
 
-func StoragenodePayment_Notes_Null() StoragenodePayment_Notes_Field {
-	return StoragenodePayment_Notes_Field{_set: true, _null: true}
+func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
+
+type StoragenodePaystub_Held_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f StoragenodePayment_Notes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
+	return StoragenodePaystub_Held_Field{_set: true, _value: v}
+}
 
-func (f StoragenodePayment_Notes_Field) value() interface{} {
+func (f StoragenodePaystub_Held_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePayment_Notes_Field) _Column() string { return "notes" }
-
-type StoragenodePaystub struct {
-	Period         string
-	NodeId         []byte
-	CreatedAt      time.Time
-	Codes          string
-	UsageAtRest    float64
-	UsageGet       int64
-	UsagePut       int64
-	UsageGetRepair int64
-	UsagePutRepair int64
-	UsageGetAudit  int64
-	CompAtRest     int64
-	CompGet        int64
-	CompPut        int64
-	CompGetRepair  int64
-	CompPutRepair  int64
-	CompGetAudit   int64
-	SurgePercent   int64
-	Held           int64
-	Owed           int64
-	Disposed       int64
-	Paid           int64
-	Distributed    int64
-}
-
-func (StoragenodePaystub) _Table() string { return "storagenode_paystubs" }
-
-type StoragenodePaystub_Update_Fields struct {
-}
+func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
 
-type StoragenodePaystub_Period_Field struct {
+type StoragenodePaystub_Owed_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StoragenodePaystub_Period(v string) StoragenodePaystub_Period_Field {
-	return StoragenodePaystub_Period_Field{_set: true, _value: v}
+func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
+	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Period_Field) value() interface{} {
+func (f StoragenodePaystub_Owed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Period_Field) _Column() string { return "period" }
+func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
 
-type StoragenodePaystub_NodeId_Field struct {
+type StoragenodePaystub_Disposed_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func StoragenodePaystub_NodeId(v []byte) StoragenodePaystub_NodeId_Field {
-	return StoragenodePaystub_NodeId_Field{_set: true, _value: v}
+func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
+	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_NodeId_Field) value() interface{} {
+func (f StoragenodePaystub_Disposed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_NodeId_Field) _Column() string { return "node_id" }
+func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
 
-type StoragenodePaystub_CreatedAt_Field struct {
+type StoragenodePaystub_Paid_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StoragenodePaystub_CreatedAt(v time.Time) StoragenodePaystub_CreatedAt_Field {
-	return StoragenodePaystub_CreatedAt_Field{_set: true, _value: v}
+func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
+	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CreatedAt_Field) value() interface{} {
+func (f StoragenodePaystub_Paid_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CreatedAt_Field) _Column() string { return "created_at" }
+func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
 
-type StoragenodePaystub_Codes_Field struct {
+type StoragenodePaystub_Distributed_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StoragenodePaystub_Codes(v string) StoragenodePaystub_Codes_Field {
-	return StoragenodePaystub_Codes_Field{_set: true, _value: v}
+func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
+	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Codes_Field) value() interface{} {
+func (f StoragenodePaystub_Distributed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Codes_Field) _Column() string { return "codes" }
+func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
 
-type StoragenodePaystub_UsageAtRest_Field struct {
+type StoragenodeStorageTally struct {
+	NodeId          []byte
+	IntervalEndTime time.Time
+	DataTotal       float64
+}
+
+func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
+
+type StoragenodeStorageTally_Update_Fields struct {
+}
+
+type StoragenodeStorageTally_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StoragenodePaystub_UsageAtRest(v float64) StoragenodePaystub_UsageAtRest_Field {
-	return StoragenodePaystub_UsageAtRest_Field{_set: true, _value: v}
+func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
+	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageAtRest_Field) value() interface{} {
+func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageAtRest_Field) _Column() string { return "usage_at_rest" }
+func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
 
-type StoragenodePaystub_UsageGet_Field struct {
+type StoragenodeStorageTally_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_UsageGet(v int64) StoragenodePaystub_UsageGet_Field {
-	return StoragenodePaystub_UsageGet_Field{_set: true, _value: v}
+func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
+	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGet_Field) value() interface{} {
+func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGet_Field) _Column() string { return "usage_get" }
+func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type StoragenodePaystub_UsagePut_Field struct {
+type StoragenodeStorageTally_DataTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func StoragenodePaystub_UsagePut(v int64) StoragenodePaystub_UsagePut_Field {
-	return StoragenodePaystub_UsagePut_Field{_set: true, _value: v}
+func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
+	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePut_Field) value() interface{} {
+func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePut_Field) _Column() string { return "usage_put" }
+func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
 
-type StoragenodePaystub_UsageGetRepair_Field struct {
+type StorjscanWallet struct {
+	UserId        []byte
+	WalletAddress []byte
+	CreatedAt     time.Time
+}
+
+func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
+
+type StorjscanWallet_Update_Fields struct {
+}
+
+type StorjscanWallet_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsageGetRepair(v int64) StoragenodePaystub_UsageGetRepair_Field {
-	return StoragenodePaystub_UsageGetRepair_Field{_set: true, _value: v}
+func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
+	return StorjscanWallet_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetRepair_Field) value() interface{} {
+func (f StorjscanWallet_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetRepair_Field) _Column() string { return "usage_get_repair" }
+func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_UsagePutRepair_Field struct {
+type StorjscanWallet_WalletAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_UsagePutRepair(v int64) StoragenodePaystub_UsagePutRepair_Field {
-	return StoragenodePaystub_UsagePutRepair_Field{_set: true, _value: v}
+func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
+	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsagePutRepair_Field) value() interface{} {
+func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsagePutRepair_Field) _Column() string { return "usage_put_repair" }
+func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
 
-type StoragenodePaystub_UsageGetAudit_Field struct {
+type StorjscanWallet_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_UsageGetAudit(v int64) StoragenodePaystub_UsageGetAudit_Field {
-	return StoragenodePaystub_UsageGetAudit_Field{_set: true, _value: v}
+func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
+	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_UsageGetAudit_Field) value() interface{} {
+func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_UsageGetAudit_Field) _Column() string { return "usage_get_audit" }
+func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_CompAtRest_Field struct {
+type StripeCustomer struct {
+	UserId     []byte
+	CustomerId string
+	CreatedAt  time.Time
+}
+
+func (StripeCustomer) _Table() string { return "stripe_customers" }
+
+type StripeCustomer_Update_Fields struct {
+}
+
+type StripeCustomer_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_CompAtRest(v int64) StoragenodePaystub_CompAtRest_Field {
-	return StoragenodePaystub_CompAtRest_Field{_set: true, _value: v}
+func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
+	return StripeCustomer_UserId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompAtRest_Field) value() interface{} {
+func (f StripeCustomer_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompAtRest_Field) _Column() string { return "comp_at_rest" }
+func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
 
-type StoragenodePaystub_CompGet_Field struct {
+type StripeCustomer_CustomerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func StoragenodePaystub_CompGet(v int64) StoragenodePaystub_CompGet_Field {
-	return StoragenodePaystub_CompGet_Field{_set: true, _value: v}
+func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
+	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGet_Field) value() interface{} {
+func (f StripeCustomer_CustomerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGet_Field) _Column() string { return "comp_get" }
+func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
 
-type StoragenodePaystub_CompPut_Field struct {
+type StripeCustomer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_CompPut(v int64) StoragenodePaystub_CompPut_Field {
-	return StoragenodePaystub_CompPut_Field{_set: true, _value: v}
+func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
+	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPut_Field) value() interface{} {
+func (f StripeCustomer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPut_Field) _Column() string { return "comp_put" }
+func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StoragenodePaystub_CompGetRepair_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+type StripecoinpaymentsInvoiceProjectRecord struct {
+	Id          []byte
+	ProjectId   []byte
+	Storage     float64
+	Egress      int64
+	Objects     *int64
+	Segments    *int64
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	State       int
+	CreatedAt   time.Time
 }
 
-func StoragenodePaystub_CompGetRepair(v int64) StoragenodePaystub_CompGetRepair_Field {
-	return StoragenodePaystub_CompGetRepair_Field{_set: true, _value: v}
+func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
+	return "stripecoinpayments_invoice_project_records"
 }
 
-func (f StoragenodePaystub_CompGetRepair_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
+	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
+	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
 }
 
-func (StoragenodePaystub_CompGetRepair_Field) _Column() string { return "comp_get_repair" }
+type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
+	State StripecoinpaymentsInvoiceProjectRecord_State_Field
+}
 
-type StoragenodePaystub_CompPutRepair_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_CompPutRepair(v int64) StoragenodePaystub_CompPutRepair_Field {
-	return StoragenodePaystub_CompPutRepair_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompPutRepair_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompPutRepair_Field) _Column() string { return "comp_put_repair" }
+func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
 
-type StoragenodePaystub_CompGetAudit_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func StoragenodePaystub_CompGetAudit(v int64) StoragenodePaystub_CompGetAudit_Field {
-	return StoragenodePaystub_CompGetAudit_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_CompGetAudit_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_CompGetAudit_Field) _Column() string { return "comp_get_audit" }
+func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
 
-type StoragenodePaystub_SurgePercent_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value float64
 }
 
-func StoragenodePaystub_SurgePercent(v int64) StoragenodePaystub_SurgePercent_Field {
-	return StoragenodePaystub_SurgePercent_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_SurgePercent_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_SurgePercent_Field) _Column() string { return "surge_percent" }
+func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
 
-type StoragenodePaystub_Held_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func StoragenodePaystub_Held(v int64) StoragenodePaystub_Held_Field {
-	return StoragenodePaystub_Held_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Held_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Held_Field) _Column() string { return "held" }
+func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
 
-type StoragenodePaystub_Owed_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int64
 }
 
-func StoragenodePaystub_Owed(v int64) StoragenodePaystub_Owed_Field {
-	return StoragenodePaystub_Owed_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_Owed_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
 	}
-	return f._value
+	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
 }
 
-func (StoragenodePaystub_Owed_Field) _Column() string { return "owed" }
-
-type StoragenodePaystub_Disposed_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
 }
 
-func StoragenodePaystub_Disposed(v int64) StoragenodePaystub_Disposed_Field {
-	return StoragenodePaystub_Disposed_Field{_set: true, _value: v}
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f StoragenodePaystub_Disposed_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Disposed_Field) _Column() string { return "disposed" }
+func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
 
-type StoragenodePaystub_Paid_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int64
 }
 
-func StoragenodePaystub_Paid(v int64) StoragenodePaystub_Paid_Field {
-	return StoragenodePaystub_Paid_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
 }
 
-func (f StoragenodePaystub_Paid_Field) value() interface{} {
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	if v == nil {
+		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
+	}
+	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
+}
+
+func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Paid_Field) _Column() string { return "paid" }
+func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
 
-type StoragenodePaystub_Distributed_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func StoragenodePaystub_Distributed(v int64) StoragenodePaystub_Distributed_Field {
-	return StoragenodePaystub_Distributed_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
 }
 
-func (f StoragenodePaystub_Distributed_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
-
-type StoragenodeStorageTally struct {
-	NodeId          []byte
-	IntervalEndTime time.Time
-	DataTotal       float64
-}
-
-func (StoragenodeStorageTally) _Table() string { return "storagenode_storage_tallies" }
-
-type StoragenodeStorageTally_Update_Fields struct {
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
+	return "period_start"
 }
 
-type StoragenodeStorageTally_NodeId_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func StoragenodeStorageTally_NodeId(v []byte) StoragenodeStorageTally_NodeId_Field {
-	return StoragenodeStorageTally_NodeId_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_NodeId_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_NodeId_Field) _Column() string { return "node_id" }
+func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
 
-type StoragenodeStorageTally_IntervalEndTime_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func StoragenodeStorageTally_IntervalEndTime(v time.Time) StoragenodeStorageTally_IntervalEndTime_Field {
-	return StoragenodeStorageTally_IntervalEndTime_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_IntervalEndTime_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
 
-type StoragenodeStorageTally_DataTotal_Field struct {
+type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func StoragenodeStorageTally_DataTotal(v float64) StoragenodeStorageTally_DataTotal_Field {
-	return StoragenodeStorageTally_DataTotal_Field{_set: true, _value: v}
+func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
+	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_DataTotal_Field) value() interface{} {
+func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_DataTotal_Field) _Column() string { return "data_total" }
+func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StorjscanWallet struct {
-	UserId        []byte
-	WalletAddress []byte
-	CreatedAt     time.Time
+type StripecoinpaymentsTxConversionRate struct {
+	TxId        string
+	RateNumeric float64
+	CreatedAt   time.Time
 }
 
-func (StorjscanWallet) _Table() string { return "storjscan_wallets" }
+func (StripecoinpaymentsTxConversionRate) _Table() string {
+	return "stripecoinpayments_tx_conversion_rates"
+}
 
-type StorjscanWallet_Update_Fields struct {
+type StripecoinpaymentsTxConversionRate_Update_Fields struct {
 }
 
-type StorjscanWallet_UserId_Field struct {
+type StripecoinpaymentsTxConversionRate_TxId_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StorjscanWallet_UserId(v []byte) StorjscanWallet_UserId_Field {
-	return StorjscanWallet_UserId_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
+	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
 }
 
-func (f StorjscanWallet_UserId_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_UserId_Field) _Column() string { return "user_id" }
+func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
 
-type StorjscanWallet_WalletAddress_Field struct {
+type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func StorjscanWallet_WalletAddress(v []byte) StorjscanWallet_WalletAddress_Field {
-	return StorjscanWallet_WalletAddress_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
+	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
 }
 
-func (f StorjscanWallet_WalletAddress_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_WalletAddress_Field) _Column() string { return "wallet_address" }
+func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
 
-type StorjscanWallet_CreatedAt_Field struct {
+type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StorjscanWallet_CreatedAt(v time.Time) StorjscanWallet_CreatedAt_Field {
-	return StorjscanWallet_CreatedAt_Field{_set: true, _value: v}
+func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
+	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StorjscanWallet_CreatedAt_Field) value() interface{} {
+func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StorjscanWallet_CreatedAt_Field) _Column() string { return "created_at" }
+func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripeCustomer struct {
-	UserId     []byte
-	CustomerId string
-	CreatedAt  time.Time
+type User struct {
+	Id                       []byte
+	Email                    string
+	NormalizedEmail          string
+	FullName                 string
+	ShortName                *string
+	PasswordHash             []byte
+	Status                   int
+	PartnerId                []byte
+	UserAgent                []byte
+	CreatedAt                time.Time
+	ProjectLimit             int
+	ProjectBandwidthLimit    int64
+	ProjectStorageLimit      int64
+	ProjectSegmentLimit      int64
+	PaidTier                 bool
+	Position                 *string
+	CompanyName              *string
+	CompanySize              *int
+	WorkingOn                *string
+	IsProfessional           bool
+	EmployeeCount            *string
+	HaveSalesContact         bool
+	MfaEnabled               bool
+	MfaSecretKey             *string
+	MfaRecoveryCodes         *string
+	SignupPromoCode          *string
+	LastVerificationReminder *time.Time
+	VerificationReminders    int
+	FailedLoginCount         *int
+	LoginLockoutExpiration   *time.Time
 }
 
-func (StripeCustomer) _Table() string { return "stripe_customers" }
+func (User) _Table() string { return "users" }
 
-type StripeCustomer_Update_Fields struct {
+type User_Create_Fields struct {
+	ShortName                User_ShortName_Field
+	PartnerId                User_PartnerId_Field
+	UserAgent                User_UserAgent_Field
+	ProjectLimit             User_ProjectLimit_Field
+	ProjectBandwidthLimit    User_ProjectBandwidthLimit_Field
+	ProjectStorageLimit      User_ProjectStorageLimit_Field
+	ProjectSegmentLimit      User_ProjectSegmentLimit_Field
+	PaidTier                 User_PaidTier_Field
+	Position                 User_Position_Field
+	CompanyName              User_CompanyName_Field
+	CompanySize              User_CompanySize_Field
+	WorkingOn                User_WorkingOn_Field
+	IsProfessional           User_IsProfessional_Field
+	EmployeeCount            User_EmployeeCount_Field
+	HaveSalesContact         User_HaveSalesContact_Field
+	MfaEnabled               User_MfaEnabled_Field
+	MfaSecretKey             User_MfaSecretKey_Field
+	MfaRecoveryCodes         User_MfaRecoveryCodes_Field
+	SignupPromoCode          User_SignupPromoCode_Field
+	LastVerificationReminder User_LastVerificationReminder_Field
+	VerificationReminders    User_VerificationReminders_Field
+	FailedLoginCount         User_FailedLoginCount_Field
+	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
 }
 
-type StripeCustomer_UserId_Field struct {
+type User_Update_Fields struct {
+	Email                    User_Email_Field
+	NormalizedEmail          User_NormalizedEmail_Field
+	FullName                 User_FullName_Field
+	ShortName                User_ShortName_Field
+	PasswordHash             User_PasswordHash_Field
+	Status                   User_Status_Field
+	ProjectLimit             User_ProjectLimit_Field
+	ProjectBandwidthLimit    User_ProjectBandwidthLimit_Field
+	ProjectStorageLimit      User_ProjectStorageLimit_Field
+	ProjectSegmentLimit      User_ProjectSegmentLimit_Field
+	PaidTier                 User_PaidTier_Field
+	Position                 User_Position_Field
+	CompanyName              User_CompanyName_Field
+	CompanySize              User_CompanySize_Field
+	WorkingOn                User_WorkingOn_Field
+	IsProfessional           User_IsProfessional_Field
+	EmployeeCount            User_EmployeeCount_Field
+	HaveSalesContact         User_HaveSalesContact_Field
+	MfaEnabled               User_MfaEnabled_Field
+	MfaSecretKey             User_MfaSecretKey_Field
+	MfaRecoveryCodes         User_MfaRecoveryCodes_Field
+	SignupPromoCode          User_SignupPromoCode_Field
+	LastVerificationReminder User_LastVerificationReminder_Field
+	VerificationReminders    User_VerificationReminders_Field
+	FailedLoginCount         User_FailedLoginCount_Field
+	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
+}
+
+type User_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func StripeCustomer_UserId(v []byte) StripeCustomer_UserId_Field {
-	return StripeCustomer_UserId_Field{_set: true, _value: v}
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
 }
 
-func (f StripeCustomer_UserId_Field) value() interface{} {
+func (f User_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_UserId_Field) _Column() string { return "user_id" }
+func (User_Id_Field) _Column() string { return "id" }
 
-type StripeCustomer_CustomerId_Field struct {
+type User_Email_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func StripeCustomer_CustomerId(v string) StripeCustomer_CustomerId_Field {
-	return StripeCustomer_CustomerId_Field{_set: true, _value: v}
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
 }
 
-func (f StripeCustomer_CustomerId_Field) value() interface{} {
+func (f User_Email_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripeCustomer_CustomerId_Field) _Column() string { return "customer_id" }
+func (User_Email_Field) _Column() string { return "email" }
 
-type StripeCustomer_CreatedAt_Field struct {
+type User_NormalizedEmail_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
-}
-
-func StripeCustomer_CreatedAt(v time.Time) StripeCustomer_CreatedAt_Field {
-	return StripeCustomer_CreatedAt_Field{_set: true, _value: v}
-}
-
-func (f StripeCustomer_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (StripeCustomer_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StripecoinpaymentsInvoiceProjectRecord struct {
-	Id          []byte
-	ProjectId   []byte
-	Storage     float64
-	Egress      int64
-	Objects     *int64
-	Segments    *int64
-	PeriodStart time.Time
-	PeriodEnd   time.Time
-	State       int
-	CreatedAt   time.Time
+	_value string
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord) _Table() string {
-	return "stripecoinpayments_invoice_project_records"
+func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
+	return User_NormalizedEmail_Field{_set: true, _value: v}
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Create_Fields struct {
-	Objects  StripecoinpaymentsInvoiceProjectRecord_Objects_Field
-	Segments StripecoinpaymentsInvoiceProjectRecord_Segments_Field
+func (f User_NormalizedEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type StripecoinpaymentsInvoiceProjectRecord_Update_Fields struct {
-	State StripecoinpaymentsInvoiceProjectRecord_State_Field
-}
+func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Id_Field struct {
+type User_FullName_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Id(v []byte) StripecoinpaymentsInvoiceProjectRecord_Id_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Id_Field{_set: true, _value: v}
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Id_Field) value() interface{} {
+func (f User_FullName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Id_Field) _Column() string { return "id" }
+func (User_FullName_Field) _Column() string { return "full_name" }
 
-type StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field struct {
+type User_ShortName_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_ProjectId(v []byte) StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field{_set: true, _value: v}
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) value() interface{} {
+func User_ShortName_Raw(v *string) User_ShortName_Field {
+	if v == nil {
+		return User_ShortName_Null()
+	}
+	return User_ShortName(*v)
+}
+
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
+}
+
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_ShortName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_ProjectId_Field) _Column() string { return "project_id" }
+func (User_ShortName_Field) _Column() string { return "short_name" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Storage_Field struct {
+type User_PasswordHash_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Storage(v float64) StripecoinpaymentsInvoiceProjectRecord_Storage_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Storage_Field{_set: true, _value: v}
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Storage_Field) value() interface{} {
+func (f User_PasswordHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Storage_Field) _Column() string { return "storage" }
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Egress_Field struct {
+type User_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Egress(v int64) StripecoinpaymentsInvoiceProjectRecord_Egress_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Egress_Field{_set: true, _value: v}
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Egress_Field) value() interface{} {
+func (f User_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Egress_Field) _Column() string { return "egress" }
+func (User_Status_Field) _Column() string { return "status" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Objects_Field struct {
+type User_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects(v int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _value: &v}
+func User_PartnerId(v []byte) User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _value: v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
+func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
 	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Objects_Null()
+		return User_PartnerId_Null()
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Objects(*v)
+	return User_PartnerId(v)
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Objects_Null() StripecoinpaymentsInvoiceProjectRecord_Objects_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Objects_Field{_set: true, _null: true}
+func User_PartnerId_Null() User_PartnerId_Field {
+	return User_PartnerId_Field{_set: true, _null: true}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Objects_Field) value() interface{} {
+func (f User_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Objects_Field) _Column() string { return "objects" }
+func (User_PartnerId_Field) _Column() string { return "partner_id" }
 
-type StripecoinpaymentsInvoiceProjectRecord_Segments_Field struct {
+type User_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments(v int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _value: &v}
+func User_UserAgent(v []byte) User_UserAgent_Field {
+	return User_UserAgent_Field{_set: true, _value: v}
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Raw(v *int64) StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
+func User_UserAgent_Raw(v []byte) User_UserAgent_Field {
 	if v == nil {
-		return StripecoinpaymentsInvoiceProjectRecord_Segments_Null()
+		return User_UserAgent_Null()
 	}
-	return StripecoinpaymentsInvoiceProjectRecord_Segments(*v)
+	return User_UserAgent(v)
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_Segments_Null() StripecoinpaymentsInvoiceProjectRecord_Segments_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_Segments_Field{_set: true, _null: true}
+func User_UserAgent_Null() User_UserAgent_Field {
+	return User_UserAgent_Field{_set: true, _null: true}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f User_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_Segments_Field) value() interface{} {
+func (f User_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_Segments_Field) _Column() string { return "segments" }
+func (User_UserAgent_Field) _Column() string { return "user_agent" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field struct {
+type User_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodStart(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field{_set: true, _value: v}
+func User_CreatedAt(v time.Time) User_CreatedAt_Field {
+	return User_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) value() interface{} {
+func (f User_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodStart_Field) _Column() string {
-	return "period_start"
-}
+func (User_CreatedAt_Field) _Column() string { return "created_at" }
 
-type StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field struct {
+type User_ProjectLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_PeriodEnd(v time.Time) StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field{_set: true, _value: v}
+func User_ProjectLimit(v int) User_ProjectLimit_Field {
+	return User_ProjectLimit_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) value() interface{} {
+func (f User_ProjectLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_PeriodEnd_Field) _Column() string { return "period_end" }
+func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
 
-type StripecoinpaymentsInvoiceProjectRecord_State_Field struct {
+type User_ProjectBandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_State(v int) StripecoinpaymentsInvoiceProjectRecord_State_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_State_Field{_set: true, _value: v}
+func User_ProjectBandwidthLimit(v int64) User_ProjectBandwidthLimit_Field {
+	return User_ProjectBandwidthLimit_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_State_Field) value() interface{} {
+func (f User_ProjectBandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_State_Field) _Column() string { return "state" }
+func (User_ProjectBandwidthLimit_Field) _Column() string { return "project_bandwidth_limit" }
 
-type StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field struct {
+type User_ProjectStorageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func StripecoinpaymentsInvoiceProjectRecord_CreatedAt(v time.Time) StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field {
-	return StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field{_set: true, _value: v}
+func User_ProjectStorageLimit(v int64) User_ProjectStorageLimit_Field {
+	return User_ProjectStorageLimit_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) value() interface{} {
+func (f User_ProjectStorageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsInvoiceProjectRecord_CreatedAt_Field) _Column() string { return "created_at" }
-
-type StripecoinpaymentsTxConversionRate struct {
-	TxId        string
-	RateNumeric float64
-	CreatedAt   time.Time
-}
-
-func (StripecoinpaymentsTxConversionRate) _Table() string {
-	return "stripecoinpayments_tx_conversion_rates"
-}
-
-type StripecoinpaymentsTxConversionRate_Update_Fields struct {
-}
+func (User_ProjectStorageLimit_Field) _Column() string { return "project_storage_limit" }
 
-type StripecoinpaymentsTxConversionRate_TxId_Field struct {
+type User_ProjectSegmentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func StripecoinpaymentsTxConversionRate_TxId(v string) StripecoinpaymentsTxConversionRate_TxId_Field {
-	return StripecoinpaymentsTxConversionRate_TxId_Field{_set: true, _value: v}
+func User_ProjectSegmentLimit(v int64) User_ProjectSegmentLimit_Field {
+	return User_ProjectSegmentLimit_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_TxId_Field) value() interface{} {
+func (f User_ProjectSegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_TxId_Field) _Column() string { return "tx_id" }
+func (User_ProjectSegmentLimit_Field) _Column() string { return "project_segment_limit" }
 
-type StripecoinpaymentsTxConversionRate_RateNumeric_Field struct {
+type User_PaidTier_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value bool
 }
 
-func StripecoinpaymentsTxConversionRate_RateNumeric(v float64) StripecoinpaymentsTxConversionRate_RateNumeric_Field {
-	return StripecoinpaymentsTxConversionRate_RateNumeric_Field{_set: true, _value: v}
+func User_PaidTier(v bool) User_PaidTier_Field {
+	return User_PaidTier_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_RateNumeric_Field) value() interface{} {
+func (f User_PaidTier_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsTxConversionRate_RateNumeric_Field) _Column() string { return "rate_numeric" }
+func (User_PaidTier_Field) _Column() string { return "paid_tier" }
 
-type StripecoinpaymentsTxConversionRate_CreatedAt_Field struct {
+type User_Position_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *string
 }
 
-func StripecoinpaymentsTxConversionRate_CreatedAt(v time.Time) StripecoinpaymentsTxConversionRate_CreatedAt_Field {
-	return StripecoinpaymentsTxConversionRate_CreatedAt_Field{_set: true, _value: v}
+func User_Position(v string) User_Position_Field {
+	return User_Position_Field{_set: true, _value: &v}
 }
 
-func (f StripecoinpaymentsTxConversionRate_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_Position_Raw(v *string) User_Position_Field {
+	if v == nil {
+		return User_Position_Null()
 	}
-	return f._value
+	return User_Position(*v)
 }
 
-func (StripecoinpaymentsTxConversionRate_CreatedAt_Field) _Column() string { return "created_at" }
-
-type User struct {
-	Id                       []byte
-	Email                    string
-	NormalizedEmail          string
-	FullName                 string
-	ShortName                *string
-	PasswordHash             []byte
-	Status                   int
-	PartnerId                []byte
-	UserAgent                []byte
-	CreatedAt                time.Time
-	ProjectLimit             int
-	ProjectBandwidthLimit    int64
-	ProjectStorageLimit      int64
-	ProjectSegmentLimit      int64
-	PaidTier                 bool
-	Position                 *string
-	CompanyName              *string
-	CompanySize              *int
-	WorkingOn                *string
-	IsProfessional           bool
-	EmployeeCount            *string
-	HaveSalesContact         bool
-	MfaEnabled               bool
-	MfaSecretKey             *string
-	MfaRecoveryCodes         *string
-	SignupPromoCode          *string
-	LastVerificationReminder *time.Time
-	VerificationReminders    int
-	FailedLoginCount         *int
-	LoginLockoutExpiration   *time.Time
+func User_Position_Null() User_Position_Field {
+	return User_Position_Field{_set: true, _null: true}
 }
 
-func (User) _Table() string { return "users" }
+func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-type User_Create_Fields struct {
-	ShortName                User_ShortName_Field
-	PartnerId                User_PartnerId_Field
-	UserAgent                User_UserAgent_Field
-	ProjectLimit             User_ProjectLimit_Field
-	ProjectBandwidthLimit    User_ProjectBandwidthLimit_Field
-	ProjectStorageLimit      User_ProjectStorageLimit_Field
-	ProjectSegmentLimit      User_ProjectSegmentLimit_Field
-	PaidTier                 User_PaidTier_Field
-	Position                 User_Position_Field
-	CompanyName              User_CompanyName_Field
-	CompanySize              User_CompanySize_Field
-	WorkingOn                User_WorkingOn_Field
-	IsProfessional           User_IsProfessional_Field
-	EmployeeCount            User_EmployeeCount_Field
-	HaveSalesContact         User_HaveSalesContact_Field
-	MfaEnabled               User_MfaEnabled_Field
-	MfaSecretKey             User_MfaSecretKey_Field
-	MfaRecoveryCodes         User_MfaRecoveryCodes_Field
-	SignupPromoCode          User_SignupPromoCode_Field
-	LastVerificationReminder User_LastVerificationReminder_Field
-	VerificationReminders    User_VerificationReminders_Field
-	FailedLoginCount         User_FailedLoginCount_Field
-	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
+func (f User_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type User_Update_Fields struct {
-	Email                    User_Email_Field
-	NormalizedEmail          User_NormalizedEmail_Field
-	FullName                 User_FullName_Field
-	ShortName                User_ShortName_Field
-	PasswordHash             User_PasswordHash_Field
-	Status                   User_Status_Field
-	ProjectLimit             User_ProjectLimit_Field
-	ProjectBandwidthLimit    User_ProjectBandwidthLimit_Field
-	ProjectStorageLimit      User_ProjectStorageLimit_Field
-	ProjectSegmentLimit      User_ProjectSegmentLimit_Field
-	PaidTier                 User_PaidTier_Field
-	Position                 User_Position_Field
-	CompanyName              User_CompanyName_Field
-	CompanySize              User_CompanySize_Field
-	WorkingOn                User_WorkingOn_Field
-	IsProfessional           User_IsProfessional_Field
-	EmployeeCount            User_EmployeeCount_Field
-	HaveSalesContact         User_HaveSalesContact_Field
-	MfaEnabled               User_MfaEnabled_Field
-	MfaSecretKey             User_MfaSecretKey_Field
-	MfaRecoveryCodes         User_MfaRecoveryCodes_Field
-	SignupPromoCode          User_SignupPromoCode_Field
-	LastVerificationReminder User_LastVerificationReminder_Field
-	VerificationReminders    User_VerificationReminders_Field
-	FailedLoginCount         User_FailedLoginCount_Field
-	LoginLockoutExpiration   User_LoginLockoutExpiration_Field
-}
+func (User_Position_Field) _Column() string { return "position" }
 
-type User_Id_Field struct {
+type User_CompanyName_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func User_CompanyName(v string) User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _value: &v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func User_CompanyName_Raw(v *string) User_CompanyName_Field {
+	if v == nil {
+		return User_CompanyName_Null()
+	}
+	return User_CompanyName(*v)
+}
+
+func User_CompanyName_Null() User_CompanyName_Field {
+	return User_CompanyName_Field{_set: true, _null: true}
+}
+
+func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanyName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (User_CompanyName_Field) _Column() string { return "company_name" }
 
-type User_Email_Field struct {
+type User_CompanySize_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
+func User_CompanySize(v int) User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _value: &v}
 }
 
-func (f User_Email_Field) value() interface{} {
+func User_CompanySize_Raw(v *int) User_CompanySize_Field {
+	if v == nil {
+		return User_CompanySize_Null()
+	}
+	return User_CompanySize(*v)
+}
+
+func User_CompanySize_Null() User_CompanySize_Field {
+	return User_CompanySize_Field{_set: true, _null: true}
+}
+
+func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_CompanySize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (User_CompanySize_Field) _Column() string { return "company_size" }
 
-type User_NormalizedEmail_Field struct {
+type User_WorkingOn_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *string
 }
 
-func User_NormalizedEmail(v string) User_NormalizedEmail_Field {
-	return User_NormalizedEmail_Field{_set: true, _value: v}
+func User_WorkingOn(v string) User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _value: &v}
 }
 
-func (f User_NormalizedEmail_Field) value() interface{} {
+func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
+	if v == nil {
+		return User_WorkingOn_Null()
+	}
+	return User_WorkingOn(*v)
+}
+
+func User_WorkingOn_Null() User_WorkingOn_Field {
+	return User_WorkingOn_Field{_set: true, _null: true}
+}
+
+func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_WorkingOn_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_NormalizedEmail_Field) _Column() string { return "normalized_email" }
+func (User_WorkingOn_Field) _Column() string { return "working_on" }
 
-type User_FullName_Field struct {
+type User_IsProfessional_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value bool
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func User_IsProfessional(v bool) User_IsProfessional_Field {
+	return User_IsProfessional_Field{_set: true, _value: v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func (f User_IsProfessional_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (User_IsProfessional_Field) _Column() string { return "is_professional" }
 
-type User_ShortName_Field struct {
+type User_EmployeeCount_Field struct {
 	_set   bool
 	_null  bool
 	_value *string
 }
 
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
+func User_EmployeeCount(v string) User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _value: &v}
 }
 
-func User_ShortName_Raw(v *string) User_ShortName_Field {
+func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
 	if v == nil {
-		return User_ShortName_Null()
+		return User_EmployeeCount_Null()
 	}
-	return User_ShortName(*v)
+	return User_EmployeeCount(*v)
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func User_EmployeeCount_Null() User_EmployeeCount_Field {
+	return User_EmployeeCount_Field{_set: true, _null: true}
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_ShortName_Field) value() interface{} {
+func (f User_EmployeeCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
 
-type User_PasswordHash_Field struct {
+type User_HaveSalesContact_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func User_HaveSalesContact(v bool) User_HaveSalesContact_Field {
+	return User_HaveSalesContact_Field{_set: true, _value: v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func (f User_HaveSalesContact_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (User_HaveSalesContact_Field) _Column() string { return "have_sales_contact" }
 
-type User_Status_Field struct {
+type User_MfaEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value bool
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func (f User_MfaEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
 
-type User_PartnerId_Field struct {
+type User_MfaSecretKey_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func User_PartnerId(v []byte) User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _value: v}
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
 }
 
-func User_PartnerId_Raw(v []byte) User_PartnerId_Field {
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
 	if v == nil {
-		return User_PartnerId_Null()
+		return User_MfaSecretKey_Null()
 	}
-	return User_PartnerId(v)
+	return User_MfaSecretKey(*v)
 }
 
-func User_PartnerId_Null() User_PartnerId_Field {
-	return User_PartnerId_Field{_set: true, _null: true}
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
 }
 
-func (f User_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_PartnerId_Field) value() interface{} {
+func (f User_MfaSecretKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PartnerId_Field) _Column() string { return "partner_id" }
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
 
-type User_UserAgent_Field struct {
+type User_MfaRecoveryCodes_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func User_UserAgent(v []byte) User_UserAgent_Field {
-	return User_UserAgent_Field{_set: true, _value: v}
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
 }
 
-func User_UserAgent_Raw(v []byte) User_UserAgent_Field {
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
 	if v == nil {
-		return User_UserAgent_Null()
+		return User_MfaRecoveryCodes_Null()
 	}
-	return User_UserAgent(v)
+	return User_MfaRecoveryCodes(*v)
 }
 
-func User_UserAgent_Null() User_UserAgent_Field {
-	return User_UserAgent_Field{_set: true, _null: true}
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
 }
 
-func (f User_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_UserAgent_Field) value() interface{} {
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_UserAgent_Field) _Column() string { return "user_agent" }
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
 
-type User_CreatedAt_Field struct {
+type User_SignupPromoCode_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *string
+}
+
+func User_SignupPromoCode(v string) User_SignupPromoCode_Field {
+	return User_SignupPromoCode_Field{_set: true, _value: &v}
+}
+
+func User_SignupPromoCode_Raw(v *string) User_SignupPromoCode_Field {
+	if v == nil {
+		return User_SignupPromoCode_Null()
+	}
+	return User_SignupPromoCode(*v)
 }
 
-func User_CreatedAt(v time.Time) User_CreatedAt_Field {
-	return User_CreatedAt_Field{_set: true, _value: v}
+func User_SignupPromoCode_Null() User_SignupPromoCode_Field {
+	return User_SignupPromoCode_Field{_set: true, _null: true}
 }
 
-func (f User_CreatedAt_Field) value() interface{} {
+func (f User_SignupPromoCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_SignupPromoCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CreatedAt_Field) _Column() string { return "created_at" }
+func (User_SignupPromoCode_Field) _Column() string { return "signup_promo_code" }
 
-type User_ProjectLimit_Field struct {
+type User_LastVerificationReminder_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func User_ProjectLimit(v int) User_ProjectLimit_Field {
-	return User_ProjectLimit_Field{_set: true, _value: v}
+func User_LastVerificationReminder(v time.Time) User_LastVerificationReminder_Field {
+	return User_LastVerificationReminder_Field{_set: true, _value: &v}
 }
 
-func (f User_ProjectLimit_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_LastVerificationReminder_Raw(v *time.Time) User_LastVerificationReminder_Field {
+	if v == nil {
+		return User_LastVerificationReminder_Null()
 	}
-	return f._value
+	return User_LastVerificationReminder(*v)
 }
 
-func (User_ProjectLimit_Field) _Column() string { return "project_limit" }
-
-type User_ProjectBandwidthLimit_Field struct {
-	_set   bool
-	_null  bool
-	_value int64
+func User_LastVerificationReminder_Null() User_LastVerificationReminder_Field {
+	return User_LastVerificationReminder_Field{_set: true, _null: true}
 }
 
-func User_ProjectBandwidthLimit(v int64) User_ProjectBandwidthLimit_Field {
-	return User_ProjectBandwidthLimit_Field{_set: true, _value: v}
+func (f User_LastVerificationReminder_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f User_ProjectBandwidthLimit_Field) value() interface{} {
+func (f User_LastVerificationReminder_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectBandwidthLimit_Field) _Column() string { return "project_bandwidth_limit" }
+func (User_LastVerificationReminder_Field) _Column() string { return "last_verification_reminder" }
 
-type User_ProjectStorageLimit_Field struct {
+type User_VerificationReminders_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func User_ProjectStorageLimit(v int64) User_ProjectStorageLimit_Field {
-	return User_ProjectStorageLimit_Field{_set: true, _value: v}
+func User_VerificationReminders(v int) User_VerificationReminders_Field {
+	return User_VerificationReminders_Field{_set: true, _value: v}
 }
 
-func (f User_ProjectStorageLimit_Field) value() interface{} {
+func (f User_VerificationReminders_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ProjectStorageLimit_Field) _Column() string { return "project_storage_limit" }
+func (User_VerificationReminders_Field) _Column() string { return "verification_reminders" }
 
-type User_ProjectSegmentLimit_Field struct {
+type User_FailedLoginCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *int
 }
 
-func User_ProjectSegmentLimit(v int64) User_ProjectSegmentLimit_Field {
-	return User_ProjectSegmentLimit_Field{_set: true, _value: v}
+func User_FailedLoginCount(v int) User_FailedLoginCount_Field {
+	return User_FailedLoginCount_Field{_set: true, _value: &v}
 }
 
-func (f User_ProjectSegmentLimit_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func User_FailedLoginCount_Raw(v *int) User_FailedLoginCount_Field {
+	if v == nil {
+		return User_FailedLoginCount_Null()
 	}
-	return f._value
+	return User_FailedLoginCount(*v)
 }
 
-func (User_ProjectSegmentLimit_Field) _Column() string { return "project_segment_limit" }
-
-type User_PaidTier_Field struct {
-	_set   bool
-	_null  bool
-	_value bool
+func User_FailedLoginCount_Null() User_FailedLoginCount_Field {
+	return User_FailedLoginCount_Field{_set: true, _null: true}
 }
 
-func User_PaidTier(v bool) User_PaidTier_Field {
-	return User_PaidTier_Field{_set: true, _value: v}
-}
+func (f User_FailedLoginCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_PaidTier_Field) value() interface{} {
+func (f User_FailedLoginCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PaidTier_Field) _Column() string { return "paid_tier" }
+func (User_FailedLoginCount_Field) _Column() string { return "failed_login_count" }
 
-type User_Position_Field struct {
+type User_LoginLockoutExpiration_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value *time.Time
 }
 
-func User_Position(v string) User_Position_Field {
-	return User_Position_Field{_set: true, _value: &v}
+func User_LoginLockoutExpiration(v time.Time) User_LoginLockoutExpiration_Field {
+	return User_LoginLockoutExpiration_Field{_set: true, _value: &v}
 }
 
-func User_Position_Raw(v *string) User_Position_Field {
+func User_LoginLockoutExpiration_Raw(v *time.Time) User_LoginLockoutExpiration_Field {
 	if v == nil {
-		return User_Position_Null()
+		return User_LoginLockoutExpiration_Null()
 	}
-	return User_Position(*v)
+	return User_LoginLockoutExpiration(*v)
 }
 
-func User_Position_Null() User_Position_Field {
-	return User_Position_Field{_set: true, _null: true}
+func User_LoginLockoutExpiration_Null() User_LoginLockoutExpiration_Field {
+	return User_LoginLockoutExpiration_Field{_set: true, _null: true}
 }
 
-func (f User_Position_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_LoginLockoutExpiration_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f User_Position_Field) value() interface{} {
+func (f User_LoginLockoutExpiration_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Position_Field) _Column() string { return "position" }
+func (User_LoginLockoutExpiration_Field) _Column() string { return "login_lockout_expiration" }
 
-type User_CompanyName_Field struct {
-	_set   bool
-	_null  bool
-	_value *string
+type ValueAttribution struct {
+	ProjectId   []byte
+	BucketName  []byte
+	PartnerId   []byte
+	UserAgent   []byte
+	LastUpdated time.Time
 }
 
-func User_CompanyName(v string) User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _value: &v}
+func (ValueAttribution) _Table() string { return "value_attributions" }
+
+type ValueAttribution_Create_Fields struct {
+	UserAgent ValueAttribution_UserAgent_Field
 }
 
-func User_CompanyName_Raw(v *string) User_CompanyName_Field {
-	if v == nil {
-		return User_CompanyName_Null()
-	}
-	return User_CompanyName(*v)
+type ValueAttribution_Update_Fields struct {
 }
 
-func User_CompanyName_Null() User_CompanyName_Field {
-	return User_CompanyName_Field{_set: true, _null: true}
+type ValueAttribution_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_CompanyName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
+	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f User_CompanyName_Field) value() interface{} {
+func (f ValueAttribution_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanyName_Field) _Column() string { return "company_name" }
+func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
 
-type User_CompanySize_Field struct {
+type ValueAttribution_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func User_CompanySize(v int) User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _value: &v}
+func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
+	return ValueAttribution_BucketName_Field{_set: true, _value: v}
 }
 
-func User_CompanySize_Raw(v *int) User_CompanySize_Field {
-	if v == nil {
-		return User_CompanySize_Null()
+func (f ValueAttribution_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_CompanySize(*v)
+	return f._value
 }
 
-func User_CompanySize_Null() User_CompanySize_Field {
-	return User_CompanySize_Field{_set: true, _null: true}
+func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+
+type ValueAttribution_PartnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_CompanySize_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
+	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
+}
 
-func (f User_CompanySize_Field) value() interface{} {
+func (f ValueAttribution_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_CompanySize_Field) _Column() string { return "company_size" }
+func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
 
-type User_WorkingOn_Field struct {
+type ValueAttribution_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_WorkingOn(v string) User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _value: &v}
+func ValueAttribution_UserAgent(v []byte) ValueAttribution_UserAgent_Field {
+	return ValueAttribution_UserAgent_Field{_set: true, _value: v}
 }
 
-func User_WorkingOn_Raw(v *string) User_WorkingOn_Field {
+func ValueAttribution_UserAgent_Raw(v []byte) ValueAttribution_UserAgent_Field {
 	if v == nil {
-		return User_WorkingOn_Null()
+		return ValueAttribution_UserAgent_Null()
 	}
-	return User_WorkingOn(*v)
+	return ValueAttribution_UserAgent(v)
 }
 
-func User_WorkingOn_Null() User_WorkingOn_Field {
-	return User_WorkingOn_Field{_set: true, _null: true}
+func ValueAttribution_UserAgent_Null() ValueAttribution_UserAgent_Field {
+	return ValueAttribution_UserAgent_Field{_set: true, _null: true}
 }
 
-func (f User_WorkingOn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ValueAttribution_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_WorkingOn_Field) value() interface{} {
+func (f ValueAttribution_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_WorkingOn_Field) _Column() string { return "working_on" }
+func (ValueAttribution_UserAgent_Field) _Column() string { return "user_agent" }
 
-type User_IsProfessional_Field struct {
+type ValueAttribution_LastUpdated_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func User_IsProfessional(v bool) User_IsProfessional_Field {
-	return User_IsProfessional_Field{_set: true, _value: v}
+func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
+	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
 }
 
-func (f User_IsProfessional_Field) value() interface{} {
+func (f ValueAttribution_LastUpdated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_IsProfessional_Field) _Column() string { return "is_professional" }
+func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
 
-type User_EmployeeCount_Field struct {
+type WebappSession struct {
+	Id        []byte
+	UserId    []byte
+	IpAddress string
+	UserAgent string
+	Status    int
+	ExpiresAt time.Time
+}
+
+func (WebappSession) _Table() string { return "webapp_sessions" }
+
+type WebappSession_Update_Fields struct {
+	Status    WebappSession_Status_Field
+	ExpiresAt WebappSession_ExpiresAt_Field
+}
+
+type WebappSession_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_EmployeeCount(v string) User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _value: &v}
+func WebappSession_Id(v []byte) WebappSession_Id_Field {
+	return WebappSession_Id_Field{_set: true, _value: v}
 }
 
-func User_EmployeeCount_Raw(v *string) User_EmployeeCount_Field {
-	if v == nil {
-		return User_EmployeeCount_Null()
+func (f WebappSession_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_EmployeeCount(*v)
+	return f._value
+}
+
+func (WebappSession_Id_Field) _Column() string { return "id" }
+
+type WebappSession_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func User_EmployeeCount_Null() User_EmployeeCount_Field {
-	return User_EmployeeCount_Field{_set: true, _null: true}
+func WebappSession_UserId(v []byte) WebappSession_UserId_Field {
+	return WebappSession_UserId_Field{_set: true, _value: v}
 }
 
-func (f User_EmployeeCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f User_EmployeeCount_Field) value() interface{} {
+func (f WebappSession_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_EmployeeCount_Field) _Column() string { return "employee_count" }
+func (WebappSession_UserId_Field) _Column() string { return "user_id" }
 
-type User_HaveSalesContact_Field struct {
+type WebappSession_IpAddress_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func User_HaveSalesContact(v bool) User_HaveSalesContact_Field {
-	return User_HaveSalesContact_Field{_set: true, _value: v}
+func WebappSession_IpAddress(v string) WebappSession_IpAddress_Field {
+	return WebappSession_IpAddress_Field{_set: true, _value: v}
 }
 
-func (f User_HaveSalesContact_Field) value() interface{} {
+func (f WebappSession_IpAddress_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_HaveSalesContact_Field) _Column() string { return "have_sales_contact" }
+func (WebappSession_IpAddress_Field) _Column() string { return "ip_address" }
 
-type User_MfaEnabled_Field struct {
+type WebappSession_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value string
 }
 
-func User_MfaEnabled(v bool) User_MfaEnabled_Field {
-	return User_MfaEnabled_Field{_set: true, _value: v}
+func WebappSession_UserAgent(v string) WebappSession_UserAgent_Field {
+	return WebappSession_UserAgent_Field{_set: true, _value: v}
 }
 
-func (f User_MfaEnabled_Field) value() interface{} {
+func (f WebappSession_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
+func (WebappSession_UserAgent_Field) _Column() string { return "user_agent" }
 
-type User_MfaSecretKey_Field struct {
+type WebappSession_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value int
 }
 
-func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
-	return User_MfaSecretKey_Field{_set: true, _value: &v}
+func WebappSession_Status(v int) WebappSession_Status_Field {
+	return WebappSession_Status_Field{_set: true, _value: v}
 }
 
-func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
-	if v == nil {
-		return User_MfaSecretKey_Null()
+func (f WebappSession_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_MfaSecretKey(*v)
+	return f._value
 }
 
-func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
-	return User_MfaSecretKey_Field{_set: true, _null: true}
+func (WebappSession_Status_Field) _Column() string { return "status" }
+
+type WebappSession_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func WebappSession_ExpiresAt(v time.Time) WebappSession_ExpiresAt_Field {
+	return WebappSession_ExpiresAt_Field{_set: true, _value: v}
+}
 
-func (f User_MfaSecretKey_Field) value() interface{} {
+func (f WebappSession_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
+func (WebappSession_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type User_MfaRecoveryCodes_Field struct {
-	_set   bool
-	_null  bool
-	_value *string
+type ApiKey struct {
+	Id        []byte
+	ProjectId []byte
+	Head      []byte
+	Name      string
+	Secret    []byte
+	PartnerId []byte
+	UserAgent []byte
+	CreatedBy []byte
+	CreatedAt time.Time
 }
 
-func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
-	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
+func (ApiKey) _Table() string { return "api_keys" }
+
+type ApiKey_Create_Fields struct {
+	PartnerId ApiKey_PartnerId_Field
+	UserAgent ApiKey_UserAgent_Field
+	CreatedBy ApiKey_CreatedBy_Field
 }
 
-func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
-	if v == nil {
-		return User_MfaRecoveryCodes_Null()
-	}
-	return User_MfaRecoveryCodes(*v)
+type ApiKey_Update_Fields struct {
+	Name ApiKey_Name_Field
 }
 
-func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
-	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
+type ApiKey_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ApiKey_Id(v []byte) ApiKey_Id_Field {
+	return ApiKey_Id_Field{_set: true, _value: v}
+}
 
-func (f User_MfaRecoveryCodes_Field) value() interface{} {
+func (f ApiKey_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
+func (ApiKey_Id_Field) _Column() string { return "id" }
 
-type User_SignupPromoCode_Field struct {
+type ApiKey_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func User_SignupPromoCode(v string) User_SignupPromoCode_Field {
-	return User_SignupPromoCode_Field{_set: true, _value: &v}
+func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
+	return ApiKey_ProjectId_Field{_set: true, _value: v}
 }
 
-func User_SignupPromoCode_Raw(v *string) User_SignupPromoCode_Field {
-	if v == nil {
-		return User_SignupPromoCode_Null()
+func (f ApiKey_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_SignupPromoCode(*v)
+	return f._value
 }
 
-func User_SignupPromoCode_Null() User_SignupPromoCode_Field {
-	return User_SignupPromoCode_Field{_set: true, _null: true}
+func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
+
+type ApiKey_Head_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_SignupPromoCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ApiKey_Head(v []byte) ApiKey_Head_Field {
+	return ApiKey_Head_Field{_set: true, _value: v}
+}
 
-func (f User_SignupPromoCode_Field) value() interface{} {
+func (f ApiKey_Head_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_SignupPromoCode_Field) _Column() string { return "signup_promo_code" }
+func (ApiKey_Head_Field) _Column() string { return "head" }
 
-type User_LastVerificationReminder_Field struct {
+type ApiKey_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func User_LastVerificationReminder(v time.Time) User_LastVerificationReminder_Field {
-	return User_LastVerificationReminder_Field{_set: true, _value: &v}
+func ApiKey_Name(v string) ApiKey_Name_Field {
+	return ApiKey_Name_Field{_set: true, _value: v}
 }
 
-func User_LastVerificationReminder_Raw(v *time.Time) User_LastVerificationReminder_Field {
-	if v == nil {
-		return User_LastVerificationReminder_Null()
+func (f ApiKey_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return User_LastVerificationReminder(*v)
+	return f._value
 }
 
-func User_LastVerificationReminder_Null() User_LastVerificationReminder_Field {
-	return User_LastVerificationReminder_Field{_set: true, _null: true}
+func (ApiKey_Name_Field) _Column() string { return "name" }
+
+type ApiKey_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f User_LastVerificationReminder_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
 }
 
-func (f User_LastVerificationReminder_Field) value() interface{} {
+func (f ApiKey_Secret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_LastVerificationReminder_Field) _Column() string { return "last_verification_reminder" }
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
 
-type User_VerificationReminders_Field struct {
+type ApiKey_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func User_VerificationReminders(v int) User_VerificationReminders_Field {
-	return User_VerificationReminders_Field{_set: true, _value: v}
+func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f User_VerificationReminders_Field) value() interface{} {
+func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
+	if v == nil {
+		return ApiKey_PartnerId_Null()
+	}
+	return ApiKey_PartnerId(v)
+}
+
+func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
+	return ApiKey_PartnerId_Field{_set: true, _null: true}
+}
+
+func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ApiKey_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_VerificationReminders_Field) _Column() string { return "verification_reminders" }
+func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
 
-type User_FailedLoginCount_Field struct {
+type ApiKey_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value []byte
 }
 
-func User_FailedLoginCount(v int) User_FailedLoginCount_Field {
-	return User_FailedLoginCount_Field{_set: true, _value: &v}
+func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _value: v}
 }
 
-func User_FailedLoginCount_Raw(v *int) User_FailedLoginCount_Field {
+func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
 	if v == nil {
-		return User_FailedLoginCount_Null()
+		return ApiKey_UserAgent_Null()
 	}
-	return User_FailedLoginCount(*v)
+	return ApiKey_UserAgent(v)
 }
 
-func User_FailedLoginCount_Null() User_FailedLoginCount_Field {
-	return User_FailedLoginCount_Field{_set: true, _null: true}
+func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
+	return ApiKey_UserAgent_Field{_set: true, _null: true}
 }
 
-func (f User_FailedLoginCount_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_FailedLoginCount_Field) value() interface{} {
+func (f ApiKey_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FailedLoginCount_Field) _Column() string { return "failed_login_count" }
+func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
 
-type User_LoginLockoutExpiration_Field struct {
+type ApiKey_CreatedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func User_LoginLockoutExpiration(v time.Time) User_LoginLockoutExpiration_Field {
-	return User_LoginLockoutExpiration_Field{_set: true, _value: &v}
+func ApiKey_CreatedBy(v []byte) ApiKey_CreatedBy_Field {
+	return ApiKey_CreatedBy_Field{_set: true, _value: v}
 }
 
-func User_LoginLockoutExpiration_Raw(v *time.Time) User_LoginLockoutExpiration_Field {
+func ApiKey_CreatedBy_Raw(v []byte) ApiKey_CreatedBy_Field {
 	if v == nil {
-		return User_LoginLockoutExpiration_Null()
+		return ApiKey_CreatedBy_Null()
 	}
-	return User_LoginLockoutExpiration(*v)
+	return ApiKey_CreatedBy(v)
 }
 
-func User_LoginLockoutExpiration_Null() User_LoginLockoutExpiration_Field {
-	return User_LoginLockoutExpiration_Field{_set: true, _null: true}
+func ApiKey_CreatedBy_Null() ApiKey_CreatedBy_Field {
+	return ApiKey_CreatedBy_Field{_set: true, _null: true}
 }
 
-func (f User_LoginLockoutExpiration_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f ApiKey_CreatedBy_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_LoginLockoutExpiration_Field) value() interface{} {
+func (f ApiKey_CreatedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_LoginLockoutExpiration_Field) _Column() string { return "login_lockout_expiration" }
+func (ApiKey_CreatedBy_Field) _Column() string { return "created_by" }
 
-type ValueAttribution struct {
-	ProjectId   []byte
-	BucketName  []byte
-	PartnerId   []byte
-	UserAgent   []byte
-	LastUpdated time.Time
+type ApiKey_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
+	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-func (ValueAttribution) _Table() string { return "value_attributions" }
+func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ValueAttribution_Create_Fields struct {
-	UserAgent ValueAttribution_UserAgent_Field
+type BucketMetainfo struct {
+	Id                              []byte
+	ProjectId                       []byte
+	Name                            []byte
+	PartnerId                       []byte
+	UserAgent                       []byte
+	PathCipher                      int
+	CreatedAt                       time.Time
+	DefaultSegmentSize              int
+	DefaultEncryptionCipherSuite    int
+	DefaultEncryptionBlockSize      int
+	DefaultRedundancyAlgorithm      int
+	DefaultRedundancyShareSize      int
+	DefaultRedundancyRequiredShares int
+	DefaultRedundancyRepairShares   int
+	DefaultRedundancyOptimalShares  int
+	DefaultRedundancyTotalShares    int
+	Placement                       *int
+	ObjectLockEnabled               *bool
+	DefaultRetentionMode            *int
+	DefaultRetentionDays            *int
+	DeletedAt *time.Time
+	Tags *[]byte
+	Cors *[]byte
+	DefaultObjectTtl *int64
+	CreatedBy *[]byte
+	LoggingTargetBucket *[]byte
+	LoggingTargetPrefix *string
+	Frozen *bool
+	LastModified *time.Time
+	CostCenter *string
+	NameNormalized *[]byte
 }
 
-type ValueAttribution_Update_Fields struct {
+func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
+
+type BucketMetainfo_Create_Fields struct {
+	PartnerId            BucketMetainfo_PartnerId_Field
+	UserAgent            BucketMetainfo_UserAgent_Field
+	Placement            BucketMetainfo_Placement_Field
+	ObjectLockEnabled    BucketMetainfo_ObjectLockEnabled_Field
+	DefaultRetentionMode BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays BucketMetainfo_DefaultRetentionDays_Field
+	DeletedAt BucketMetainfo_DeletedAt_Field
+	Tags BucketMetainfo_Tags_Field
+	Cors BucketMetainfo_Cors_Field
+	DefaultObjectTtl BucketMetainfo_DefaultObjectTtl_Field
+	CreatedBy BucketMetainfo_CreatedBy_Field
+	LoggingTargetBucket BucketMetainfo_LoggingTargetBucket_Field
+	LoggingTargetPrefix BucketMetainfo_LoggingTargetPrefix_Field
+	Frozen BucketMetainfo_Frozen_Field
+	LastModified BucketMetainfo_LastModified_Field
+	CostCenter BucketMetainfo_CostCenter_Field
+	NameNormalized BucketMetainfo_NameNormalized_Field
 }
 
-type ValueAttribution_ProjectId_Field struct {
+type BucketMetainfo_Update_Fields struct {
+	PartnerId                       BucketMetainfo_PartnerId_Field
+	UserAgent                       BucketMetainfo_UserAgent_Field
+	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
+	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
+	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
+	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
+	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
+	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
+	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
+	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
+	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
+	Placement                       BucketMetainfo_Placement_Field
+	ObjectLockEnabled               BucketMetainfo_ObjectLockEnabled_Field
+	DefaultRetentionMode            BucketMetainfo_DefaultRetentionMode_Field
+	DefaultRetentionDays            BucketMetainfo_DefaultRetentionDays_Field
+	DeletedAt BucketMetainfo_DeletedAt_Field
+	Tags BucketMetainfo_Tags_Field
+	Cors BucketMetainfo_Cors_Field
+	DefaultObjectTtl BucketMetainfo_DefaultObjectTtl_Field
+	CreatedBy BucketMetainfo_CreatedBy_Field
+	LoggingTargetBucket BucketMetainfo_LoggingTargetBucket_Field
+	LoggingTargetPrefix BucketMetainfo_LoggingTargetPrefix_Field
+	Frozen BucketMetainfo_Frozen_Field
+	LastModified BucketMetainfo_LastModified_Field
+	CostCenter BucketMetainfo_CostCenter_Field
+	NameNormalized BucketMetainfo_NameNormalized_Field
+}
+
+type BucketMetainfo_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_ProjectId(v []byte) ValueAttribution_ProjectId_Field {
-	return ValueAttribution_ProjectId_Field{_set: true, _value: v}
+func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
+	return BucketMetainfo_Id_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_ProjectId_Field) value() interface{} {
+func (f BucketMetainfo_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketMetainfo_Id_Field) _Column() string { return "id" }
 
-type ValueAttribution_BucketName_Field struct {
+type BucketMetainfo_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_BucketName(v []byte) ValueAttribution_BucketName_Field {
-	return ValueAttribution_BucketName_Field{_set: true, _value: v}
+func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
+	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_BucketName_Field) value() interface{} {
+func (f BucketMetainfo_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
 
-type ValueAttribution_PartnerId_Field struct {
+type BucketMetainfo_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_PartnerId(v []byte) ValueAttribution_PartnerId_Field {
-	return ValueAttribution_PartnerId_Field{_set: true, _value: v}
+func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
+	return BucketMetainfo_Name_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_PartnerId_Field) value() interface{} {
+func (f BucketMetainfo_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_PartnerId_Field) _Column() string { return "partner_id" }
+func (BucketMetainfo_Name_Field) _Column() string { return "name" }
 
-type ValueAttribution_UserAgent_Field struct {
+type BucketMetainfo_PartnerId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ValueAttribution_UserAgent(v []byte) ValueAttribution_UserAgent_Field {
-	return ValueAttribution_UserAgent_Field{_set: true, _value: v}
+func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
 }
 
-func ValueAttribution_UserAgent_Raw(v []byte) ValueAttribution_UserAgent_Field {
+func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
 	if v == nil {
-		return ValueAttribution_UserAgent_Null()
+		return BucketMetainfo_PartnerId_Null()
 	}
-	return ValueAttribution_UserAgent(v)
+	return BucketMetainfo_PartnerId(v)
 }
 
-func ValueAttribution_UserAgent_Null() ValueAttribution_UserAgent_Field {
-	return ValueAttribution_UserAgent_Field{_set: true, _null: true}
+func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
+	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
 }
 
-func (f ValueAttribution_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ValueAttribution_UserAgent_Field) value() interface{} {
+func (f BucketMetainfo_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ValueAttribution_UserAgent_Field) _Column() string { return "user_agent" }
+func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
 
-type ValueAttribution_LastUpdated_Field struct {
+type BucketMetainfo_UserAgent_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func ValueAttribution_LastUpdated(v time.Time) ValueAttribution_LastUpdated_Field {
-	return ValueAttribution_LastUpdated_Field{_set: true, _value: v}
+func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
 }
 
-func (f ValueAttribution_LastUpdated_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+	if v == nil {
+		return BucketMetainfo_UserAgent_Null()
 	}
-	return f._value
+	return BucketMetainfo_UserAgent(v)
 }
 
-func (ValueAttribution_LastUpdated_Field) _Column() string { return "last_updated" }
-
-type WebappSession struct {
-	Id        []byte
-	UserId    []byte
-	IpAddress string
-	UserAgent string
-	Status    int
-	ExpiresAt time.Time
+func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
+	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
 }
 
-func (WebappSession) _Table() string { return "webapp_sessions" }
+func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-type WebappSession_Update_Fields struct {
-	Status    WebappSession_Status_Field
-	ExpiresAt WebappSession_ExpiresAt_Field
+func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type WebappSession_Id_Field struct {
+func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+
+type BucketMetainfo_PathCipher_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func WebappSession_Id(v []byte) WebappSession_Id_Field {
-	return WebappSession_Id_Field{_set: true, _value: v}
+func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
+	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_Id_Field) value() interface{} {
+func (f BucketMetainfo_PathCipher_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_Id_Field) _Column() string { return "id" }
+func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
 
-type WebappSession_UserId_Field struct {
+type BucketMetainfo_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func WebappSession_UserId(v []byte) WebappSession_UserId_Field {
-	return WebappSession_UserId_Field{_set: true, _value: v}
+func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
+	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_UserId_Field) value() interface{} {
+func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_UserId_Field) _Column() string { return "user_id" }
+func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
 
-type WebappSession_IpAddress_Field struct {
+type BucketMetainfo_DefaultSegmentSize_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func WebappSession_IpAddress(v string) WebappSession_IpAddress_Field {
-	return WebappSession_IpAddress_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
+	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_IpAddress_Field) value() interface{} {
+func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_IpAddress_Field) _Column() string { return "ip_address" }
+func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
 
-type WebappSession_UserAgent_Field struct {
+type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func WebappSession_UserAgent(v string) WebappSession_UserAgent_Field {
-	return WebappSession_UserAgent_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
+	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_UserAgent_Field) value() interface{} {
+func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_UserAgent_Field) _Column() string { return "user_agent" }
+func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
+	return "default_encryption_cipher_suite"
+}
 
-type WebappSession_Status_Field struct {
+type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
 	_set   bool
 	_null  bool
 	_value int
 }
 
-func WebappSession_Status(v int) WebappSession_Status_Field {
-	return WebappSession_Status_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
+	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_Status_Field) value() interface{} {
+func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_Status_Field) _Column() string { return "status" }
+func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
+	return "default_encryption_block_size"
+}
 
-type WebappSession_ExpiresAt_Field struct {
+type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func WebappSession_ExpiresAt(v time.Time) WebappSession_ExpiresAt_Field {
-	return WebappSession_ExpiresAt_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
+	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
 }
 
-func (f WebappSession_ExpiresAt_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (WebappSession_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type ApiKey struct {
-	Id        []byte
-	ProjectId []byte
-	Head      []byte
-	Name      string
-	Secret    []byte
-	PartnerId []byte
-	UserAgent []byte
-	CreatedAt time.Time
-}
-
-func (ApiKey) _Table() string { return "api_keys" }
-
-type ApiKey_Create_Fields struct {
-	PartnerId ApiKey_PartnerId_Field
-	UserAgent ApiKey_UserAgent_Field
-}
-
-type ApiKey_Update_Fields struct {
-	Name ApiKey_Name_Field
+func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
+	return "default_redundancy_algorithm"
 }
 
-type ApiKey_Id_Field struct {
+type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ApiKey_Id(v []byte) ApiKey_Id_Field {
-	return ApiKey_Id_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
+	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Id_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Id_Field) _Column() string { return "id" }
+func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
+	return "default_redundancy_share_size"
+}
 
-type ApiKey_ProjectId_Field struct {
+type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ApiKey_ProjectId(v []byte) ApiKey_ProjectId_Field {
-	return ApiKey_ProjectId_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
+	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_ProjectId_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_ProjectId_Field) _Column() string { return "project_id" }
-
-type ApiKey_Head_Field struct {
+func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
+	return "default_redundancy_required_shares"
+}
+
+type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ApiKey_Head(v []byte) ApiKey_Head_Field {
-	return ApiKey_Head_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
+	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Head_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Head_Field) _Column() string { return "head" }
+func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
+	return "default_redundancy_repair_shares"
+}
 
-type ApiKey_Name_Field struct {
+type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func ApiKey_Name(v string) ApiKey_Name_Field {
-	return ApiKey_Name_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
+	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Name_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Name_Field) _Column() string { return "name" }
+func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
+	return "default_redundancy_optimal_shares"
+}
 
-type ApiKey_Secret_Field struct {
+type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int
 }
 
-func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
-	return ApiKey_Secret_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
+	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
 }
 
-func (f ApiKey_Secret_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_Secret_Field) _Column() string { return "secret" }
+func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
+	return "default_redundancy_total_shares"
+}
 
-type ApiKey_PartnerId_Field struct {
+type BucketMetainfo_Placement_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func ApiKey_PartnerId(v []byte) ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _value: v}
+func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
 }
 
-func ApiKey_PartnerId_Raw(v []byte) ApiKey_PartnerId_Field {
+func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
 	if v == nil {
-		return ApiKey_PartnerId_Null()
+		return BucketMetainfo_Placement_Null()
 	}
-	return ApiKey_PartnerId(v)
+	return BucketMetainfo_Placement(*v)
 }
 
-func ApiKey_PartnerId_Null() ApiKey_PartnerId_Field {
-	return ApiKey_PartnerId_Field{_set: true, _null: true}
+func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
+	return BucketMetainfo_Placement_Field{_set: true, _null: true}
 }
 
-func (f ApiKey_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f ApiKey_PartnerId_Field) value() interface{} {
+func (f BucketMetainfo_Placement_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_PartnerId_Field) _Column() string { return "partner_id" }
+func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
 
-type ApiKey_UserAgent_Field struct {
+type BucketMetainfo_ObjectLockEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *bool
 }
 
-func ApiKey_UserAgent(v []byte) ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _value: v}
+func BucketMetainfo_ObjectLockEnabled(v bool) BucketMetainfo_ObjectLockEnabled_Field {
+	return BucketMetainfo_ObjectLockEnabled_Field{_set: true, _value: &v}
 }
 
-func ApiKey_UserAgent_Raw(v []byte) ApiKey_UserAgent_Field {
+func BucketMetainfo_ObjectLockEnabled_Raw(v *bool) BucketMetainfo_ObjectLockEnabled_Field {
 	if v == nil {
-		return ApiKey_UserAgent_Null()
+		return BucketMetainfo_ObjectLockEnabled_Null()
 	}
-	return ApiKey_UserAgent(v)
+	return BucketMetainfo_ObjectLockEnabled(*v)
 }
 
-func ApiKey_UserAgent_Null() ApiKey_UserAgent_Field {
-	return ApiKey_UserAgent_Field{_set: true, _null: true}
+func BucketMetainfo_ObjectLockEnabled_Null() BucketMetainfo_ObjectLockEnabled_Field {
+	return BucketMetainfo_ObjectLockEnabled_Field{_set: true, _null: true}
 }
 
-func (f ApiKey_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_ObjectLockEnabled_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f ApiKey_UserAgent_Field) value() interface{} {
+func (f BucketMetainfo_ObjectLockEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_UserAgent_Field) _Column() string { return "user_agent" }
+func (BucketMetainfo_ObjectLockEnabled_Field) _Column() string { return "object_lock_enabled" }
 
-type ApiKey_CreatedAt_Field struct {
+type BucketMetainfo_DefaultRetentionMode_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int
 }
 
-func ApiKey_CreatedAt(v time.Time) ApiKey_CreatedAt_Field {
-	return ApiKey_CreatedAt_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultRetentionMode(v int) BucketMetainfo_DefaultRetentionMode_Field {
+	return BucketMetainfo_DefaultRetentionMode_Field{_set: true, _value: &v}
 }
 
-func (f ApiKey_CreatedAt_Field) value() interface{} {
+func BucketMetainfo_DefaultRetentionMode_Raw(v *int) BucketMetainfo_DefaultRetentionMode_Field {
+	if v == nil {
+		return BucketMetainfo_DefaultRetentionMode_Null()
+	}
+	return BucketMetainfo_DefaultRetentionMode(*v)
+}
+
+func BucketMetainfo_DefaultRetentionMode_Null() BucketMetainfo_DefaultRetentionMode_Field {
+	return BucketMetainfo_DefaultRetentionMode_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_DefaultRetentionMode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketMetainfo_DefaultRetentionMode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketMetainfo_DefaultRetentionMode_Field) _Column() string { return "default_retention_mode" }
 
-type BucketMetainfo struct {
-	Id                              []byte
-	ProjectId                       []byte
-	Name                            []byte
-	PartnerId                       []byte
-	UserAgent                       []byte
-	PathCipher                      int
-	CreatedAt                       time.Time
-	DefaultSegmentSize              int
-	DefaultEncryptionCipherSuite    int
-	DefaultEncryptionBlockSize      int
-	DefaultRedundancyAlgorithm      int
-	DefaultRedundancyShareSize      int
-	DefaultRedundancyRequiredShares int
-	DefaultRedundancyRepairShares   int
-	DefaultRedundancyOptimalShares  int
-	DefaultRedundancyTotalShares    int
-	Placement                       *int
+type BucketMetainfo_DefaultRetentionDays_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
 }
 
-func (BucketMetainfo) _Table() string { return "bucket_metainfos" }
-
-type BucketMetainfo_Create_Fields struct {
-	PartnerId BucketMetainfo_PartnerId_Field
-	UserAgent BucketMetainfo_UserAgent_Field
-	Placement BucketMetainfo_Placement_Field
+func BucketMetainfo_DefaultRetentionDays(v int) BucketMetainfo_DefaultRetentionDays_Field {
+	return BucketMetainfo_DefaultRetentionDays_Field{_set: true, _value: &v}
 }
 
-type BucketMetainfo_Update_Fields struct {
-	PartnerId                       BucketMetainfo_PartnerId_Field
-	UserAgent                       BucketMetainfo_UserAgent_Field
-	DefaultSegmentSize              BucketMetainfo_DefaultSegmentSize_Field
-	DefaultEncryptionCipherSuite    BucketMetainfo_DefaultEncryptionCipherSuite_Field
-	DefaultEncryptionBlockSize      BucketMetainfo_DefaultEncryptionBlockSize_Field
-	DefaultRedundancyAlgorithm      BucketMetainfo_DefaultRedundancyAlgorithm_Field
-	DefaultRedundancyShareSize      BucketMetainfo_DefaultRedundancyShareSize_Field
-	DefaultRedundancyRequiredShares BucketMetainfo_DefaultRedundancyRequiredShares_Field
-	DefaultRedundancyRepairShares   BucketMetainfo_DefaultRedundancyRepairShares_Field
-	DefaultRedundancyOptimalShares  BucketMetainfo_DefaultRedundancyOptimalShares_Field
-	DefaultRedundancyTotalShares    BucketMetainfo_DefaultRedundancyTotalShares_Field
-	Placement                       BucketMetainfo_Placement_Field
+func BucketMetainfo_DefaultRetentionDays_Raw(v *int) BucketMetainfo_DefaultRetentionDays_Field {
+	if v == nil {
+		return BucketMetainfo_DefaultRetentionDays_Null()
+	}
+	return BucketMetainfo_DefaultRetentionDays(*v)
 }
 
-type BucketMetainfo_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func BucketMetainfo_DefaultRetentionDays_Null() BucketMetainfo_DefaultRetentionDays_Field {
+	return BucketMetainfo_DefaultRetentionDays_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_Id(v []byte) BucketMetainfo_Id_Field {
-	return BucketMetainfo_Id_Field{_set: true, _value: v}
+func (f BucketMetainfo_DefaultRetentionDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BucketMetainfo_Id_Field) value() interface{} {
+func (f BucketMetainfo_DefaultRetentionDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Id_Field) _Column() string { return "id" }
+func (BucketMetainfo_DefaultRetentionDays_Field) _Column() string { return "default_retention_days" }
 
-type BucketMetainfo_ProjectId_Field struct {
+type BucketMetainfo_DeletedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func BucketMetainfo_ProjectId(v []byte) BucketMetainfo_ProjectId_Field {
-	return BucketMetainfo_ProjectId_Field{_set: true, _value: v}
+func BucketMetainfo_DeletedAt(v time.Time) BucketMetainfo_DeletedAt_Field {
+	return BucketMetainfo_DeletedAt_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_ProjectId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_DeletedAt_Raw(v *time.Time) BucketMetainfo_DeletedAt_Field {
+	if v == nil {
+		return BucketMetainfo_DeletedAt_Null()
 	}
-	return f._value
+	return BucketMetainfo_DeletedAt(*v)
 }
 
-func (BucketMetainfo_ProjectId_Field) _Column() string { return "project_id" }
-
-type BucketMetainfo_Name_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func BucketMetainfo_DeletedAt_Null() BucketMetainfo_DeletedAt_Field {
+	return BucketMetainfo_DeletedAt_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_Name(v []byte) BucketMetainfo_Name_Field {
-	return BucketMetainfo_Name_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_DeletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_Name_Field) value() interface{} {
+func (f BucketMetainfo_DeletedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Name_Field) _Column() string { return "name" }
+func (BucketMetainfo_DeletedAt_Field) _Column() string { return "deleted_at" }
 
-type BucketMetainfo_PartnerId_Field struct {
+type BucketMetainfo_Tags_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *[]byte
 }
 
-func BucketMetainfo_PartnerId(v []byte) BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _value: v}
+func BucketMetainfo_Tags(v []byte) BucketMetainfo_Tags_Field {
+	return BucketMetainfo_Tags_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_PartnerId_Raw(v []byte) BucketMetainfo_PartnerId_Field {
+func BucketMetainfo_Tags_Raw(v *[]byte) BucketMetainfo_Tags_Field {
 	if v == nil {
-		return BucketMetainfo_PartnerId_Null()
+		return BucketMetainfo_Tags_Null()
 	}
-	return BucketMetainfo_PartnerId(v)
+	return BucketMetainfo_Tags(*v)
 }
 
-func BucketMetainfo_PartnerId_Null() BucketMetainfo_PartnerId_Field {
-	return BucketMetainfo_PartnerId_Field{_set: true, _null: true}
+func BucketMetainfo_Tags_Null() BucketMetainfo_Tags_Field {
+	return BucketMetainfo_Tags_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_Tags_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_PartnerId_Field) value() interface{} {
+func (f BucketMetainfo_Tags_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_PartnerId_Field) _Column() string { return "partner_id" }
+func (BucketMetainfo_Tags_Field) _Column() string { return "tags" }
 
-type BucketMetainfo_UserAgent_Field struct {
+type BucketMetainfo_Cors_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *[]byte
 }
 
-func BucketMetainfo_UserAgent(v []byte) BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _value: v}
+func BucketMetainfo_Cors(v []byte) BucketMetainfo_Cors_Field {
+	return BucketMetainfo_Cors_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_UserAgent_Raw(v []byte) BucketMetainfo_UserAgent_Field {
+func BucketMetainfo_Cors_Raw(v *[]byte) BucketMetainfo_Cors_Field {
 	if v == nil {
-		return BucketMetainfo_UserAgent_Null()
+		return BucketMetainfo_Cors_Null()
 	}
-	return BucketMetainfo_UserAgent(v)
+	return BucketMetainfo_Cors(*v)
 }
 
-func BucketMetainfo_UserAgent_Null() BucketMetainfo_UserAgent_Field {
-	return BucketMetainfo_UserAgent_Field{_set: true, _null: true}
+func BucketMetainfo_Cors_Null() BucketMetainfo_Cors_Field {
+	return BucketMetainfo_Cors_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_Cors_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_UserAgent_Field) value() interface{} {
+func (f BucketMetainfo_Cors_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_UserAgent_Field) _Column() string { return "user_agent" }
+func (BucketMetainfo_Cors_Field) _Column() string { return "cors" }
 
-type BucketMetainfo_PathCipher_Field struct {
+type BucketMetainfo_DefaultObjectTtl_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int64
 }
 
-func BucketMetainfo_PathCipher(v int) BucketMetainfo_PathCipher_Field {
-	return BucketMetainfo_PathCipher_Field{_set: true, _value: v}
+func BucketMetainfo_DefaultObjectTtl(v int64) BucketMetainfo_DefaultObjectTtl_Field {
+	return BucketMetainfo_DefaultObjectTtl_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_PathCipher_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_DefaultObjectTtl_Raw(v *int64) BucketMetainfo_DefaultObjectTtl_Field {
+	if v == nil {
+		return BucketMetainfo_DefaultObjectTtl_Null()
 	}
-	return f._value
+	return BucketMetainfo_DefaultObjectTtl(*v)
 }
 
-func (BucketMetainfo_PathCipher_Field) _Column() string { return "path_cipher" }
-
-type BucketMetainfo_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func BucketMetainfo_DefaultObjectTtl_Null() BucketMetainfo_DefaultObjectTtl_Field {
+	return BucketMetainfo_DefaultObjectTtl_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_CreatedAt(v time.Time) BucketMetainfo_CreatedAt_Field {
-	return BucketMetainfo_CreatedAt_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_DefaultObjectTtl_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_CreatedAt_Field) value() interface{} {
+func (f BucketMetainfo_DefaultObjectTtl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketMetainfo_DefaultObjectTtl_Field) _Column() string { return "default_object_ttl" }
 
-type BucketMetainfo_DefaultSegmentSize_Field struct {
+type BucketMetainfo_CreatedBy_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *[]byte
 }
 
-func BucketMetainfo_DefaultSegmentSize(v int) BucketMetainfo_DefaultSegmentSize_Field {
-	return BucketMetainfo_DefaultSegmentSize_Field{_set: true, _value: v}
+func BucketMetainfo_CreatedBy(v []byte) BucketMetainfo_CreatedBy_Field {
+	return BucketMetainfo_CreatedBy_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultSegmentSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_CreatedBy_Raw(v *[]byte) BucketMetainfo_CreatedBy_Field {
+	if v == nil {
+		return BucketMetainfo_CreatedBy_Null()
 	}
-	return f._value
+	return BucketMetainfo_CreatedBy(*v)
 }
 
-func (BucketMetainfo_DefaultSegmentSize_Field) _Column() string { return "default_segment_size" }
-
-type BucketMetainfo_DefaultEncryptionCipherSuite_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func BucketMetainfo_CreatedBy_Null() BucketMetainfo_CreatedBy_Field {
+	return BucketMetainfo_CreatedBy_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultEncryptionCipherSuite(v int) BucketMetainfo_DefaultEncryptionCipherSuite_Field {
-	return BucketMetainfo_DefaultEncryptionCipherSuite_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_CreatedBy_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_DefaultEncryptionCipherSuite_Field) value() interface{} {
+func (f BucketMetainfo_CreatedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultEncryptionCipherSuite_Field) _Column() string {
-	return "default_encryption_cipher_suite"
-}
+func (BucketMetainfo_CreatedBy_Field) _Column() string { return "created_by" }
 
-type BucketMetainfo_DefaultEncryptionBlockSize_Field struct {
+type BucketMetainfo_LoggingTargetBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *[]byte
 }
 
-func BucketMetainfo_DefaultEncryptionBlockSize(v int) BucketMetainfo_DefaultEncryptionBlockSize_Field {
-	return BucketMetainfo_DefaultEncryptionBlockSize_Field{_set: true, _value: v}
+func BucketMetainfo_LoggingTargetBucket(v []byte) BucketMetainfo_LoggingTargetBucket_Field {
+	return BucketMetainfo_LoggingTargetBucket_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultEncryptionBlockSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_LoggingTargetBucket_Raw(v *[]byte) BucketMetainfo_LoggingTargetBucket_Field {
+	if v == nil {
+		return BucketMetainfo_LoggingTargetBucket_Null()
 	}
-	return f._value
-}
-
-func (BucketMetainfo_DefaultEncryptionBlockSize_Field) _Column() string {
-	return "default_encryption_block_size"
+	return BucketMetainfo_LoggingTargetBucket(*v)
 }
 
-type BucketMetainfo_DefaultRedundancyAlgorithm_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func BucketMetainfo_LoggingTargetBucket_Null() BucketMetainfo_LoggingTargetBucket_Field {
+	return BucketMetainfo_LoggingTargetBucket_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultRedundancyAlgorithm(v int) BucketMetainfo_DefaultRedundancyAlgorithm_Field {
-	return BucketMetainfo_DefaultRedundancyAlgorithm_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_LoggingTargetBucket_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_DefaultRedundancyAlgorithm_Field) value() interface{} {
+func (f BucketMetainfo_LoggingTargetBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyAlgorithm_Field) _Column() string {
-	return "default_redundancy_algorithm"
-}
+func (BucketMetainfo_LoggingTargetBucket_Field) _Column() string { return "logging_target_bucket" }
 
-type BucketMetainfo_DefaultRedundancyShareSize_Field struct {
+type BucketMetainfo_LoggingTargetPrefix_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *string
 }
 
-func BucketMetainfo_DefaultRedundancyShareSize(v int) BucketMetainfo_DefaultRedundancyShareSize_Field {
-	return BucketMetainfo_DefaultRedundancyShareSize_Field{_set: true, _value: v}
+func BucketMetainfo_LoggingTargetPrefix(v string) BucketMetainfo_LoggingTargetPrefix_Field {
+	return BucketMetainfo_LoggingTargetPrefix_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyShareSize_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func BucketMetainfo_LoggingTargetPrefix_Raw(v *string) BucketMetainfo_LoggingTargetPrefix_Field {
+	if v == nil {
+		return BucketMetainfo_LoggingTargetPrefix_Null()
 	}
-	return f._value
-}
-
-func (BucketMetainfo_DefaultRedundancyShareSize_Field) _Column() string {
-	return "default_redundancy_share_size"
+	return BucketMetainfo_LoggingTargetPrefix(*v)
 }
 
-type BucketMetainfo_DefaultRedundancyRequiredShares_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func BucketMetainfo_LoggingTargetPrefix_Null() BucketMetainfo_LoggingTargetPrefix_Field {
+	return BucketMetainfo_LoggingTargetPrefix_Field{_set: true, _null: true}
 }
 
-func BucketMetainfo_DefaultRedundancyRequiredShares(v int) BucketMetainfo_DefaultRedundancyRequiredShares_Field {
-	return BucketMetainfo_DefaultRedundancyRequiredShares_Field{_set: true, _value: v}
-}
+func (f BucketMetainfo_LoggingTargetPrefix_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_DefaultRedundancyRequiredShares_Field) value() interface{} {
+func (f BucketMetainfo_LoggingTargetPrefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRequiredShares_Field) _Column() string {
-	return "default_redundancy_required_shares"
-}
+func (BucketMetainfo_LoggingTargetPrefix_Field) _Column() string { return "logging_target_prefix" }
 
-type BucketMetainfo_DefaultRedundancyRepairShares_Field struct {
+type BucketMetainfo_Frozen_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *bool
 }
 
-func BucketMetainfo_DefaultRedundancyRepairShares(v int) BucketMetainfo_DefaultRedundancyRepairShares_Field {
-	return BucketMetainfo_DefaultRedundancyRepairShares_Field{_set: true, _value: v}
+func BucketMetainfo_Frozen(v bool) BucketMetainfo_Frozen_Field {
+	return BucketMetainfo_Frozen_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyRepairShares_Field) value() interface{} {
+func BucketMetainfo_Frozen_Raw(v *bool) BucketMetainfo_Frozen_Field {
+	if v == nil {
+		return BucketMetainfo_Frozen_Null()
+	}
+	return BucketMetainfo_Frozen(*v)
+}
+
+func BucketMetainfo_Frozen_Null() BucketMetainfo_Frozen_Field {
+	return BucketMetainfo_Frozen_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_Frozen_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_Frozen_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyRepairShares_Field) _Column() string {
-	return "default_redundancy_repair_shares"
-}
+func (BucketMetainfo_Frozen_Field) _Column() string { return "frozen" }
 
-type BucketMetainfo_DefaultRedundancyOptimalShares_Field struct {
+type BucketMetainfo_LastModified_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func BucketMetainfo_DefaultRedundancyOptimalShares(v int) BucketMetainfo_DefaultRedundancyOptimalShares_Field {
-	return BucketMetainfo_DefaultRedundancyOptimalShares_Field{_set: true, _value: v}
+func BucketMetainfo_LastModified(v time.Time) BucketMetainfo_LastModified_Field {
+	return BucketMetainfo_LastModified_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyOptimalShares_Field) value() interface{} {
+func BucketMetainfo_LastModified_Raw(v *time.Time) BucketMetainfo_LastModified_Field {
+	if v == nil {
+		return BucketMetainfo_LastModified_Null()
+	}
+	return BucketMetainfo_LastModified(*v)
+}
+
+func BucketMetainfo_LastModified_Null() BucketMetainfo_LastModified_Field {
+	return BucketMetainfo_LastModified_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_LastModified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_LastModified_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyOptimalShares_Field) _Column() string {
-	return "default_redundancy_optimal_shares"
-}
+func (BucketMetainfo_LastModified_Field) _Column() string { return "last_modified" }
 
-type BucketMetainfo_DefaultRedundancyTotalShares_Field struct {
+type BucketMetainfo_CostCenter_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *string
 }
 
-func BucketMetainfo_DefaultRedundancyTotalShares(v int) BucketMetainfo_DefaultRedundancyTotalShares_Field {
-	return BucketMetainfo_DefaultRedundancyTotalShares_Field{_set: true, _value: v}
+func BucketMetainfo_CostCenter(v string) BucketMetainfo_CostCenter_Field {
+	return BucketMetainfo_CostCenter_Field{_set: true, _value: &v}
 }
 
-func (f BucketMetainfo_DefaultRedundancyTotalShares_Field) value() interface{} {
+func BucketMetainfo_CostCenter_Raw(v *string) BucketMetainfo_CostCenter_Field {
+	if v == nil {
+		return BucketMetainfo_CostCenter_Null()
+	}
+	return BucketMetainfo_CostCenter(*v)
+}
+
+func BucketMetainfo_CostCenter_Null() BucketMetainfo_CostCenter_Field {
+	return BucketMetainfo_CostCenter_Field{_set: true, _null: true}
+}
+
+func (f BucketMetainfo_CostCenter_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketMetainfo_CostCenter_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
-	return "default_redundancy_total_shares"
-}
+func (BucketMetainfo_CostCenter_Field) _Column() string { return "cost_center" }
 
-type BucketMetainfo_Placement_Field struct {
+type BucketMetainfo_NameNormalized_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *[]byte
 }
 
-func BucketMetainfo_Placement(v int) BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _value: &v}
+func BucketMetainfo_NameNormalized(v []byte) BucketMetainfo_NameNormalized_Field {
+	return BucketMetainfo_NameNormalized_Field{_set: true, _value: &v}
 }
 
-func BucketMetainfo_Placement_Raw(v *int) BucketMetainfo_Placement_Field {
+func BucketMetainfo_NameNormalized_Raw(v *[]byte) BucketMetainfo_NameNormalized_Field {
 	if v == nil {
-		return BucketMetainfo_Placement_Null()
+		return BucketMetainfo_NameNormalized_Null()
 	}
-	return BucketMetainfo_Placement(*v)
+	return BucketMetainfo_NameNormalized(*v)
 }
 
-func BucketMetainfo_Placement_Null() BucketMetainfo_Placement_Field {
-	return BucketMetainfo_Placement_Field{_set: true, _null: true}
+func BucketMetainfo_NameNormalized_Null() BucketMetainfo_NameNormalized_Field {
+	return BucketMetainfo_NameNormalized_Field{_set: true, _null: true}
 }
 
-func (f BucketMetainfo_Placement_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f BucketMetainfo_NameNormalized_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f BucketMetainfo_Placement_Field) value() interface{} {
+func (f BucketMetainfo_NameNormalized_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
+func (BucketMetainfo_NameNormalized_Field) _Column() string { return "name_normalized" }
 
 type ProjectMember struct {
 	MemberId  []byte
@@ -11276,8 +12689,25 @@ type BandwidthLimit_UsageLimit_SegmentLimit_Row struct {
 	SegmentLimit   *int64
 }
 
-type CreatedAt_Row struct {
-	CreatedAt time.Time
+type CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row struct {
+	CreatedAt                    time.Time
+	DefaultEncryptionCipherSuite int
+	DefaultEncryptionBlockSize   int
+	Placement                    *int
+	ObjectLockEnabled            *bool
+	DefaultRetentionMode         *int
+	DefaultRetentionDays         *int
+	DeletedAt *time.Time
+	Tags *[]byte
+	DefaultSegmentSize int
+	Cors *[]byte
+	DefaultObjectTtl *int64
+	CreatedBy *[]byte
+	LoggingTargetBucket *[]byte
+	LoggingTargetPrefix *string
+	Frozen *bool
+	LastModified *time.Time
+	CostCenter *string
 }
 
 type CustomerId_Row struct {
@@ -11706,15 +13136,16 @@ func (obj *pgxImpl) Create_Project(ctx context.Context,
 	__user_agent_val := optional.UserAgent.value()
 	__owner_id_val := project_owner_id.value()
 	__created_at_val := __now
+	__default_redundancy_scheme_val := optional.DefaultRedundancyScheme.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, name, description, usage_limit, bandwidth_limit, rate_limit, burst_limit, max_buckets, partner_id, user_agent, owner_id, created_at")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, name, description, usage_limit, bandwidth_limit, rate_limit, burst_limit, max_buckets, partner_id, user_agent, owner_id, created_at, default_redundancy_scheme")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO projects "), __clause, __sqlbundle_Literal(" RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO projects "), __clause, __sqlbundle_Literal(" RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __rate_limit_val, __burst_limit_val, __max_buckets_val, __partner_id_val, __user_agent_val, __owner_id_val, __created_at_val)
+	__values = append(__values, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __rate_limit_val, __burst_limit_val, __max_buckets_val, __partner_id_val, __user_agent_val, __owner_id_val, __created_at_val, __default_redundancy_scheme_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -11737,7 +13168,7 @@ func (obj *pgxImpl) Create_Project(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -11791,18 +13222,19 @@ func (obj *pgxImpl) Create_ApiKey(ctx context.Context,
 	__secret_val := api_key_secret.value()
 	__partner_id_val := optional.PartnerId.value()
 	__user_agent_val := optional.UserAgent.value()
+	__created_by_val := optional.CreatedBy.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, user_agent, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, user_agent, created_by, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __user_agent_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __user_agent_val, __created_by_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -12107,17 +13539,31 @@ func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__object_lock_enabled_val := optional.ObjectLockEnabled.value()
+	__default_retention_mode_val := optional.DefaultRetentionMode.value()
+	__default_retention_days_val := optional.DefaultRetentionDays.value()
+	__deleted_at_val := optional.DeletedAt.value()
+	__tags_val := optional.Tags.value()
+	__cors_val := optional.Cors.value()
+	__default_object_ttl_val := optional.DefaultObjectTtl.value()
+	__created_by_val := optional.CreatedBy.value()
+	__logging_target_bucket_val := optional.LoggingTargetBucket.value()
+	__logging_target_prefix_val := optional.LoggingTargetPrefix.value()
+	__frozen_val := optional.Frozen.value()
+	__last_modified_val := optional.LastModified.value()
+	__cost_center_val := optional.CostCenter.value()
+	__name_normalized_val := optional.NameNormalized.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, object_lock_enabled, default_retention_mode, default_retention_days, deleted_at, tags, cors, default_object_ttl, created_by, logging_target_bucket, logging_target_prefix, frozen, last_modified, cost_center, name_normalized ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __object_lock_enabled_val, __default_retention_mode_val, __default_retention_days_val, __deleted_at_val, __tags_val, __cors_val, __default_object_ttl_val, __created_by_val, __logging_target_bucket_val, __logging_target_prefix_val, __frozen_val, __last_modified_val, __cost_center_val, __name_normalized_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -13088,7 +14534,7 @@ func (obj *pgxImpl) Get_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -13097,7 +14543,7 @@ func (obj *pgxImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err != nil {
 		return (*Project)(nil), obj.makeErr(err)
 	}
@@ -13219,7 +14665,7 @@ func (obj *pgxImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects")
 
 	var __values []interface{}
 
@@ -13236,7 +14682,7 @@ func (obj *pgxImpl) All_Project(ctx context.Context) (
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -13263,7 +14709,7 @@ func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx cont
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -13281,7 +14727,7 @@ func (obj *pgxImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx cont
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -13308,7 +14754,7 @@ func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Con
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_owner_id.value())
@@ -13326,7 +14772,7 @@ func (obj *pgxImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx context.Con
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -13353,7 +14799,7 @@ func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Na
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -13371,7 +14817,7 @@ func (obj *pgxImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Na
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -13399,7 +14845,7 @@ func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -13419,7 +14865,7 @@ func (obj *pgxImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -13492,7 +14938,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -13501,7 +14947,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -13514,7 +14960,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Head(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_head.value())
@@ -13523,7 +14969,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Head(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -13537,7 +14983,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_name.value(), api_key_project_id.value())
@@ -13546,7 +14992,7 @@ func (obj *pgxImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -14499,7 +15945,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -14508,7 +15954,7 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -14516,13 +15962,13 @@ func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context
 
 }
 
-func (obj *pgxImpl) Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx context.Context,
+func (obj *pgxImpl) Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	row *CreatedAt_Row, err error) {
+	row *CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.created_at FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.created_at, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.default_segment_size, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -14530,10 +15976,10 @@ func (obj *pgxImpl) Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx conte
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &CreatedAt_Row{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.CreatedAt)
+	row = &CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.CreatedAt, &row.DefaultEncryptionCipherSuite, &row.DefaultEncryptionBlockSize, &row.Placement, &row.ObjectLockEnabled, &row.DefaultRetentionMode, &row.DefaultRetentionDays, &row.DeletedAt, &row.Tags, &row.DefaultSegmentSize, &row.Cors, &row.DefaultObjectTtl, &row.CreatedBy, &row.LoggingTargetBucket, &row.LoggingTargetPrefix, &row.Frozen, &row.LastModified, &row.CostCenter)
 	if err != nil {
-		return (*CreatedAt_Row)(nil), obj.makeErr(err)
+		return (*CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row)(nil), obj.makeErr(err)
 	}
 	return row, nil
 
@@ -14614,7 +16060,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -14634,7 +16080,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_GreaterOrEqual_
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 				if err != nil {
 					return nil, err
 				}
@@ -14664,7 +16110,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -14684,7 +16130,7 @@ func (obj *pgxImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greater_OrderBy
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 				if err != nil {
 					return nil, err
 				}
@@ -16676,7 +18122,7 @@ func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16722,6 +18168,11 @@ func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_buckets = ?"))
 	}
 
+	if update.DefaultRedundancyScheme._set {
+		__values = append(__values, update.DefaultRedundancyScheme.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_scheme = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -16735,7 +18186,7 @@ func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -16874,7 +18325,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16940,6 +18391,76 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.ObjectLockEnabled._set {
+		__values = append(__values, update.ObjectLockEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_lock_enabled = ?"))
+	}
+
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+
+	if update.DeletedAt._set {
+		__values = append(__values, update.DeletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_at = ?"))
+	}
+
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
+	if update.Cors._set {
+		__values = append(__values, update.Cors.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cors = ?"))
+	}
+
+	if update.DefaultObjectTtl._set {
+		__values = append(__values, update.DefaultObjectTtl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_object_ttl = ?"))
+	}
+
+	if update.CreatedBy._set {
+		__values = append(__values, update.CreatedBy.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_by = ?"))
+	}
+
+	if update.LoggingTargetBucket._set {
+		__values = append(__values, update.LoggingTargetBucket.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("logging_target_bucket = ?"))
+	}
+
+	if update.LoggingTargetPrefix._set {
+		__values = append(__values, update.LoggingTargetPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("logging_target_prefix = ?"))
+	}
+
+	if update.Frozen._set {
+		__values = append(__values, update.Frozen.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("frozen = ?"))
+	}
+
+	if update.LastModified._set {
+		__values = append(__values, update.LastModified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_modified = ?"))
+	}
+
+	if update.CostCenter._set {
+		__values = append(__values, update.CostCenter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cost_center = ?"))
+	}
+
+	if update.NameNormalized._set {
+		__values = append(__values, update.NameNormalized.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name_normalized = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -16953,7 +18474,7 @@ func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Cont
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18175,6 +19696,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_suppressions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18215,6 +19746,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_transfers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18225,6 +19766,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_renames;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_idempotency_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18245,6 +19806,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_access_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -18603,15 +20184,16 @@ func (obj *pgxcockroachImpl) Create_Project(ctx context.Context,
 	__user_agent_val := optional.UserAgent.value()
 	__owner_id_val := project_owner_id.value()
 	__created_at_val := __now
+	__default_redundancy_scheme_val := optional.DefaultRedundancyScheme.value()
 
-	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, name, description, usage_limit, bandwidth_limit, rate_limit, burst_limit, max_buckets, partner_id, user_agent, owner_id, created_at")}
-	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
+	var __columns = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("id, name, description, usage_limit, bandwidth_limit, rate_limit, burst_limit, max_buckets, partner_id, user_agent, owner_id, created_at, default_redundancy_scheme")}
+	var __placeholders = &__sqlbundle_Hole{SQL: __sqlbundle_Literal("?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?")}
 	var __clause = &__sqlbundle_Hole{SQL: __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("("), __columns, __sqlbundle_Literal(") VALUES ("), __placeholders, __sqlbundle_Literal(")")}}}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO projects "), __clause, __sqlbundle_Literal(" RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("INSERT INTO projects "), __clause, __sqlbundle_Literal(" RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme")}}
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __rate_limit_val, __burst_limit_val, __max_buckets_val, __partner_id_val, __user_agent_val, __owner_id_val, __created_at_val)
+	__values = append(__values, __id_val, __name_val, __description_val, __usage_limit_val, __bandwidth_limit_val, __rate_limit_val, __burst_limit_val, __max_buckets_val, __partner_id_val, __user_agent_val, __owner_id_val, __created_at_val, __default_redundancy_scheme_val)
 
 	__optional_columns := __sqlbundle_Literals{Join: ", "}
 	__optional_placeholders := __sqlbundle_Literals{Join: ", "}
@@ -18634,7 +20216,7 @@ func (obj *pgxcockroachImpl) Create_Project(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -18688,18 +20270,19 @@ func (obj *pgxcockroachImpl) Create_ApiKey(ctx context.Context,
 	__secret_val := api_key_secret.value()
 	__partner_id_val := optional.PartnerId.value()
 	__user_agent_val := optional.UserAgent.value()
+	__created_by_val := optional.CreatedBy.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, user_agent, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, head, name, secret, partner_id, user_agent, created_by, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __user_agent_val, __created_at_val)
+	__values = append(__values, __id_val, __project_id_val, __head_val, __name_val, __secret_val, __partner_id_val, __user_agent_val, __created_by_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -19004,17 +20587,31 @@ func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	__default_redundancy_optimal_shares_val := bucket_metainfo_default_redundancy_optimal_shares.value()
 	__default_redundancy_total_shares_val := bucket_metainfo_default_redundancy_total_shares.value()
 	__placement_val := optional.Placement.value()
+	__object_lock_enabled_val := optional.ObjectLockEnabled.value()
+	__default_retention_mode_val := optional.DefaultRetentionMode.value()
+	__default_retention_days_val := optional.DefaultRetentionDays.value()
+	__deleted_at_val := optional.DeletedAt.value()
+	__tags_val := optional.Tags.value()
+	__cors_val := optional.Cors.value()
+	__default_object_ttl_val := optional.DefaultObjectTtl.value()
+	__created_by_val := optional.CreatedBy.value()
+	__logging_target_bucket_val := optional.LoggingTargetBucket.value()
+	__logging_target_prefix_val := optional.LoggingTargetPrefix.value()
+	__frozen_val := optional.Frozen.value()
+	__last_modified_val := optional.LastModified.value()
+	__cost_center_val := optional.CostCenter.value()
+	__name_normalized_val := optional.NameNormalized.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_metainfos ( id, project_id, name, partner_id, user_agent, path_cipher, created_at, default_segment_size, default_encryption_cipher_suite, default_encryption_block_size, default_redundancy_algorithm, default_redundancy_share_size, default_redundancy_required_shares, default_redundancy_repair_shares, default_redundancy_optimal_shares, default_redundancy_total_shares, placement, object_lock_enabled, default_retention_mode, default_retention_days, deleted_at, tags, cors, default_object_ttl, created_by, logging_target_bucket, logging_target_prefix, frozen, last_modified, cost_center, name_normalized ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val)
+	__values = append(__values, __id_val, __project_id_val, __name_val, __partner_id_val, __user_agent_val, __path_cipher_val, __created_at_val, __default_segment_size_val, __default_encryption_cipher_suite_val, __default_encryption_block_size_val, __default_redundancy_algorithm_val, __default_redundancy_share_size_val, __default_redundancy_required_shares_val, __default_redundancy_repair_shares_val, __default_redundancy_optimal_shares_val, __default_redundancy_total_shares_val, __placement_val, __object_lock_enabled_val, __default_retention_mode_val, __default_retention_days_val, __deleted_at_val, __tags_val, __cors_val, __default_object_ttl_val, __created_by_val, __logging_target_bucket_val, __logging_target_prefix_val, __frozen_val, __last_modified_val, __cost_center_val, __name_normalized_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -19985,7 +21582,7 @@ func (obj *pgxcockroachImpl) Get_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -19994,7 +21591,7 @@ func (obj *pgxcockroachImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err != nil {
 		return (*Project)(nil), obj.makeErr(err)
 	}
@@ -20116,7 +21713,7 @@ func (obj *pgxcockroachImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects")
 
 	var __values []interface{}
 
@@ -20133,7 +21730,7 @@ func (obj *pgxcockroachImpl) All_Project(ctx context.Context) (
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -20160,7 +21757,7 @@ func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -20178,7 +21775,7 @@ func (obj *pgxcockroachImpl) All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -20205,7 +21802,7 @@ func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx co
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.owner_id = ? ORDER BY projects.created_at")
 
 	var __values []interface{}
 	__values = append(__values, project_owner_id.value())
@@ -20223,7 +21820,7 @@ func (obj *pgxcockroachImpl) All_Project_By_OwnerId_OrderBy_Asc_CreatedAt(ctx co
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -20250,7 +21847,7 @@ func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_P
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -20268,7 +21865,7 @@ func (obj *pgxcockroachImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_P
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -20296,7 +21893,7 @@ func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_Creat
 	rows []*Project, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme FROM projects WHERE projects.created_at < ? ORDER BY projects.created_at LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_created_at_less.value())
@@ -20316,7 +21913,7 @@ func (obj *pgxcockroachImpl) Limited_Project_By_CreatedAt_Less_OrderBy_Asc_Creat
 
 			for __rows.Next() {
 				project := &Project{}
-				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+				err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 				if err != nil {
 					return nil, err
 				}
@@ -20389,7 +21986,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -20398,7 +21995,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -20411,7 +22008,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Head(ctx context.Context,
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.head = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_head.value())
@@ -20420,7 +22017,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Head(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -20434,7 +22031,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Contex
 	api_key *ApiKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.head, api_keys.name, api_keys.secret, api_keys.partner_id, api_keys.user_agent, api_keys.created_by, api_keys.created_at FROM api_keys WHERE api_keys.name = ? AND api_keys.project_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_name.value(), api_key_project_id.value())
@@ -20443,7 +22040,7 @@ func (obj *pgxcockroachImpl) Get_ApiKey_By_Name_And_ProjectId(ctx context.Contex
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Head, &api_key.Name, &api_key.Secret, &api_key.PartnerId, &api_key.UserAgent, &api_key.CreatedBy, &api_key.CreatedAt)
 	if err != nil {
 		return (*ApiKey)(nil), obj.makeErr(err)
 	}
@@ -21396,7 +22993,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	bucket_metainfo *BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -21405,7 +23002,7 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err != nil {
 		return (*BucketMetainfo)(nil), obj.makeErr(err)
 	}
@@ -21413,13 +23010,13 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx contex
 
 }
 
-func (obj *pgxcockroachImpl) Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx context.Context,
+func (obj *pgxcockroachImpl) Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	row *CreatedAt_Row, err error) {
+	row *CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.created_at FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.created_at, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.default_segment_size, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name.value())
@@ -21427,10 +23024,10 @@ func (obj *pgxcockroachImpl) Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	row = &CreatedAt_Row{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.CreatedAt)
+	row = &CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&row.CreatedAt, &row.DefaultEncryptionCipherSuite, &row.DefaultEncryptionBlockSize, &row.Placement, &row.ObjectLockEnabled, &row.DefaultRetentionMode, &row.DefaultRetentionDays, &row.DeletedAt, &row.Tags, &row.DefaultSegmentSize, &row.Cors, &row.DefaultObjectTtl, &row.CreatedBy, &row.LoggingTargetBucket, &row.LoggingTargetPrefix, &row.Frozen, &row.LastModified, &row.CostCenter)
 	if err != nil {
-		return (*CreatedAt_Row)(nil), obj.makeErr(err)
+		return (*CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row)(nil), obj.makeErr(err)
 	}
 	return row, nil
 
@@ -21511,7 +23108,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name >= ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater_or_equal.value())
@@ -21531,7 +23128,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 				if err != nil {
 					return nil, err
 				}
@@ -21561,7 +23158,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 	rows []*BucketMetainfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized FROM bucket_metainfos WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name > ? ORDER BY bucket_metainfos.name LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, bucket_metainfo_project_id.value(), bucket_metainfo_name_greater.value())
@@ -21581,7 +23178,7 @@ func (obj *pgxcockroachImpl) Limited_BucketMetainfo_By_ProjectId_And_Name_Greate
 
 			for __rows.Next() {
 				bucket_metainfo := &BucketMetainfo{}
-				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+				err = __rows.Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 				if err != nil {
 					return nil, err
 				}
@@ -23573,7 +25170,7 @@ func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.usage_limit, projects.bandwidth_limit, projects.segment_limit, projects.rate_limit, projects.burst_limit, projects.max_buckets, projects.partner_id, projects.user_agent, projects.owner_id, projects.created_at, projects.default_redundancy_scheme")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -23619,6 +25216,11 @@ func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_buckets = ?"))
 	}
 
+	if update.DefaultRedundancyScheme._set {
+		__values = append(__values, update.DefaultRedundancyScheme.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_redundancy_scheme = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -23632,7 +25234,7 @@ func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.UsageLimit, &project.BandwidthLimit, &project.SegmentLimit, &project.RateLimit, &project.BurstLimit, &project.MaxBuckets, &project.PartnerId, &project.UserAgent, &project.OwnerId, &project.CreatedAt, &project.DefaultRedundancyScheme)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -23771,7 +25373,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_metainfos SET "), __sets, __sqlbundle_Literal(" WHERE bucket_metainfos.project_id = ? AND bucket_metainfos.name = ? RETURNING bucket_metainfos.id, bucket_metainfos.project_id, bucket_metainfos.name, bucket_metainfos.partner_id, bucket_metainfos.user_agent, bucket_metainfos.path_cipher, bucket_metainfos.created_at, bucket_metainfos.default_segment_size, bucket_metainfos.default_encryption_cipher_suite, bucket_metainfos.default_encryption_block_size, bucket_metainfos.default_redundancy_algorithm, bucket_metainfos.default_redundancy_share_size, bucket_metainfos.default_redundancy_required_shares, bucket_metainfos.default_redundancy_repair_shares, bucket_metainfos.default_redundancy_optimal_shares, bucket_metainfos.default_redundancy_total_shares, bucket_metainfos.placement, bucket_metainfos.object_lock_enabled, bucket_metainfos.default_retention_mode, bucket_metainfos.default_retention_days, bucket_metainfos.deleted_at, bucket_metainfos.tags, bucket_metainfos.cors, bucket_metainfos.default_object_ttl, bucket_metainfos.created_by, bucket_metainfos.logging_target_bucket, bucket_metainfos.logging_target_prefix, bucket_metainfos.frozen, bucket_metainfos.last_modified, bucket_metainfos.cost_center, bucket_metainfos.name_normalized")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -23837,6 +25439,76 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("placement = ?"))
 	}
 
+	if update.ObjectLockEnabled._set {
+		__values = append(__values, update.ObjectLockEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("object_lock_enabled = ?"))
+	}
+
+	if update.DefaultRetentionMode._set {
+		__values = append(__values, update.DefaultRetentionMode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_mode = ?"))
+	}
+
+	if update.DefaultRetentionDays._set {
+		__values = append(__values, update.DefaultRetentionDays.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_retention_days = ?"))
+	}
+
+	if update.DeletedAt._set {
+		__values = append(__values, update.DeletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_at = ?"))
+	}
+
+	if update.Tags._set {
+		__values = append(__values, update.Tags.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("tags = ?"))
+	}
+
+	if update.Cors._set {
+		__values = append(__values, update.Cors.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cors = ?"))
+	}
+
+	if update.DefaultObjectTtl._set {
+		__values = append(__values, update.DefaultObjectTtl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("default_object_ttl = ?"))
+	}
+
+	if update.CreatedBy._set {
+		__values = append(__values, update.CreatedBy.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("created_by = ?"))
+	}
+
+	if update.LoggingTargetBucket._set {
+		__values = append(__values, update.LoggingTargetBucket.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("logging_target_bucket = ?"))
+	}
+
+	if update.LoggingTargetPrefix._set {
+		__values = append(__values, update.LoggingTargetPrefix.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("logging_target_prefix = ?"))
+	}
+
+	if update.Frozen._set {
+		__values = append(__values, update.Frozen.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("frozen = ?"))
+	}
+
+	if update.LastModified._set {
+		__values = append(__values, update.LastModified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_modified = ?"))
+	}
+
+	if update.CostCenter._set {
+		__values = append(__values, update.CostCenter.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("cost_center = ?"))
+	}
+
+	if update.NameNormalized._set {
+		__values = append(__values, update.NameNormalized.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name_normalized = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -23850,7 +25522,7 @@ func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx con
 	obj.logStmt(__stmt, __values...)
 
 	bucket_metainfo = &BucketMetainfo{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_metainfo.Id, &bucket_metainfo.ProjectId, &bucket_metainfo.Name, &bucket_metainfo.PartnerId, &bucket_metainfo.UserAgent, &bucket_metainfo.PathCipher, &bucket_metainfo.CreatedAt, &bucket_metainfo.DefaultSegmentSize, &bucket_metainfo.DefaultEncryptionCipherSuite, &bucket_metainfo.DefaultEncryptionBlockSize, &bucket_metainfo.DefaultRedundancyAlgorithm, &bucket_metainfo.DefaultRedundancyShareSize, &bucket_metainfo.DefaultRedundancyRequiredShares, &bucket_metainfo.DefaultRedundancyRepairShares, &bucket_metainfo.DefaultRedundancyOptimalShares, &bucket_metainfo.DefaultRedundancyTotalShares, &bucket_metainfo.Placement, &bucket_metainfo.ObjectLockEnabled, &bucket_metainfo.DefaultRetentionMode, &bucket_metainfo.DefaultRetentionDays, &bucket_metainfo.DeletedAt, &bucket_metainfo.Tags, &bucket_metainfo.Cors, &bucket_metainfo.DefaultObjectTtl, &bucket_metainfo.CreatedBy, &bucket_metainfo.LoggingTargetBucket, &bucket_metainfo.LoggingTargetPrefix, &bucket_metainfo.Frozen, &bucket_metainfo.LastModified, &bucket_metainfo.CostCenter, &bucket_metainfo.NameNormalized)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -25072,6 +26744,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM email_suppressions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25112,6 +26794,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_transfers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25122,6 +26814,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_renames;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_idempotency_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -25142,6 +26854,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_access_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26103,15 +27835,15 @@ func (rx *Rx) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	return tx.Get_BucketMetainfo_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
-func (rx *Rx) Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx context.Context,
+func (rx *Rx) Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
-	row *CreatedAt_Row, err error) {
+	row *CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
+	return tx.Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx, bucket_metainfo_project_id, bucket_metainfo_name)
 }
 
 func (rx *Rx) Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Context,
@@ -27393,10 +29125,10 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		bucket_metainfo *BucketMetainfo, err error)
 
-	Get_BucketMetainfo_CreatedAt_By_ProjectId_And_Name(ctx context.Context,
+	Get_BucketMetainfo_CreatedAt_BucketMetainfo_DefaultEncryptionCipherSuite_BucketMetainfo_DefaultEncryptionBlockSize_BucketMetainfo_Placement_BucketMetainfo_ObjectLockEnabled_BucketMetainfo_DefaultRetentionMode_BucketMetainfo_DefaultRetentionDays_BucketMetainfo_DeletedAt_BucketMetainfo_Tags_BucketMetainfo_DefaultSegmentSize_BucketMetainfo_Cors_BucketMetainfo_DefaultObjectTtl_BucketMetainfo_CreatedBy_BucketMetainfo_LoggingTargetBucket_BucketMetainfo_LoggingTargetPrefix_BucketMetainfo_Frozen_BucketMetainfo_LastModified_BucketMetainfo_CostCenter_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
-		row *CreatedAt_Row, err error)
+		row *CreatedAt_DefaultEncryptionCipherSuite_DefaultEncryptionBlockSize_Placement_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DeletedAt_Tags_DefaultSegmentSize_Cors_DefaultObjectTtl_CreatedBy_LoggingTargetBucket_LoggingTargetPrefix_Frozen_LastModified_CostCenter_Row, err error)
 
 	Get_BucketMetainfo_Id_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -27,6 +27,28 @@ CREATE TABLE billing_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE bucket_access_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	target_bucket bytea NOT NULL,
+	target_prefix text NOT NULL,
+	operation text NOT NULL,
+	object_key bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_audit_logs (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	action text NOT NULL,
+	api_key_id bytea NOT NULL,
+	api_key_head bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bucket_bandwidth_rollups (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -49,6 +71,22 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_idempotency_keys (
+	project_id bytea NOT NULL,
+	idempotency_key bytea NOT NULL,
+	operation text NOT NULL,
+	bucket_name bytea NOT NULL,
+	response bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, idempotency_key )
+);
+CREATE TABLE bucket_renames (
+	project_id bytea NOT NULL,
+	old_name bytea NOT NULL,
+	new_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, old_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -63,6 +101,13 @@ CREATE TABLE bucket_storage_tallies (
 	metadata_size bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start )
 );
+CREATE TABLE bucket_transfers (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	new_project_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE coinpayments_transactions (
 	id text NOT NULL,
 	user_id bytea NOT NULL,
@@ -106,6 +151,12 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE email_suppressions (
+	project_id bytea NOT NULL,
+	email text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -241,6 +292,7 @@ CREATE TABLE projects (
 	user_agent bytea,
 	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
+	default_redundancy_scheme text,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE project_bandwidth_daily_rollups (
@@ -476,6 +528,7 @@ CREATE TABLE api_keys (
 	secret bytea NOT NULL,
 	partner_id bytea,
 	user_agent bytea,
+	created_by bytea,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
@@ -499,8 +552,23 @@ CREATE TABLE bucket_metainfos (
 	default_redundancy_optimal_shares integer NOT NULL,
 	default_redundancy_total_shares integer NOT NULL,
 	placement integer,
+	object_lock_enabled boolean,
+	default_retention_mode integer,
+	default_retention_days integer,
+	deleted_at timestamp with time zone,
+	tags bytea,
+	cors bytea,
+	default_object_ttl bigint,
+	created_by bytea,
+	logging_target_bucket bytea,
+	logging_target_prefix text,
+	frozen boolean,
+	last_modified timestamp with time zone,
+	cost_center text,
+	name_normalized bytea,
 	PRIMARY KEY ( id ),
-	UNIQUE ( project_id, name )
+	UNIQUE ( project_id, name ),
+	UNIQUE ( project_id, name_normalized )
 );
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -528,6 +596,8 @@ CREATE TABLE user_credits (
 	UNIQUE ( id, offer_id )
 );
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX bucket_access_logs_created_at_index ON bucket_access_logs ( created_at ) ;
+CREATE INDEX bucket_audit_logs_project_id_created_at_index ON bucket_audit_logs ( project_id, created_at ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/mailservice"
+)
+
+// ensures that emailSuppressionsDB implements mailservice.SuppressionDB.
+var _ mailservice.SuppressionDB = (*emailSuppressionsDB)(nil)
+
+type emailSuppressionsDB struct {
+	db *satelliteDB
+}
+
+// AddSuppression adds the address to the suppression list of the project.
+func (db *emailSuppressionsDB) AddSuppression(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO email_suppressions (project_id, email, created_at)
+		VALUES ($1, $2, now())
+		ON CONFLICT (project_id, email) DO NOTHING
+	`, projectID, email)
+	return errs.Wrap(err)
+}
+
+// RemoveSuppression removes the address from the suppression list of the project.
+func (db *emailSuppressionsDB) RemoveSuppression(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM email_suppressions
+		WHERE project_id = $1 AND email = $2
+	`, projectID, email)
+	return errs.Wrap(err)
+}
+
+// ListSuppressions returns the suppressed addresses of the project.
+func (db *emailSuppressionsDB) ListSuppressions(ctx context.Context, projectID uuid.UUID) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT email FROM email_suppressions
+		WHERE project_id = $1
+	`, projectID)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		emails = append(emails, email)
+	}
+	return emails, errs.Wrap(rows.Err())
+}
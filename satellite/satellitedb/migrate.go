@@ -1951,6 +1951,195 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add object lock columns to bucket_metainfos",
+				Version:     200,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN object_lock_enabled boolean`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_mode integer`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_retention_days integer`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add deleted_at column to bucket_metainfos for soft-deleted buckets",
+				Version:     201,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN deleted_at timestamp with time zone`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add default_redundancy_scheme column to projects",
+				Version:     202,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD COLUMN default_redundancy_scheme text`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add tags column to bucket_metainfos",
+				Version:     203,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN tags bytea`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_idempotency_keys table",
+				Version:     204,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_idempotency_keys (
+						project_id bytea NOT NULL,
+						idempotency_key bytea NOT NULL,
+						operation text NOT NULL,
+						bucket_name bytea NOT NULL,
+						response bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, idempotency_key )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_renames table",
+				Version:     205,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_renames (
+						project_id bytea NOT NULL,
+						old_name bytea NOT NULL,
+						new_name bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, old_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add email_suppressions table",
+				Version:     206,
+				Action: migrate.SQL{
+					`CREATE TABLE email_suppressions (
+						project_id bytea NOT NULL,
+						email text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, email )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add cors column to bucket_metainfos",
+				Version:     207,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN cors bytea`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add default_object_ttl column to bucket_metainfos",
+				Version:     208,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN default_object_ttl bigint`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add created_by column to api_keys and bucket_metainfos",
+				Version:     209,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN created_by bytea`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN created_by bytea`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket access logging columns and bucket_access_logs table",
+				Version:     210,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN logging_target_bucket bytea`,
+					`ALTER TABLE bucket_metainfos ADD COLUMN logging_target_prefix text`,
+					`CREATE TABLE bucket_access_logs (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						target_bucket bytea NOT NULL,
+						target_prefix text NOT NULL,
+						operation text NOT NULL,
+						object_key bytea NOT NULL,
+						api_key_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX bucket_access_logs_created_at_index ON bucket_access_logs ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_transfers table",
+				Version:     211,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_transfers (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						new_project_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_audit_logs table",
+				Version:     212,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_audit_logs (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						action text NOT NULL,
+						api_key_id bytea NOT NULL,
+						api_key_head bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX bucket_audit_logs_project_id_created_at_index ON bucket_audit_logs ( project_id, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add frozen column to bucket_metainfos",
+				Version:     213,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN frozen boolean`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add last_modified column to bucket_metainfos",
+				Version:     214,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN last_modified timestamp with time zone`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add cost_center column to bucket_metainfos",
+				Version:     215,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN cost_center text`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add name_normalized column to bucket_metainfos",
+				Version:     216,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_metainfos ADD COLUMN name_normalized bytea`,
+					`ALTER TABLE bucket_metainfos ADD UNIQUE (project_id, name_normalized)`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
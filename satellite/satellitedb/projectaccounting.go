@@ -612,9 +612,14 @@ func (db *ProjectAccounting) GetBucketUsageRollups(ctx context.Context, projectI
 		return nil, err
 	}
 
+	costCenters, err := db.getBucketCostCenters(ctx, projectID, buckets)
+	if err != nil {
+		return nil, err
+	}
+
 	var bucketUsageRollups []accounting.BucketUsageRollup
 	for _, bucket := range buckets {
-		bucketRollup, err := db.getSingleBucketRollup(ctx, projectID, bucket, since, before)
+		bucketRollup, err := db.getSingleBucketRollup(ctx, projectID, bucket, costCenters[bucket], since, before)
 		if err != nil {
 			return nil, err
 		}
@@ -631,7 +636,12 @@ func (db *ProjectAccounting) GetSingleBucketUsageRollup(ctx context.Context, pro
 	since = timeTruncateDown(since.UTC())
 	before = before.UTC()
 
-	bucketRollup, err := db.getSingleBucketRollup(ctx, projectID, bucket, since, before)
+	costCenters, err := db.getBucketCostCenters(ctx, projectID, []string{bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	bucketRollup, err := db.getSingleBucketRollup(ctx, projectID, bucket, costCenters[bucket], since, before)
 	if err != nil {
 		return nil, err
 	}
@@ -639,7 +649,42 @@ func (db *ProjectAccounting) GetSingleBucketUsageRollup(ctx context.Context, pro
 	return bucketRollup, nil
 }
 
-func (db *ProjectAccounting) getSingleBucketRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (*accounting.BucketUsageRollup, error) {
+// getBucketCostCenters returns the cost centers of the buckets by bucket name.
+// The usage of deleted and soft-deleted buckets isn't allocated to a cost center.
+func (db *ProjectAccounting) getBucketCostCenters(ctx context.Context, projectID uuid.UUID, buckets []string) (_ map[string]string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	costCenters := make(map[string]string)
+	if len(buckets) == 0 {
+		return costCenters, nil
+	}
+
+	bucketNames := make([][]byte, 0, len(buckets))
+	for _, bucket := range buckets {
+		bucketNames = append(bucketNames, []byte(bucket))
+	}
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT name, cost_center FROM bucket_metainfos
+		WHERE project_id = $1 AND name = ANY($2::BYTEA[]) AND deleted_at IS NULL AND cost_center IS NOT NULL
+	`, projectID[:], pgutil.ByteaArray(bucketNames))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var name []byte
+		var costCenter string
+		if err := rows.Scan(&name, &costCenter); err != nil {
+			return nil, err
+		}
+		costCenters[string(name)] = costCenter
+	}
+	return costCenters, rows.Err()
+}
+
+func (db *ProjectAccounting) getSingleBucketRollup(ctx context.Context, projectID uuid.UUID, bucket, costCenter string, since, before time.Time) (*accounting.BucketUsageRollup, error) {
 	roullupsQuery := db.db.Rebind(`SELECT SUM(settled), SUM(inline), action
 		FROM bucket_bandwidth_rollups
 		WHERE project_id = ? AND bucket_name = ? AND interval_start >= ? AND interval_start <= ?
@@ -653,6 +698,7 @@ func (db *ProjectAccounting) getSingleBucketRollup(ctx context.Context, projectI
 		BucketName: bucket,
 		Since:      since,
 		Before:     before,
+		CostCenter: costCenter,
 	}
 
 	// get bucket_bandwidth_rollup
@@ -6,11 +6,13 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -395,3 +397,58 @@ func (projects *projects) GetMaxBuckets(ctx context.Context, id uuid.UUID) (maxB
 	}
 	return dbxRow.MaxBuckets, nil
 }
+
+// GetDefaultRedundancyScheme returns the project specific redundancy scheme, nil when the project uses the satellite default.
+func (projects *projects) GetDefaultRedundancyScheme(ctx context.Context, id uuid.UUID) (_ *storj.RedundancyScheme, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxProject, err := projects.db.Get_Project_By_Id(ctx, dbx.Project_Id(id[:]))
+	if err != nil {
+		return nil, err
+	}
+	if dbxProject.DefaultRedundancyScheme == nil {
+		return nil, nil
+	}
+	return parseRedundancyScheme(*dbxProject.DefaultRedundancyScheme)
+}
+
+// UpdateDefaultRedundancyScheme sets the project specific redundancy scheme, nil resets it to the satellite default.
+func (projects *projects) UpdateDefaultRedundancyScheme(ctx context.Context, id uuid.UUID, rs *storj.RedundancyScheme) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var value *string
+	if rs != nil {
+		if rs.Algorithm != storj.ReedSolomon {
+			return Error.New("unsupported redundancy algorithm %d", rs.Algorithm)
+		}
+		if rs.RequiredShares <= 0 || rs.RequiredShares > rs.RepairShares ||
+			rs.RepairShares > rs.OptimalShares || rs.OptimalShares > rs.TotalShares || rs.ShareSize <= 0 {
+			return Error.New("invalid redundancy scheme %+v", *rs)
+		}
+		formatted := formatRedundancyScheme(*rs)
+		value = &formatted
+	}
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		dbx.Project_Update_Fields{
+			DefaultRedundancyScheme: dbx.Project_DefaultRedundancyScheme_Raw(value),
+		})
+
+	return err
+}
+
+// formatRedundancyScheme encodes a Reed-Solomon scheme as "required/repair/optimal/total-sharesize".
+func formatRedundancyScheme(rs storj.RedundancyScheme) string {
+	return fmt.Sprintf("%d/%d/%d/%d-%d", rs.RequiredShares, rs.RepairShares, rs.OptimalShares, rs.TotalShares, rs.ShareSize)
+}
+
+// parseRedundancyScheme decodes a scheme stored by formatRedundancyScheme.
+func parseRedundancyScheme(value string) (*storj.RedundancyScheme, error) {
+	rs := storj.RedundancyScheme{Algorithm: storj.ReedSolomon}
+	_, err := fmt.Sscanf(value, "%d/%d/%d/%d-%d", &rs.RequiredShares, &rs.RepairShares, &rs.OptimalShares, &rs.TotalShares, &rs.ShareSize)
+	if err != nil {
+		return nil, Error.New("invalid redundancy scheme %q: %v", value, err)
+	}
+	return &rs, nil
+}